@@ -3,18 +3,35 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/dcm-project/k8s-service-provider/internal/approval"
+	"github.com/dcm-project/k8s-service-provider/internal/audit"
+	"github.com/dcm-project/k8s-service-provider/internal/auth"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/api"
 	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/defaults"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/services"
+	"github.com/dcm-project/k8s-service-provider/internal/envelope"
+	"github.com/dcm-project/k8s-service-provider/internal/events"
+	"github.com/dcm-project/k8s-service-provider/internal/flavors"
+	grpcAPI "github.com/dcm-project/k8s-service-provider/internal/grpc"
 	"github.com/dcm-project/k8s-service-provider/internal/k8s"
+	"github.com/dcm-project/k8s-service-provider/internal/mtls"
 	namespaceAPI "github.com/dcm-project/k8s-service-provider/internal/namespace/api"
+	"github.com/dcm-project/k8s-service-provider/internal/netid"
 	namespaceServices "github.com/dcm-project/k8s-service-provider/internal/namespace/services"
+	"github.com/dcm-project/k8s-service-provider/internal/ratelimit"
+	"github.com/dcm-project/k8s-service-provider/internal/reconciler"
+	"github.com/dcm-project/k8s-service-provider/internal/records"
+	"github.com/dcm-project/k8s-service-provider/internal/report"
+	"github.com/dcm-project/k8s-service-provider/internal/sshkeys"
+	"github.com/dcm-project/k8s-service-provider/internal/tenancy"
 	"go.uber.org/zap"
 )
 
@@ -50,45 +67,288 @@ func main() {
 		logger.Fatal("Failed to initialize Kubernetes client", zap.Error(err))
 	}
 
+	// Initialize lifecycle event bus
+	eventBus := events.NewBus()
+
 	// Initialize deployment service
-	deployService := services.NewDeploymentService(k8sClient, logger)
+	deployService := services.NewDeploymentService(k8sClient, eventBus, cfg.VMShape, cfg.Workload, logger)
+
+	// Register the configured target clusters so deployments can name one
+	// via metadata.cluster
+	clusterRegistry, err := k8s.NewRegistry(cfg.Kubernetes, logger)
+	if err != nil {
+		logger.Fatal("Failed to initialize cluster registry", zap.Error(err))
+	}
+	if clusterRegistry != nil {
+		targets := make(map[string]*services.DeploymentService)
+		for _, cluster := range clusterRegistry.Clusters() {
+			targets[cluster.Name] = services.NewClusterDeploymentService(
+				cluster.Client, cluster.Kubeconfig, eventBus, cfg.VMShape, cfg.Workload,
+				logger.With(zap.String("cluster", cluster.Name)))
+		}
+		deployService.SetClusterTargets(targets)
+		logger.Info("Multi-cluster targeting enabled", zap.Int("clusters", len(targets)))
+	}
+
+	// Load operator defaults profiles when a directory is configured
+	if cfg.Defaults.ProfilesDir != "" {
+		profiles, err := defaults.LoadProfiles(cfg.Defaults.ProfilesDir)
+		if err != nil {
+			logger.Fatal("Failed to load defaults profiles", zap.Error(err))
+		}
+		logger.Info("Loaded defaults profiles",
+			zap.String("dir", cfg.Defaults.ProfilesDir),
+			zap.Int("count", len(profiles)))
+		deployService.SetDefaultsEngine(defaults.NewEngine(profiles, k8sClient.GetReadClientset(), logger))
+	}
+
+	// Serve deployment and VM reads from watch-driven informer caches when
+	// enabled; reads fall back to live LIST calls until the caches sync
+	cacheCtx, cacheCancel := context.WithCancel(context.Background())
+	defer cacheCancel()
+	if cfg.Cache.Enabled {
+		deployService.StartInformerCache(cacheCtx, time.Duration(cfg.Cache.ResyncSeconds)*time.Second)
+		logger.Info("Informer cache enabled", zap.Int("resync_seconds", cfg.Cache.ResyncSeconds))
+	}
+
+	// Persist deployment records outside the cluster when a store is
+	// configured, keeping IDs resolvable after out-of-band deletions
+	if cfg.Store.Driver != "" {
+		recordStore, err := records.NewStore(cfg.Store, logger)
+		if err != nil {
+			logger.Fatal("Failed to initialize record store", zap.Error(err))
+		}
+		defer func() {
+			if err := recordStore.Close(); err != nil {
+				logger.Warn("Failed to close record store", zap.Error(err))
+			}
+		}()
+		deployService.SetRecordStore(recordStore)
+		logger.Info("Deployment record store enabled", zap.String("driver", cfg.Store.Driver))
+	}
+
+	// Store records as ManagedDeployment custom resources and converge the
+	// cluster back toward them when managed objects disappear out-of-band
+	if cfg.Reconciler.Enabled {
+		crdStore := reconciler.NewStore(k8sClient.GetDynamicClient(), logger)
+		deployService.SetRecordStore(crdStore)
+		reconciler.New(crdStore, deployService,
+			time.Duration(cfg.Reconciler.IntervalSeconds)*time.Second, logger).Start(cacheCtx)
+		logger.Info("ManagedDeployment reconciler enabled",
+			zap.Int("interval_seconds", cfg.Reconciler.IntervalSeconds))
+	}
+
+	// Sweep for orphaned secondary objects in the background when enabled
+	if cfg.GC.Enabled {
+		deployService.StartOrphanSweeper(cacheCtx,
+			time.Duration(cfg.GC.IntervalSeconds)*time.Second, cfg.GC.Remove)
+		logger.Info("Orphan sweeper enabled",
+			zap.Int("interval_seconds", cfg.GC.IntervalSeconds),
+			zap.Bool("remove", cfg.GC.Remove))
+	}
+
+	// Enable stable VM network identities when configured
+	if cfg.NetworkIdentity.Enabled {
+		deployService.SetNetworkIdentityRegistry(
+			netid.NewConfigMapRegistry(k8sClient.GetClientset(), cfg.NetworkIdentity.Namespace, logger))
+	}
+
+	// Operator-defined VM flavors replace the built-in catalog when configured
+	if cfg.VMShape.FlavorsFile != "" {
+		catalog, err := flavors.Load(cfg.VMShape.FlavorsFile)
+		if err != nil {
+			logger.Fatal("Failed to load VM flavor catalog", zap.Error(err))
+		}
+		deployService.SetFlavorCatalog(catalog)
+		logger.Info("Loaded VM flavor catalog",
+			zap.String("file", cfg.VMShape.FlavorsFile),
+			zap.Int("flavors", len(catalog.List())))
+	}
+
+	// Protect system namespaces and optionally pin deployments to an allowlist
+	nsPolicy, err := services.NewNamespacePolicy(cfg.Namespaces)
+	if err != nil {
+		logger.Fatal("Invalid namespace policy", zap.Error(err))
+	}
+	deployService.SetNamespacePolicy(nsPolicy)
+
+	// Default tolerations let tenant workloads land on dedicated node pools
+	if cfg.Workload.DefaultTolerations != "" {
+		tolerations, err := services.ParseDefaultTolerations(cfg.Workload.DefaultTolerations)
+		if err != nil {
+			logger.Fatal("Failed to parse default tolerations", zap.Error(err))
+		}
+		deployService.SetDefaultTolerations(tolerations)
+		logger.Info("Applying default tolerations", zap.Int("count", len(tolerations)))
+	}
+
+	// Reusable SSH public keys referenced by name from VM specs
+	sshKeyRegistry := sshkeys.NewRegistry(k8sClient.GetClientset(), k8sClient.GetReadClientset(), cfg.SSHKeys.Namespace, logger)
+	deployService.SetSSHKeyRegistry(sshKeyRegistry)
+
+	// Initialize operation audit store, forwarding recorded operations to the
+	// configured SIEM sinks when export is enabled
+	var auditStore audit.StoreInterface = audit.NewStore()
+	exportCtx, exportCancel := context.WithCancel(context.Background())
+	defer exportCancel()
+	if cfg.Audit.ExportEnabled {
+		sinks, err := audit.SinksFromConfig(cfg.Audit)
+		if err != nil {
+			logger.Fatal("Failed to initialize audit sinks", zap.Error(err))
+		}
+		logger.Info("Audit export enabled", zap.Int("sinks", len(sinks)))
+
+		exporter := audit.NewExporter(sinks, cfg.Audit, logger)
+		exporter.Start(exportCtx)
+		auditStore = audit.NewExportingStore(auditStore, exporter)
+	}
+
+	// Initialize the router-level audit trail of mutating requests when a
+	// request log destination is configured; a nil recorder disables it
+	auditRecorder, err := audit.RecorderFromConfig(cfg.Audit, logger)
+	if err != nil {
+		logger.Fatal("Failed to initialize audit request log", zap.Error(err))
+	}
+	if auditRecorder != nil {
+		logger.Info("Audit request log enabled")
+		auditRecorder.Start(exportCtx)
+	}
+
+	// Initialize authentication when enabled — OIDC bearer tokens, API keys,
+	// or both; a nil authenticator leaves the APIs open for
+	// proxy-authenticated setups
+	var authenticator *auth.Authenticator
+	if cfg.Auth.Enabled {
+		var verifier *auth.Verifier
+		if cfg.Auth.IssuerURL != "" {
+			verifier = auth.NewVerifier(cfg.Auth, logger)
+		}
+		keychain, err := auth.NewKeychain(cfg.Auth.APIKeys)
+		if err != nil {
+			logger.Fatal("Failed to parse API keys", zap.Error(err))
+		}
+		authenticator = auth.NewAuthenticator(verifier, keychain)
+		logger.Info("API authentication enabled",
+			zap.String("issuer", cfg.Auth.IssuerURL),
+			zap.Int("api_keys", len(cfg.Auth.APIKeys)))
+	}
+
+	// Initialize tenancy when tenant mappings are configured; a nil resolver
+	// leaves all namespaces open to every principal
+	tenants, err := tenancy.NewResolver(cfg.Auth.Tenants)
+	if err != nil {
+		logger.Fatal("Failed to parse tenant mappings", zap.Error(err))
+	}
+	if tenants != nil {
+		logger.Info("Namespace tenancy enabled", zap.Int("tenants", len(cfg.Auth.Tenants)))
+	}
+
+	// Build the listener TLS configuration when enabled; a nil configuration
+	// keeps the servers on plain HTTP
+	tlsConfig, err := mtls.NewTLSConfig(cfg.TLS)
+	if err != nil {
+		logger.Fatal("Failed to build TLS configuration", zap.Error(err))
+	}
+	if tlsConfig != nil {
+		logger.Info("TLS enabled",
+			zap.Bool("mutual_tls", cfg.TLS.ClientCAFile != ""),
+			zap.Int("allowed_clients", len(cfg.TLS.AllowedClients)))
+	}
+
+	// Initialize rate limiting when enabled; a nil limiter disables it
+	limiter := ratelimit.NewLimiter(cfg.RateLimit)
+	if limiter != nil {
+		logger.Info("Rate limiting enabled",
+			zap.Float64("rps", cfg.RateLimit.RPS),
+			zap.Float64("client_rps", cfg.RateLimit.ClientRPS))
+	}
+
+	// Initialize the approval store for gated destructive operations
+	approvalStore := approval.NewStore(approval.DefaultTTL)
+
+	// Start the namespace digest reporter when configured
+	reportCtx, reportCancel := context.WithCancel(context.Background())
+	defer reportCancel()
+	if cfg.Report.Enabled {
+		reporter := report.NewScheduler(
+			report.NewBuilder(deployService, k8sClient.GetReadClientset(), logger),
+			report.SinksFromConfig(cfg.Report, logger),
+			cfg.Report,
+			logger,
+		)
+		reporter.Start(reportCtx)
+	}
 
 	// Initialize namespace service
 	namespaceService := namespaceServices.NewNamespaceService(k8sClient, logger)
 
 	// Setup HTTP routers
-	deploymentRouter := api.SetupRouter(deployService, logger)
+	deploymentRouter := api.SetupRouter(cfg.Server, deployService, auditStore, approvalStore, eventBus, sshKeyRegistry, logger)
 	namespaceHandler := namespaceAPI.NewHandler(namespaceService, logger)
-	namespaceRouter := namespaceAPI.SetupRouter(namespaceHandler, logger)
+	namespaceRouter := namespaceAPI.SetupRouter(namespaceHandler, cfg.Server, logger)
+
+	// Create HTTP servers; the envelope middleware provides the opt-in
+	// response envelope, the authenticator authentication, and the audit recorder
+	// the mutating-request trail, uniformly across both APIs. Audit sits
+	// outermost so rejected requests are recorded too; rate limiting and
+	// tenancy sit inside authentication so they see the verified identity.
+	newServer := func(host string, port, readTimeout, writeTimeout int, handler http.Handler) *http.Server {
+		return &http.Server{
+			Addr:         fmt.Sprintf("%s:%d", host, port),
+			Handler:      auditRecorder.Middleware(authenticator.Middleware(limiter.Middleware(tenants.Middleware(envelope.Middleware(handler))))),
+			ReadTimeout:  time.Duration(readTimeout) * time.Second,
+			WriteTimeout: time.Duration(writeTimeout) * time.Second,
+			TLSConfig:    tlsConfig,
+		}
+	}
 
-	// Create HTTP servers
-	deploymentServer := &http.Server{
-		Addr:         fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port),
-		Handler:      deploymentRouter,
-		ReadTimeout:  time.Duration(cfg.Server.ReadTimeout) * time.Second,
-		WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
+	// In unified mode both APIs share one port, dispatched by path prefix;
+	// split mode keeps the namespace API on its own server and port
+	var httpServers []*http.Server
+	if cfg.Server.Mode == config.ServerModeSplit {
+		ns := cfg.NamespaceServer
+		httpServers = append(httpServers,
+			newServer(cfg.Server.Host, cfg.Server.Port, cfg.Server.ReadTimeout, cfg.Server.WriteTimeout, deploymentRouter),
+			newServer(ns.Host, ns.Port, ns.ReadTimeout, ns.WriteTimeout, namespaceRouter))
+	} else {
+		httpServers = append(httpServers,
+			newServer(cfg.Server.Host, cfg.Server.Port, cfg.Server.ReadTimeout, cfg.Server.WriteTimeout,
+				unifiedHandler(deploymentRouter, namespaceRouter)))
 	}
 
-	namespaceServer := &http.Server{
-		Addr:         fmt.Sprintf("%s:%d", cfg.Server.Host, 8081),
-		Handler:      namespaceRouter,
-		ReadTimeout:  time.Duration(cfg.Server.ReadTimeout) * time.Second,
-		WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
+	// Start the HTTP servers, each in its own goroutine; the certificate and
+	// key are already loaded into the TLS configuration
+	for _, server := range httpServers {
+		go func() {
+			logger.Info("Starting HTTP server",
+				zap.String("mode", cfg.Server.Mode),
+				zap.String("address", server.Addr),
+				zap.Bool("tls", server.TLSConfig != nil))
+			var err error
+			if server.TLSConfig != nil {
+				err = server.ListenAndServeTLS("", "")
+			} else {
+				err = server.ListenAndServe()
+			}
+			if err != nil && err != http.ErrServerClosed {
+				logger.Fatal("Failed to start HTTP server", zap.String("address", server.Addr), zap.Error(err))
+			}
+		}()
 	}
 
-	// Start deployment service in a goroutine
+	// Start gRPC server in a goroutine; it shares the authenticator and TLS
+	// configuration with the HTTP listeners so enabling auth or mTLS covers
+	// every transport
+	grpcServer := grpcAPI.NewServer(deployService, namespaceService, authenticator, tlsConfig, logger)
+	grpcAddr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.GRPCPort)
 	go func() {
-		logger.Info("Starting deployment service HTTP server", zap.String("address", deploymentServer.Addr))
-		if err := deploymentServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			logger.Fatal("Failed to start deployment server", zap.Error(err))
+		listener, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			logger.Fatal("Failed to listen for gRPC server", zap.Error(err))
 		}
-	}()
-
-	// Start namespace service in a goroutine
-	go func() {
-		logger.Info("Starting namespace service HTTP server", zap.String("address", namespaceServer.Addr))
-		if err := namespaceServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			logger.Fatal("Failed to start namespace server", zap.Error(err))
+		logger.Info("Starting gRPC server", zap.String("address", grpcAddr))
+		if err := grpcServer.Serve(listener); err != nil {
+			logger.Fatal("Failed to start gRPC server", zap.Error(err))
 		}
 	}()
 
@@ -103,32 +363,28 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	// Shutdown both servers concurrently
-	deploymentErr := make(chan error, 1)
-	namespaceErr := make(chan error, 1)
-
-	go func() {
-		deploymentErr <- deploymentServer.Shutdown(ctx)
-	}()
+	// Shutdown all HTTP servers concurrently
+	shutdownErrs := make(chan error, len(httpServers))
+	for _, server := range httpServers {
+		go func() {
+			err := server.Shutdown(ctx)
+			if err != nil {
+				logger.Error("HTTP server forced to shutdown", zap.String("address", server.Addr), zap.Error(err))
+			}
+			shutdownErrs <- err
+		}()
+	}
 
 	go func() {
-		namespaceErr <- namespaceServer.Shutdown(ctx)
+		grpcServer.GracefulStop()
+		logger.Info("gRPC server stopped")
 	}()
 
-	// Wait for both shutdowns to complete
+	// Wait for all shutdowns to complete
 	var shutdownErrors []error
-	for i := 0; i < 2; i++ {
-		select {
-		case err := <-deploymentErr:
-			if err != nil {
-				logger.Error("Deployment server forced to shutdown", zap.Error(err))
-				shutdownErrors = append(shutdownErrors, err)
-			}
-		case err := <-namespaceErr:
-			if err != nil {
-				logger.Error("Namespace server forced to shutdown", zap.Error(err))
-				shutdownErrors = append(shutdownErrors, err)
-			}
+	for range httpServers {
+		if err := <-shutdownErrs; err != nil {
+			shutdownErrors = append(shutdownErrors, err)
 		}
 	}
 
@@ -136,7 +392,18 @@ func main() {
 		os.Exit(1)
 	}
 
-	logger.Info("Both servers gracefully stopped")
+	logger.Info("All servers gracefully stopped")
+}
+
+// unifiedHandler dispatches both APIs on one port in unified mode. Only the
+// exact namespace query route goes to the namespace router: a prefix match
+// would shadow the deployment API's routes under /api/v1/namespaces/, such
+// as the namespace activity feed.
+func unifiedHandler(deploymentRouter, namespaceRouter http.Handler) http.Handler {
+	unified := http.NewServeMux()
+	unified.Handle("POST /api/v1/namespaces", namespaceRouter)
+	unified.Handle("/", deploymentRouter)
+	return unified
 }
 
 // initLogger initializes the logger based on configuration
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// namedHandler answers every request with the router name so the dispatch
+// tests can tell which router the unified mux picked
+func namedHandler(name string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Router", name)
+	})
+}
+
+func TestUnifiedHandlerDispatch(t *testing.T) {
+	handler := unifiedHandler(namedHandler("deployment"), namedHandler("namespace"))
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		router string
+	}{
+		{
+			name:   "namespace query goes to the namespace router",
+			method: http.MethodPost,
+			path:   "/api/v1/namespaces",
+			router: "namespace",
+		},
+		{
+			name:   "namespace activity feed stays on the deployment router",
+			method: http.MethodGet,
+			path:   "/api/v1/namespaces/default/events",
+			router: "deployment",
+		},
+		{
+			name:   "deployment routes go to the deployment router",
+			method: http.MethodGet,
+			path:   "/api/v1/deployments",
+			router: "deployment",
+		},
+		{
+			name:   "health stays on the deployment router",
+			method: http.MethodGet,
+			path:   "/api/v1/health",
+			router: "deployment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.path, nil))
+			assert.Equal(t, tt.router, recorder.Header().Get("X-Router"))
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Package approval implements a time-boxed two-person approval workflow for
+// destructive operations. A caller files a request for an elevated action, a
+// second operator approves or denies it, and the approval is consumed exactly
+// once by the gated operation. Pending requests expire automatically after the
+// configured time-to-live.
+package approval
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Elevated actions that can be requested for approval
+const (
+	ActionForceDelete        = "force-delete"
+	ActionOverrideProtection = "override-protection"
+	ActionSystemNamespace    = "system-namespace"
+)
+
+// DefaultTTL is how long a pending or approved request stays usable when no
+// explicit time-to-live is configured
+const DefaultTTL = 30 * time.Minute
+
+// Status represents the lifecycle state of an approval request
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusDenied   Status = "denied"
+	StatusExpired  Status = "expired"
+	StatusConsumed Status = "consumed"
+)
+
+// Errors returned by store operations
+var (
+	ErrNotFound         = errors.New("approval request not found")
+	ErrNotPending       = errors.New("approval request is not pending")
+	ErrSelfApproval     = errors.New("approval requests cannot be approved by their requester")
+	ErrApproverRequired = errors.New("approver identity is required")
+	ErrNoApproval       = errors.New("no approved request found for this operation")
+)
+
+// Request represents a single request for an elevated operation
+type Request struct {
+	ID           string    `json:"id"`
+	Action       string    `json:"action"`
+	DeploymentID string    `json:"deploymentId,omitempty"`
+	Namespace    string    `json:"namespace,omitempty"`
+	Reason       string    `json:"reason,omitempty"`
+	RequestedBy  string    `json:"requestedBy,omitempty"`
+	Status       Status    `json:"status"`
+	CreatedAt    time.Time `json:"createdAt"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+	DecidedBy    string    `json:"decidedBy,omitempty"`
+	DecidedAt    time.Time `json:"decidedAt,omitempty"`
+}
+
+// Filter narrows down the requests returned by List
+type Filter struct {
+	Status Status
+	Action string
+}
+
+// Matches reports whether the request satisfies the filter
+func (f *Filter) Matches(req *Request) bool {
+	if f.Status != "" && req.Status != f.Status {
+		return false
+	}
+	if f.Action != "" && req.Action != f.Action {
+		return false
+	}
+	return true
+}
+
+// StoreInterface defines the interface for approval stores
+type StoreInterface interface {
+	Create(req Request) Request
+	List(filter *Filter) []Request
+	Approve(id, approver string) (Request, error)
+	Deny(id, approver string) (Request, error)
+	Consume(action, deploymentID, namespace string) (Request, error)
+}
+
+// Store is an in-memory approval store safe for concurrent use
+type Store struct {
+	mu       sync.Mutex
+	requests []Request
+	ttl      time.Duration
+}
+
+// NewStore creates a new in-memory approval store. A non-positive ttl falls
+// back to DefaultTTL.
+func NewStore(ttl time.Duration) *Store {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Store{ttl: ttl}
+}
+
+// Create files a new pending request, assigning an ID and expiry
+func (s *Store) Create(req Request) Request {
+	if req.ID == "" {
+		req.ID = uuid.New().String()
+	}
+	if req.CreatedAt.IsZero() {
+		req.CreatedAt = time.Now()
+	}
+	if req.ExpiresAt.IsZero() {
+		req.ExpiresAt = req.CreatedAt.Add(s.ttl)
+	}
+	req.Status = StatusPending
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, req)
+	return req
+}
+
+// List returns all requests matching the filter, oldest first. Pending and
+// approved requests past their expiry are reported as expired.
+func (s *Store) List(filter *Filter) []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expireStale(time.Now())
+
+	results := make([]Request, 0, len(s.requests))
+	for i := range s.requests {
+		if filter == nil || filter.Matches(&s.requests[i]) {
+			results = append(results, s.requests[i])
+		}
+	}
+	return results
+}
+
+// Approve marks a pending request as approved by a second operator
+func (s *Store) Approve(id, approver string) (Request, error) {
+	return s.decide(id, approver, StatusApproved)
+}
+
+// Deny marks a pending request as denied
+func (s *Store) Deny(id, approver string) (Request, error) {
+	return s.decide(id, approver, StatusDenied)
+}
+
+func (s *Store) decide(id, approver string, decision Status) (Request, error) {
+	if approver == "" {
+		return Request{}, ErrApproverRequired
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expireStale(time.Now())
+
+	req := s.findLocked(id)
+	if req == nil {
+		return Request{}, ErrNotFound
+	}
+	if req.Status != StatusPending {
+		return *req, ErrNotPending
+	}
+	if approver == req.RequestedBy {
+		return *req, ErrSelfApproval
+	}
+
+	req.Status = decision
+	req.DecidedBy = approver
+	req.DecidedAt = time.Now()
+	return *req, nil
+}
+
+// Consume marks the matching approved request as consumed and returns it. A
+// request matches when its action is equal and its deployment ID and namespace
+// are either unset or equal to the operation being performed.
+func (s *Store) Consume(action, deploymentID, namespace string) (Request, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expireStale(time.Now())
+
+	for i := range s.requests {
+		req := &s.requests[i]
+		if req.Status != StatusApproved || req.Action != action {
+			continue
+		}
+		if req.DeploymentID != "" && req.DeploymentID != deploymentID {
+			continue
+		}
+		if req.Namespace != "" && req.Namespace != namespace {
+			continue
+		}
+
+		req.Status = StatusConsumed
+		return *req, nil
+	}
+	return Request{}, ErrNoApproval
+}
+
+// expireStale transitions pending and approved requests past their expiry.
+// Callers must hold the lock.
+func (s *Store) expireStale(now time.Time) {
+	for i := range s.requests {
+		req := &s.requests[i]
+		if (req.Status == StatusPending || req.Status == StatusApproved) && now.After(req.ExpiresAt) {
+			req.Status = StatusExpired
+		}
+	}
+}
+
+// findLocked returns the request with the given ID. Callers must hold the lock.
+func (s *Store) findLocked(id string) *Request {
+	for i := range s.requests {
+		if s.requests[i].ID == id {
+			return &s.requests[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package approval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreCreateAssignsDefaults(t *testing.T) {
+	store := NewStore(time.Hour)
+
+	req := store.Create(Request{
+		Action:      ActionForceDelete,
+		RequestedBy: "alice",
+	})
+
+	assert.NotEmpty(t, req.ID)
+	assert.Equal(t, StatusPending, req.Status)
+	assert.False(t, req.CreatedAt.IsZero())
+	assert.Equal(t, req.CreatedAt.Add(time.Hour), req.ExpiresAt)
+}
+
+func TestStoreApprovalLifecycle(t *testing.T) {
+	store := NewStore(time.Hour)
+	req := store.Create(Request{Action: ActionForceDelete, DeploymentID: "dep-1", RequestedBy: "alice"})
+
+	// The requester cannot approve their own request
+	_, err := store.Approve(req.ID, "alice")
+	assert.ErrorIs(t, err, ErrSelfApproval)
+
+	// An anonymous approver is rejected
+	_, err = store.Approve(req.ID, "")
+	assert.ErrorIs(t, err, ErrApproverRequired)
+
+	approved, err := store.Approve(req.ID, "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, StatusApproved, approved.Status)
+	assert.Equal(t, "bob", approved.DecidedBy)
+
+	// A decided request cannot be decided again
+	_, err = store.Deny(req.ID, "bob")
+	assert.ErrorIs(t, err, ErrNotPending)
+
+	// The approval is consumed exactly once
+	consumed, err := store.Consume(ActionForceDelete, "dep-1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, req.ID, consumed.ID)
+
+	_, err = store.Consume(ActionForceDelete, "dep-1", "")
+	assert.ErrorIs(t, err, ErrNoApproval)
+}
+
+func TestStoreConsumeMatching(t *testing.T) {
+	store := NewStore(time.Hour)
+
+	req := store.Create(Request{Action: ActionSystemNamespace, Namespace: "kube-system", RequestedBy: "alice"})
+	_, err := store.Approve(req.ID, "bob")
+	assert.NoError(t, err)
+
+	// An approval scoped to one namespace does not cover another
+	_, err = store.Consume(ActionSystemNamespace, "", "kube-public")
+	assert.ErrorIs(t, err, ErrNoApproval)
+
+	// Nor does it cover a different action
+	_, err = store.Consume(ActionForceDelete, "", "kube-system")
+	assert.ErrorIs(t, err, ErrNoApproval)
+
+	_, err = store.Consume(ActionSystemNamespace, "", "kube-system")
+	assert.NoError(t, err)
+}
+
+func TestStoreExpiry(t *testing.T) {
+	store := NewStore(time.Hour)
+	expired := store.Create(Request{
+		Action:      ActionForceDelete,
+		RequestedBy: "alice",
+		CreatedAt:   time.Now().Add(-2 * time.Hour),
+		ExpiresAt:   time.Now().Add(-time.Hour),
+	})
+
+	requests := store.List(nil)
+	assert.Len(t, requests, 1)
+	assert.Equal(t, StatusExpired, requests[0].Status)
+
+	// Expired requests cannot be approved or consumed
+	_, err := store.Approve(expired.ID, "bob")
+	assert.ErrorIs(t, err, ErrNotPending)
+	_, err = store.Consume(ActionForceDelete, "", "")
+	assert.ErrorIs(t, err, ErrNoApproval)
+}
+
+func TestStoreListFiltering(t *testing.T) {
+	store := NewStore(time.Hour)
+
+	first := store.Create(Request{Action: ActionForceDelete, RequestedBy: "alice"})
+	store.Create(Request{Action: ActionSystemNamespace, RequestedBy: "alice"})
+	_, err := store.Approve(first.ID, "bob")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		filter *Filter
+		want   int
+	}{
+		{"no filter", nil, 2},
+		{"by status", &Filter{Status: StatusPending}, 1},
+		{"by action", &Filter{Action: ActionForceDelete}, 1},
+		{"no match", &Filter{Status: StatusDenied}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Len(t, store.List(tt.filter), tt.want)
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+)
+
+// AuditEvent is one mutating HTTP request as seen at the router, recorded
+// regardless of which handler served it. It complements the Operation trail:
+// operations describe what the service did, events describe what was asked
+// of it — including requests that never reached a handler.
+type AuditEvent struct {
+	ID           string    `json:"id"`
+	Timestamp    time.Time `json:"timestamp"`
+	Actor        string    `json:"actor,omitempty"`
+	Method       string    `json:"method"`
+	Path         string    `json:"path"`
+	DeploymentID string    `json:"deploymentId,omitempty"`
+	PayloadHash  string    `json:"payloadHash,omitempty"` // SHA-256 of the request body
+	Status       int       `json:"status"`
+	Outcome      Outcome   `json:"outcome"`
+}
+
+// EventSink delivers a single audit event to its destination
+type EventSink interface {
+	Name() string
+	Write(ctx context.Context, event AuditEvent) error
+}
+
+// FileEventSink appends events as JSON lines to a local file
+type FileEventSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileEventSink opens (or creates) the audit log file for appending
+func NewFileEventSink(path string) (*FileEventSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) // #nosec G304 -- path comes from operator configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	return &FileEventSink{file: file}, nil
+}
+
+// Name returns the sink identifier used in logs
+func (f *FileEventSink) Name() string {
+	return "file"
+}
+
+// Write appends the event as one JSON line
+func (f *FileEventSink) Write(_ context.Context, event AuditEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.file.Write(append(body, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit event: %w", err)
+	}
+	return nil
+}
+
+// Close releases the audit log file
+func (f *FileEventSink) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
+
+// HTTPEventSink POSTs each event as JSON to a configured collector URL
+type HTTPEventSink struct {
+	url        string
+	authHeader string
+	client     *http.Client
+}
+
+// NewHTTPEventSink creates an HTTP event sink targeting the given URL. When
+// authHeader is set it is sent as the Authorization header on every request.
+func NewHTTPEventSink(url, authHeader string) *HTTPEventSink {
+	return &HTTPEventSink{
+		url:        url,
+		authHeader: authHeader,
+		client:     &http.Client{Timeout: httpTimeout},
+	}
+}
+
+// Name returns the sink identifier used in logs
+func (h *HTTPEventSink) Name() string {
+	return "http"
+}
+
+// Write POSTs the event and treats any non-2xx response as a failure
+func (h *HTTPEventSink) Write(ctx context.Context, event AuditEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build audit request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if h.authHeader != "" {
+		request.Header.Set("Authorization", h.authHeader)
+	}
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to deliver audit event: %w", err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("audit endpoint returned status %d", response.StatusCode)
+	}
+	return nil
+}
+
+// Recorder queues audit events and delivers them to the configured sinks in
+// the background, so recording never blocks an API request. When the buffer
+// is full new events are dropped with a warning.
+type Recorder struct {
+	sinks  []EventSink
+	buffer chan AuditEvent
+	logger *zap.Logger
+}
+
+// NewRecorder creates a recorder delivering to the given sinks
+func NewRecorder(sinks []EventSink, bufferSize int, logger *zap.Logger) *Recorder {
+	return &Recorder{
+		sinks:  sinks,
+		buffer: make(chan AuditEvent, bufferSize),
+		logger: logger.Named("audit_recorder"),
+	}
+}
+
+// RecorderFromConfig builds a recorder from the request log configuration,
+// or nil when no request log destination is configured
+func RecorderFromConfig(cfg config.AuditConfig, logger *zap.Logger) (*Recorder, error) {
+	var sinks []EventSink
+	if cfg.RequestLogPath != "" {
+		sink, err := NewFileEventSink(cfg.RequestLogPath)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.RequestLogURL != "" {
+		sinks = append(sinks, NewHTTPEventSink(cfg.RequestLogURL, cfg.RequestLogAuthHeader))
+	}
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	return NewRecorder(sinks, cfg.BufferSize, logger), nil
+}
+
+// Start launches the delivery loop, which runs until the context is cancelled
+func (r *Recorder) Start(ctx context.Context) {
+	go r.run(ctx)
+}
+
+// Record queues an event for delivery without blocking, assigning an ID and
+// timestamp if unset
+func (r *Recorder) Record(event AuditEvent) {
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	select {
+	case r.buffer <- event:
+	default:
+		r.logger.Warn("Audit buffer full, dropping event",
+			zap.String("method", event.Method),
+			zap.String("path", event.Path))
+	}
+}
+
+// run delivers queued events to every sink
+func (r *Recorder) run(ctx context.Context) {
+	for {
+		select {
+		case event := <-r.buffer:
+			r.deliver(ctx, event)
+		case <-ctx.Done():
+			// Drain what is already buffered so events recorded during
+			// shutdown are not lost
+			for {
+				select {
+				case event := <-r.buffer:
+					r.deliver(context.Background(), event)
+					continue
+				default:
+				}
+				return
+			}
+		}
+	}
+}
+
+// deliver writes the event to every sink; a failing sink does not affect the others
+func (r *Recorder) deliver(ctx context.Context, event AuditEvent) {
+	for _, sink := range r.sinks {
+		if err := sink.Write(ctx, event); err != nil {
+			r.logger.Warn("Failed to deliver audit event",
+				zap.String("sink", sink.Name()),
+				zap.String("event_id", event.ID),
+				zap.Error(err))
+		}
+	}
+}
+
+// Middleware records every mutating request passing through the wrapped
+// handler. A nil recorder leaves the handler untouched, so the middleware can
+// be applied unconditionally.
+func (r *Recorder) Middleware(next http.Handler) http.Handler {
+	if r == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		if !isMutating(request.Method) {
+			next.ServeHTTP(w, request)
+			return
+		}
+
+		// Hash the payload rather than storing it: the audit trail must not
+		// retain credentials submitted in deployment specs
+		var payloadHash string
+		if request.Body != nil {
+			if body, err := io.ReadAll(request.Body); err == nil {
+				request.Body = io.NopCloser(bytes.NewReader(body))
+				if len(body) > 0 {
+					sum := sha256.Sum256(body)
+					payloadHash = hex.EncodeToString(sum[:])
+				}
+			}
+		}
+
+		wrapped := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(wrapped, request)
+
+		outcome := OutcomeSuccess
+		if wrapped.status >= http.StatusBadRequest {
+			outcome = OutcomeFailure
+		}
+
+		r.Record(AuditEvent{
+			Actor:        request.Header.Get("X-Actor"),
+			Method:       request.Method,
+			Path:         request.URL.Path,
+			DeploymentID: deploymentIDFromPath(request.URL.Path),
+			PayloadHash:  payloadHash,
+			Status:       wrapped.status,
+			Outcome:      outcome,
+		})
+	})
+}
+
+// isMutating reports whether the HTTP method changes state
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// deploymentIDFromPath extracts the deployment ID from request paths of the
+// form /api/v1/deployments/{id}[/...]
+func deploymentIDFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "deployments" && i+1 < len(segments) {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+// statusWriter wraps http.ResponseWriter to capture the status code
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusWriter) WriteHeader(code int) {
+	s.status = code
+	s.ResponseWriter.WriteHeader(code)
+}
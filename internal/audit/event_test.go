@@ -0,0 +1,165 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// recordingEventSink captures delivered events
+type recordingEventSink struct {
+	mu     sync.Mutex
+	events []AuditEvent
+}
+
+func (r *recordingEventSink) Name() string { return "recording" }
+
+func (r *recordingEventSink) Write(_ context.Context, event AuditEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *recordingEventSink) delivered() []AuditEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]AuditEvent(nil), r.events...)
+}
+
+func TestFileEventSinkAppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileEventSink(path)
+	require.NoError(t, err)
+	defer func() { _ = sink.Close() }()
+
+	require.NoError(t, sink.Write(context.Background(), AuditEvent{ID: "1", Method: "POST", Path: "/api/v1/deployments", Outcome: OutcomeSuccess}))
+	require.NoError(t, sink.Write(context.Background(), AuditEvent{ID: "2", Method: "DELETE", Path: "/api/v1/deployments/abc", Outcome: OutcomeFailure}))
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	var events []AuditEvent
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event AuditEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+
+	require.Len(t, events, 2)
+	assert.Equal(t, "POST", events[0].Method)
+	assert.Equal(t, OutcomeFailure, events[1].Outcome)
+}
+
+func TestMiddlewareRecordsMutatingRequest(t *testing.T) {
+	sink := &recordingEventSink{}
+	recorder := NewRecorder([]EventSink{sink}, 10, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	recorder.Start(ctx)
+
+	var gotBody string
+	handler := recorder.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The middleware must leave the body readable for the handler
+		body := make([]byte, 64)
+		n, _ := r.Body.Read(body)
+		gotBody = string(body[:n])
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	payload := `{"kind":"container"}`
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/deployments", strings.NewReader(payload))
+	request.Header.Set("X-Actor", "alice")
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	assert.Equal(t, payload, gotBody)
+	require.Eventually(t, func() bool {
+		return len(sink.delivered()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	event := sink.delivered()[0]
+	assert.NotEmpty(t, event.ID)
+	assert.Equal(t, "alice", event.Actor)
+	assert.Equal(t, http.MethodPost, event.Method)
+	assert.Equal(t, "/api/v1/deployments", event.Path)
+	assert.Equal(t, http.StatusCreated, event.Status)
+	assert.Equal(t, OutcomeSuccess, event.Outcome)
+
+	sum := sha256.Sum256([]byte(payload))
+	assert.Equal(t, hex.EncodeToString(sum[:]), event.PayloadHash)
+}
+
+func TestMiddlewareRecordsFailureOutcome(t *testing.T) {
+	sink := &recordingEventSink{}
+	recorder := NewRecorder([]EventSink{sink}, 10, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	recorder.Start(ctx)
+
+	handler := recorder.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	request := httptest.NewRequest(http.MethodDelete, "/api/v1/deployments/abc-123", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	require.Eventually(t, func() bool {
+		return len(sink.delivered()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	event := sink.delivered()[0]
+	assert.Equal(t, "abc-123", event.DeploymentID)
+	assert.Equal(t, OutcomeFailure, event.Outcome)
+	assert.Empty(t, event.PayloadHash)
+}
+
+func TestMiddlewareIgnoresReads(t *testing.T) {
+	sink := &recordingEventSink{}
+	recorder := NewRecorder([]EventSink{sink}, 10, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	recorder.Start(ctx)
+
+	handler := recorder.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil))
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, sink.delivered())
+}
+
+func TestNilRecorderMiddlewareIsPassthrough(t *testing.T) {
+	var recorder *Recorder
+	handler := recorder.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodPost, "/api/v1/deployments", nil))
+	assert.Equal(t, http.StatusTeapot, response.Code)
+}
+
+func TestDeploymentIDFromPath(t *testing.T) {
+	assert.Equal(t, "abc", deploymentIDFromPath("/api/v1/deployments/abc"))
+	assert.Equal(t, "abc", deploymentIDFromPath("/api/v1/deployments/abc/actions"))
+	assert.Empty(t, deploymentIDFromPath("/api/v1/deployments"))
+	assert.Empty(t, deploymentIDFromPath("/api/v1/namespaces"))
+}
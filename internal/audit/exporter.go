@@ -0,0 +1,165 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+)
+
+// maxDeliveryAttempts bounds how often a batch is retried per sink before it
+// is dropped; the backoff doubles between attempts starting at retryBackoff
+const (
+	maxDeliveryAttempts = 3
+	retryBackoff        = time.Second
+)
+
+// Exporter buffers recorded operations and forwards them to the configured
+// SIEM sinks in batches. A batch is flushed when it reaches the configured
+// size or on the flush interval, whichever comes first. Recording never
+// blocks an API request: when the buffer is full new operations are dropped
+// with a warning.
+type Exporter struct {
+	sinks         []Sink
+	buffer        chan Operation
+	batchSize     int
+	flushInterval time.Duration
+	logger        *zap.Logger
+}
+
+// NewExporter creates an exporter forwarding to the given sinks
+func NewExporter(sinks []Sink, cfg config.AuditConfig, logger *zap.Logger) *Exporter {
+	return &Exporter{
+		sinks:         sinks,
+		buffer:        make(chan Operation, cfg.BufferSize),
+		batchSize:     cfg.BatchSize,
+		flushInterval: time.Duration(cfg.FlushSeconds) * time.Second,
+		logger:        logger.Named("audit_exporter"),
+	}
+}
+
+// Start launches the export loop, which runs until the context is cancelled.
+// Operations still buffered at shutdown get one final delivery attempt.
+func (e *Exporter) Start(ctx context.Context) {
+	go e.run(ctx)
+}
+
+// Enqueue queues an operation for export without blocking
+func (e *Exporter) Enqueue(op Operation) {
+	select {
+	case e.buffer <- op:
+	default:
+		e.logger.Warn("Export buffer full, dropping operation",
+			zap.String("operation_id", op.ID),
+			zap.String("action", op.Action))
+	}
+}
+
+// run batches buffered operations and flushes them on size or interval
+func (e *Exporter) run(ctx context.Context) {
+	ticker := time.NewTicker(e.flushInterval)
+	defer ticker.Stop()
+
+	var pending []Operation
+	for {
+		select {
+		case op := <-e.buffer:
+			pending = append(pending, op)
+			if len(pending) >= e.batchSize {
+				e.flush(ctx, pending)
+				pending = nil
+			}
+		case <-ticker.C:
+			if len(pending) > 0 {
+				e.flush(ctx, pending)
+				pending = nil
+			}
+		case <-ctx.Done():
+			// Drain what is already buffered and make a final best-effort
+			// delivery so operations recorded during shutdown are not lost
+			for {
+				select {
+				case op := <-e.buffer:
+					pending = append(pending, op)
+					continue
+				default:
+				}
+				break
+			}
+			if len(pending) > 0 {
+				flushCtx, cancel := context.WithTimeout(context.Background(), e.flushInterval)
+				e.flush(flushCtx, pending)
+				cancel()
+			}
+			return
+		}
+	}
+}
+
+// flush delivers the batch to every sink, retrying each sink independently
+// with doubling backoff. A sink that exhausts its attempts drops the batch;
+// the other sinks are unaffected.
+func (e *Exporter) flush(ctx context.Context, batch []Operation) {
+	for _, sink := range e.sinks {
+		logger := e.logger.With(zap.String("sink", sink.Name()), zap.Int("batch_size", len(batch)))
+
+		var err error
+		for attempt := 1; attempt <= maxDeliveryAttempts; attempt++ {
+			if err = sink.Send(ctx, batch); err == nil {
+				break
+			}
+			logger.Warn("Failed to deliver operations batch",
+				zap.Int("attempt", attempt), zap.Error(err))
+
+			if attempt == maxDeliveryAttempts {
+				break
+			}
+			select {
+			case <-time.After(retryBackoff << (attempt - 1)):
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err != nil {
+			logger.Error("Dropping operations batch after exhausting delivery attempts", zap.Error(err))
+		}
+	}
+}
+
+// SinksFromConfig builds the sink list from the audit export configuration
+func SinksFromConfig(cfg config.AuditConfig) ([]Sink, error) {
+	var sinks []Sink
+	if cfg.SyslogAddr != "" {
+		sink, err := NewSyslogSink(cfg.SyslogAddr, cfg.SyslogCAFile)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.HTTPURL != "" {
+		sinks = append(sinks, NewHTTPSink(cfg.HTTPURL, cfg.HTTPAuthHeader))
+	}
+	return sinks, nil
+}
+
+// ExportingStore wraps an operation store so every recorded operation is also
+// queued on the exporter
+type ExportingStore struct {
+	StoreInterface
+	exporter *Exporter
+}
+
+// NewExportingStore creates a store that records into the wrapped store and
+// forwards each operation to the exporter
+func NewExportingStore(store StoreInterface, exporter *Exporter) *ExportingStore {
+	return &ExportingStore{StoreInterface: store, exporter: exporter}
+}
+
+// Record stores the operation and queues it for export
+func (s *ExportingStore) Record(op Operation) Operation {
+	op = s.StoreInterface.Record(op)
+	s.exporter.Enqueue(op)
+	return op
+}
@@ -0,0 +1,137 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+)
+
+// recordingSink captures delivered batches and can fail a number of attempts
+type recordingSink struct {
+	mu       sync.Mutex
+	batches  [][]Operation
+	failures int
+}
+
+func (r *recordingSink) Name() string { return "recording" }
+
+func (r *recordingSink) Send(ctx context.Context, operations []Operation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		return errors.New("receiver unavailable")
+	}
+	r.batches = append(r.batches, operations)
+	return nil
+}
+
+func (r *recordingSink) delivered() [][]Operation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.batches
+}
+
+func TestExporterFlushesFullBatch(t *testing.T) {
+	sink := &recordingSink{}
+	exporter := NewExporter([]Sink{sink}, config.AuditConfig{BatchSize: 2, FlushSeconds: 60, BufferSize: 10}, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	exporter.Start(ctx)
+
+	exporter.Enqueue(Operation{ID: "1", Action: "create"})
+	exporter.Enqueue(Operation{ID: "2", Action: "delete"})
+
+	assert.Eventually(t, func() bool {
+		batches := sink.delivered()
+		return len(batches) == 1 && len(batches[0]) == 2
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestExporterRetriesFailedDelivery(t *testing.T) {
+	sink := &recordingSink{failures: 1}
+	exporter := NewExporter([]Sink{sink}, config.AuditConfig{BatchSize: 1, FlushSeconds: 60, BufferSize: 10}, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	exporter.Start(ctx)
+
+	exporter.Enqueue(Operation{ID: "1", Action: "create"})
+
+	assert.Eventually(t, func() bool {
+		return len(sink.delivered()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestHTTPSinkSendsBatch(t *testing.T) {
+	var gotAuth string
+	var gotBatch []Operation
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&gotBatch))
+	}))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, "Bearer token")
+	err := sink.Send(context.Background(), []Operation{
+		{ID: "1", Action: "create", Kind: "container", Outcome: OutcomeSuccess},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token", gotAuth)
+	require.Len(t, gotBatch, 1)
+	assert.Equal(t, "create", gotBatch[0].Action)
+}
+
+func TestHTTPSinkRejectsErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewHTTPSink(server.URL, "").Send(context.Background(), []Operation{{ID: "1"}})
+	assert.ErrorContains(t, err, "status 503")
+}
+
+func TestSyslogMessageFormat(t *testing.T) {
+	timestamp := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	success := syslogMessage(&Operation{ID: "1", Action: "create", Outcome: OutcomeSuccess, Timestamp: timestamp}, "host-1")
+	assert.True(t, strings.HasPrefix(success, "<110>1 2024-05-01T12:00:00.000Z host-1 k8s-service-provider - create - "))
+	assert.Contains(t, success, `"outcome":"success"`)
+
+	failure := syslogMessage(&Operation{ID: "2", Action: "delete", Outcome: OutcomeFailure, Timestamp: timestamp}, "host-1")
+	assert.True(t, strings.HasPrefix(failure, "<108>1 "))
+}
+
+func TestExportingStoreForwardsRecords(t *testing.T) {
+	sink := &recordingSink{}
+	exporter := NewExporter([]Sink{sink}, config.AuditConfig{BatchSize: 1, FlushSeconds: 60, BufferSize: 10}, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	exporter.Start(ctx)
+
+	store := NewExportingStore(NewStore(), exporter)
+	op := store.Record(Operation{Action: "create", Outcome: OutcomeSuccess})
+
+	assert.NotEmpty(t, op.ID)
+	assert.Len(t, store.List(nil), 1)
+	assert.Eventually(t, func() bool {
+		batches := sink.delivered()
+		return len(batches) == 1 && batches[0][0].ID == op.ID
+	}, 2*time.Second, 10*time.Millisecond)
+}
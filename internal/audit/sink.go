@@ -0,0 +1,195 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// Sink delivers a batch of operations to one SIEM destination. A sink makes
+// a single delivery attempt; retries and buffering are the exporter's job so
+// one slow destination does not block the others.
+type Sink interface {
+	Name() string
+	Send(ctx context.Context, operations []Operation) error
+}
+
+// syslog facility 13 (log audit) combined with the per-message severity,
+// per RFC 5424 section 6.2.1
+const (
+	syslogFacility        = 13
+	syslogSeverityInfo    = 6
+	syslogSeverityWarning = 4
+)
+
+// dialTimeout bounds establishing the TLS connection to the syslog receiver
+const dialTimeout = 10 * time.Second
+
+// SyslogSink writes operations as RFC 5424 messages over a TLS connection,
+// framed with octet counting per RFC 5425. The connection is dialed lazily
+// and redialed on the next delivery after a write failure.
+type SyslogSink struct {
+	mu       sync.Mutex
+	addr     string
+	hostname string
+	tlsConf  *tls.Config
+	conn     net.Conn
+}
+
+// NewSyslogSink creates a syslog sink targeting addr (host:port). When caFile
+// is set the receiver certificate is verified against that bundle instead of
+// the system roots.
+func NewSyslogSink(addr, caFile string) (*SyslogSink, error) {
+	tlsConf := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read syslog CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("syslog CA file %q contains no certificates", caFile)
+		}
+		tlsConf.RootCAs = pool
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+
+	return &SyslogSink{
+		addr:     addr,
+		hostname: hostname,
+		tlsConf:  tlsConf,
+	}, nil
+}
+
+// Name returns the sink identifier used in logs
+func (s *SyslogSink) Name() string {
+	return "syslog"
+}
+
+// Send writes each operation as one framed syslog message. On a write failure
+// the connection is dropped so the retried delivery starts with a fresh dial.
+func (s *SyslogSink) Send(ctx context.Context, operations []Operation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		dialer := &net.Dialer{Timeout: dialTimeout}
+		conn, err := tls.DialWithDialer(dialer, "tcp", s.addr, s.tlsConf)
+		if err != nil {
+			return fmt.Errorf("failed to connect to syslog receiver: %w", err)
+		}
+		s.conn = conn
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.conn.SetWriteDeadline(deadline)
+	}
+
+	for i := range operations {
+		message := syslogMessage(&operations[i], s.hostname)
+		if _, err := fmt.Fprintf(s.conn, "%d %s", len(message), message); err != nil {
+			_ = s.conn.Close()
+			s.conn = nil
+			return fmt.Errorf("failed to write syslog message: %w", err)
+		}
+	}
+	return nil
+}
+
+// Close releases the connection to the syslog receiver
+func (s *SyslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// syslogMessage renders an operation as an RFC 5424 message with the
+// operation JSON as the message body. Failures are emitted at warning
+// severity so receivers can alert on them without parsing the body.
+func syslogMessage(op *Operation, hostname string) string {
+	severity := syslogSeverityInfo
+	if op.Outcome == OutcomeFailure {
+		severity = syslogSeverityWarning
+	}
+	pri := syslogFacility*8 + severity
+
+	body, err := json.Marshal(op)
+	if err != nil {
+		body = []byte(fmt.Sprintf("%q", err.Error()))
+	}
+
+	return fmt.Sprintf("<%d>1 %s %s k8s-service-provider - %s - %s\n",
+		pri, op.Timestamp.UTC().Format("2006-01-02T15:04:05.000Z"), hostname, op.Action, body)
+}
+
+// httpTimeout bounds a single HTTP batch delivery attempt
+const httpTimeout = 10 * time.Second
+
+// HTTPSink POSTs operation batches as a JSON array to a configured URL, for
+// SIEM HTTP event collectors (Splunk HEC behind a transform, Elastic ingest
+// pipelines, and the like)
+type HTTPSink struct {
+	url        string
+	authHeader string
+	client     *http.Client
+}
+
+// NewHTTPSink creates an HTTP sink targeting the given URL. When authHeader
+// is set it is sent as the Authorization header on every request.
+func NewHTTPSink(url, authHeader string) *HTTPSink {
+	return &HTTPSink{
+		url:        url,
+		authHeader: authHeader,
+		client:     &http.Client{Timeout: httpTimeout},
+	}
+}
+
+// Name returns the sink identifier used in logs
+func (h *HTTPSink) Name() string {
+	return "http"
+}
+
+// Send POSTs the batch and treats any non-2xx response as a failure
+func (h *HTTPSink) Send(ctx context.Context, operations []Operation) error {
+	body, err := json.Marshal(operations)
+	if err != nil {
+		return fmt.Errorf("failed to encode operations: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build export request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if h.authHeader != "" {
+		request.Header.Set("Authorization", h.authHeader)
+	}
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to deliver operations: %w", err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("export endpoint returned status %d", response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package audit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Outcome represents the result of a recorded operation
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// Operation represents a single provisioning operation performed through the API
+type Operation struct {
+	ID           string    `json:"id"`
+	Timestamp    time.Time `json:"timestamp"`
+	Actor        string    `json:"actor,omitempty"`
+	Action       string    `json:"action"`
+	Kind         string    `json:"kind,omitempty"`
+	DeploymentID string    `json:"deploymentId,omitempty"`
+	Namespace    string    `json:"namespace,omitempty"`
+	Outcome      Outcome   `json:"outcome"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// Filter narrows down the operations returned by List
+type Filter struct {
+	Actor     string
+	Kind      string
+	Namespace string
+	Outcome   Outcome
+	From      time.Time
+	To        time.Time
+}
+
+// Matches reports whether the operation satisfies the filter
+func (f *Filter) Matches(op *Operation) bool {
+	if f.Actor != "" && op.Actor != f.Actor {
+		return false
+	}
+	if f.Kind != "" && op.Kind != f.Kind {
+		return false
+	}
+	if f.Namespace != "" && op.Namespace != f.Namespace {
+		return false
+	}
+	if f.Outcome != "" && op.Outcome != f.Outcome {
+		return false
+	}
+	if !f.From.IsZero() && op.Timestamp.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && op.Timestamp.After(f.To) {
+		return false
+	}
+	return true
+}
+
+// StoreInterface defines the interface for operation stores
+type StoreInterface interface {
+	Record(op Operation) Operation
+	List(filter *Filter) []Operation
+}
+
+// Store is an in-memory operation store safe for concurrent use
+type Store struct {
+	mu         sync.RWMutex
+	operations []Operation
+}
+
+// NewStore creates a new in-memory operation store
+func NewStore() *Store {
+	return &Store{}
+}
+
+// Record appends an operation to the store, assigning an ID and timestamp if unset
+func (s *Store) Record(op Operation) Operation {
+	if op.ID == "" {
+		op.ID = uuid.New().String()
+	}
+	if op.Timestamp.IsZero() {
+		op.Timestamp = time.Now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.operations = append(s.operations, op)
+	return op
+}
+
+// List returns all operations matching the filter, oldest first
+func (s *Store) List(filter *Filter) []Operation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	results := make([]Operation, 0, len(s.operations))
+	for i := range s.operations {
+		if filter == nil || filter.Matches(&s.operations[i]) {
+			results = append(results, s.operations[i])
+		}
+	}
+	return results
+}
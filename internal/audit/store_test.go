@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreRecordAssignsDefaults(t *testing.T) {
+	store := NewStore()
+
+	op := store.Record(Operation{
+		Action:  "create",
+		Kind:    "container",
+		Outcome: OutcomeSuccess,
+	})
+
+	assert.NotEmpty(t, op.ID)
+	assert.False(t, op.Timestamp.IsZero())
+}
+
+func TestStoreListFiltering(t *testing.T) {
+	store := NewStore()
+	base := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	store.Record(Operation{Actor: "alice", Action: "create", Kind: "container", Outcome: OutcomeSuccess, Timestamp: base})
+	store.Record(Operation{Actor: "bob", Action: "delete", Kind: "vm", Outcome: OutcomeFailure, Timestamp: base.Add(time.Hour)})
+	store.Record(Operation{Actor: "alice", Action: "update", Kind: "vm", Outcome: OutcomeSuccess, Timestamp: base.Add(2 * time.Hour)})
+
+	tests := []struct {
+		name   string
+		filter *Filter
+		want   int
+	}{
+		{"no filter", nil, 3},
+		{"by actor", &Filter{Actor: "alice"}, 2},
+		{"by kind", &Filter{Kind: "vm"}, 2},
+		{"by outcome", &Filter{Outcome: OutcomeFailure}, 1},
+		{"by time range", &Filter{From: base.Add(30 * time.Minute), To: base.Add(90 * time.Minute)}, 1},
+		{"combined", &Filter{Actor: "alice", Kind: "vm"}, 1},
+		{"no match", &Filter{Actor: "carol"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Len(t, store.List(tt.filter), tt.want)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// HeaderAPIKey carries the API key on service-to-service requests
+const HeaderAPIKey = "X-API-Key"
+
+// Scope limits what an API key may do
+type Scope string
+
+const (
+	ScopeReadOnly  Scope = "read-only"
+	ScopeReadWrite Scope = "read-write"
+)
+
+// APIKey is one configured service-to-service credential. Only the SHA-256
+// hash of the key material is held; the plaintext key never reaches the
+// provider's configuration.
+type APIKey struct {
+	Name  string
+	Scope Scope
+	hash  []byte
+}
+
+// Keychain holds the configured API keys and resolves presented key material
+type Keychain struct {
+	keys []APIKey
+}
+
+// NewKeychain parses the configured key entries, each of the form
+// name:scope:sha256-hex, where scope is read-only or read-write
+func NewKeychain(entries []string) (*Keychain, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]APIKey, 0, len(entries))
+	for _, entry := range entries {
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid API key entry %q: expected name:scope:sha256-hex", entry)
+		}
+
+		scope := Scope(parts[1])
+		if scope != ScopeReadOnly && scope != ScopeReadWrite {
+			return nil, fmt.Errorf("invalid API key scope %q for key %q: expected %q or %q",
+				parts[1], parts[0], ScopeReadOnly, ScopeReadWrite)
+		}
+
+		hash, err := hex.DecodeString(parts[2])
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid API key hash for key %q: expected 64 hex characters", parts[0])
+		}
+
+		keys = append(keys, APIKey{Name: parts[0], Scope: scope, hash: hash})
+	}
+
+	return &Keychain{keys: keys}, nil
+}
+
+// Lookup resolves the presented key material to a configured key. All
+// comparisons run in constant time so lookups do not leak which keys exist.
+func (k *Keychain) Lookup(material string) (*APIKey, bool) {
+	if k == nil {
+		return nil, false
+	}
+
+	digest := sha256.Sum256([]byte(material))
+	for i := range k.keys {
+		if subtle.ConstantTimeCompare(k.keys[i].hash, digest[:]) == 1 {
+			return &k.keys[i], true
+		}
+	}
+	return nil, false
+}
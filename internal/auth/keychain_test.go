@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+)
+
+// keyEntry builds a configuration entry for the given plaintext key
+func keyEntry(name string, scope Scope, material string) string {
+	digest := sha256.Sum256([]byte(material))
+	return name + ":" + string(scope) + ":" + hex.EncodeToString(digest[:])
+}
+
+func TestNewKeychainParsesEntries(t *testing.T) {
+	keychain, err := NewKeychain([]string{
+		keyEntry("ci", ScopeReadWrite, "ci-secret"),
+		keyEntry("monitor", ScopeReadOnly, "monitor-secret"),
+	})
+	require.NoError(t, err)
+
+	key, ok := keychain.Lookup("ci-secret")
+	require.True(t, ok)
+	assert.Equal(t, "ci", key.Name)
+	assert.Equal(t, ScopeReadWrite, key.Scope)
+
+	_, ok = keychain.Lookup("wrong-secret")
+	assert.False(t, ok)
+}
+
+func TestNewKeychainEmpty(t *testing.T) {
+	keychain, err := NewKeychain(nil)
+	require.NoError(t, err)
+	assert.Nil(t, keychain)
+
+	_, ok := keychain.Lookup("anything")
+	assert.False(t, ok)
+}
+
+func TestNewKeychainRejectsMalformedEntries(t *testing.T) {
+	_, err := NewKeychain([]string{"ci:read-write"})
+	assert.ErrorContains(t, err, "expected name:scope:sha256-hex")
+
+	_, err = NewKeychain([]string{"ci:admin:" + hex.EncodeToString(make([]byte, 32))})
+	assert.ErrorContains(t, err, "invalid API key scope")
+
+	_, err = NewKeychain([]string{"ci:read-write:nothex"})
+	assert.ErrorContains(t, err, "invalid API key hash")
+}
+
+func TestMiddlewareAcceptsAPIKey(t *testing.T) {
+	keychain, err := NewKeychain([]string{keyEntry("ci", ScopeReadWrite, "ci-secret")})
+	require.NoError(t, err)
+
+	var subject string
+	var scope Scope
+	handler := NewAuthenticator(nil, keychain).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if creator, ok := identity.FromContext(r.Context()); ok {
+			subject = creator.Subject
+		}
+		scope, _ = ScopeFromContext(r.Context())
+	}))
+
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/deployments", nil)
+	request.Header.Set(HeaderAPIKey, "ci-secret")
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "api-key:ci", subject)
+	assert.Equal(t, ScopeReadWrite, scope)
+}
+
+func TestMiddlewareEnforcesReadOnlyScope(t *testing.T) {
+	keychain, err := NewKeychain([]string{keyEntry("monitor", ScopeReadOnly, "monitor-secret")})
+	require.NoError(t, err)
+
+	handler := NewAuthenticator(nil, keychain).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	// Reads pass
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+	request.Header.Set(HeaderAPIKey, "monitor-secret")
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	// Mutations are forbidden
+	request = httptest.NewRequest(http.MethodDelete, "/api/v1/deployments/abc", nil)
+	request.Header.Set(HeaderAPIKey, "monitor-secret")
+	response = httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+	assert.Equal(t, http.StatusForbidden, response.Code)
+	assert.Contains(t, response.Body.String(), "read-only")
+}
+
+func TestMiddlewareRejectsUnknownAPIKey(t *testing.T) {
+	keychain, err := NewKeychain([]string{keyEntry("ci", ScopeReadWrite, "ci-secret")})
+	require.NoError(t, err)
+
+	handler := NewAuthenticator(nil, keychain).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler should not be reached")
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+	request.Header.Set(HeaderAPIKey, "forged")
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+}
+
+func TestMiddlewareRequiresSomeCredential(t *testing.T) {
+	keychain, err := NewKeychain([]string{keyEntry("ci", ScopeReadWrite, "ci-secret")})
+	require.NoError(t, err)
+
+	handler := NewAuthenticator(nil, keychain).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler should not be reached")
+	}))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil))
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+}
@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+)
+
+// Authenticator authenticates API requests by either scheme: an API key for
+// service-to-service callers, or an OIDC bearer token for everyone else
+type Authenticator struct {
+	verifier *Verifier
+	keychain *Keychain
+}
+
+// NewAuthenticator combines the configured schemes; either may be nil
+func NewAuthenticator(verifier *Verifier, keychain *Keychain) *Authenticator {
+	return &Authenticator{verifier: verifier, keychain: keychain}
+}
+
+// scopeContextKey is an unexported type to avoid collisions with other context values
+type scopeContextKey struct{}
+
+// WithScope returns a context carrying the caller's authorized scope
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, scope)
+}
+
+// ScopeFromContext returns the caller's authorized scope, if one was attached
+func ScopeFromContext(ctx context.Context) (Scope, bool) {
+	scope, ok := ctx.Value(scopeContextKey{}).(Scope)
+	return scope, ok
+}
+
+// Middleware rejects requests without valid credentials and attaches the
+// authenticated identity and scope to the request context. Health endpoints
+// stay open so probes keep working, as do CORS preflight requests. A nil
+// authenticator leaves the handler untouched, so the middleware can be
+// applied unconditionally.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	if a == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions || strings.HasSuffix(r.URL.Path, "/health") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if material := r.Header.Get(HeaderAPIKey); material != "" {
+			a.serveWithAPIKey(w, r, next, material)
+			return
+		}
+
+		if a.verifier == nil {
+			writeUnauthorized(w, "Request has no API key")
+			return
+		}
+
+		token := bearerToken(r)
+		if token == "" {
+			writeUnauthorized(w, "Request has no bearer token")
+			return
+		}
+
+		claims, err := a.verifier.Verify(r.Context(), token)
+		if err != nil {
+			writeUnauthorized(w, err.Error())
+			return
+		}
+
+		ctx := identity.WithCreator(r.Context(), &models.Creator{Subject: claims.Subject, Email: claims.Email})
+		next.ServeHTTP(w, r.WithContext(WithScope(ctx, ScopeReadWrite)))
+	})
+}
+
+// Authenticate validates raw credential material through the same schemes as
+// the HTTP middleware, returning a context carrying the authenticated
+// identity and scope. Transports without HTTP semantics (gRPC) use this so
+// every listener honours the same keychain and verifier.
+func (a *Authenticator) Authenticate(ctx context.Context, apiKey, bearer string) (context.Context, error) {
+	if apiKey != "" {
+		key, ok := a.keychain.Lookup(apiKey)
+		if !ok {
+			return nil, errors.New("unknown API key")
+		}
+		ctx = identity.WithCreator(ctx, &models.Creator{Subject: "api-key:" + key.Name})
+		return WithScope(ctx, key.Scope), nil
+	}
+
+	if a.verifier == nil {
+		return nil, errors.New("request has no API key")
+	}
+	if bearer == "" {
+		return nil, errors.New("request has no bearer token")
+	}
+
+	claims, err := a.verifier.Verify(ctx, bearer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = identity.WithCreator(ctx, &models.Creator{Subject: claims.Subject, Email: claims.Email})
+	return WithScope(ctx, ScopeReadWrite), nil
+}
+
+// serveWithAPIKey authenticates the request with the presented key material,
+// enforcing the key's scope against the request method
+func (a *Authenticator) serveWithAPIKey(w http.ResponseWriter, r *http.Request, next http.Handler, material string) {
+	key, ok := a.keychain.Lookup(material)
+	if !ok {
+		writeUnauthorized(w, "Unknown API key")
+		return
+	}
+
+	if key.Scope == ScopeReadOnly && isMutating(r.Method) {
+		models.WriteProblem(w, models.NewProblem(http.StatusForbidden, models.CodeForbidden,
+			"API key scope does not permit this operation", "key "+key.Name+" is read-only"))
+		return
+	}
+
+	ctx := identity.WithCreator(r.Context(), &models.Creator{Subject: "api-key:" + key.Name})
+	next.ServeHTTP(w, r.WithContext(WithScope(ctx, key.Scope)))
+}
+
+// isMutating reports whether the HTTP method changes state
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// bearerToken extracts the token from the Authorization header
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) > 7 && strings.EqualFold(header[:7], "Bearer ") {
+		return header[7:]
+	}
+	return ""
+}
+
+// writeUnauthorized writes the standard problem document with a 401 status
+func writeUnauthorized(w http.ResponseWriter, details string) {
+	models.WriteProblem(w, models.NewProblem(http.StatusUnauthorized, models.CodeUnauthorized,
+		"Authentication required", details))
+}
@@ -0,0 +1,229 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+)
+
+// fakeIssuer serves an OIDC discovery document and a JWKS for a test key,
+// and mints tokens signed with it
+type fakeIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer := &fakeIssuer{key: key, kid: "test-key"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer.server.URL,
+			"jwks_uri": issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"use": "sig",
+				"kid": issuer.kid,
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+// mint signs a token with the issuer key, applying the claim overrides on
+// top of a valid default set
+func (f *fakeIssuer) mint(t *testing.T, overrides map[string]interface{}) string {
+	t.Helper()
+
+	claims := map[string]interface{}{
+		"iss":   f.server.URL,
+		"sub":   "alice",
+		"email": "alice@example.com",
+		"aud":   "k8s-service-provider",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+	for name, value := range overrides {
+		claims[name] = value
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": f.kid})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, f.key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (f *fakeIssuer) verifier() *Verifier {
+	return NewVerifier(config.AuthConfig{
+		IssuerURL: f.server.URL,
+		Audience:  "k8s-service-provider",
+	}, zap.NewNop())
+}
+
+func TestVerifyValidToken(t *testing.T) {
+	issuer := newFakeIssuer(t)
+
+	claims, err := issuer.verifier().Verify(context.Background(), issuer.mint(t, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", claims.Subject)
+	assert.Equal(t, "alice@example.com", claims.Email)
+}
+
+func TestVerifyAudienceList(t *testing.T) {
+	issuer := newFakeIssuer(t)
+
+	token := issuer.mint(t, map[string]interface{}{"aud": []string{"other", "k8s-service-provider"}})
+	_, err := issuer.verifier().Verify(context.Background(), token)
+	assert.NoError(t, err)
+}
+
+func TestVerifyRejectsExpiredToken(t *testing.T) {
+	issuer := newFakeIssuer(t)
+
+	token := issuer.mint(t, map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()})
+	_, err := issuer.verifier().Verify(context.Background(), token)
+	assert.ErrorContains(t, err, "expired")
+}
+
+func TestVerifyRejectsWrongIssuer(t *testing.T) {
+	issuer := newFakeIssuer(t)
+
+	token := issuer.mint(t, map[string]interface{}{"iss": "https://evil.example.com"})
+	_, err := issuer.verifier().Verify(context.Background(), token)
+	assert.ErrorContains(t, err, "issued by")
+}
+
+func TestVerifyRejectsWrongAudience(t *testing.T) {
+	issuer := newFakeIssuer(t)
+
+	token := issuer.mint(t, map[string]interface{}{"aud": "someone-else"})
+	_, err := issuer.verifier().Verify(context.Background(), token)
+	assert.ErrorContains(t, err, "audience")
+}
+
+func TestVerifyRejectsTamperedToken(t *testing.T) {
+	issuer := newFakeIssuer(t)
+
+	token := issuer.mint(t, nil)
+	tampered := token[:len(token)-4] + "AAAA"
+	_, err := issuer.verifier().Verify(context.Background(), tampered)
+	assert.Error(t, err)
+}
+
+func TestVerifyRejectsUnknownKey(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	verifier := issuer.verifier()
+
+	// Prime the key cache, then present a token from a different key
+	_, err := verifier.Verify(context.Background(), issuer.mint(t, nil))
+	require.NoError(t, err)
+
+	other := newFakeIssuer(t)
+	other.kid = "other-key"
+	token := other.mint(t, map[string]interface{}{"iss": issuer.server.URL})
+	_, err = verifier.Verify(context.Background(), token)
+	assert.ErrorContains(t, err, "unknown key")
+}
+
+func TestVerifyRejectsUnsupportedAlgorithm(t *testing.T) {
+	issuer := newFakeIssuer(t)
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"iss":%q,"sub":"alice"}`, issuer.server.URL)))
+	_, err := issuer.verifier().Verify(context.Background(), header+"."+payload+".")
+	assert.ErrorContains(t, err, "unsupported signing algorithm")
+}
+
+func TestMiddlewareAttachesIdentity(t *testing.T) {
+	issuer := newFakeIssuer(t)
+
+	var subject string
+	handler := NewAuthenticator(issuer.verifier(), nil).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if creator, ok := identity.FromContext(r.Context()); ok {
+			subject = creator.Subject
+		}
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+	request.Header.Set("Authorization", "Bearer "+issuer.mint(t, nil))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "alice", subject)
+}
+
+func TestMiddlewareRejectsMissingToken(t *testing.T) {
+	issuer := newFakeIssuer(t)
+
+	handler := NewAuthenticator(issuer.verifier(), nil).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler should not be reached")
+	}))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil))
+
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+	assert.Contains(t, response.Body.String(), "UNAUTHORIZED")
+}
+
+func TestMiddlewareAllowsHealthCheck(t *testing.T) {
+	issuer := newFakeIssuer(t)
+
+	handler := NewAuthenticator(issuer.verifier(), nil).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/api/v1/health", nil))
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+func TestNilAuthenticatorMiddlewareIsPassthrough(t *testing.T) {
+	var authenticator *Authenticator
+	handler := authenticator.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil))
+	assert.Equal(t, http.StatusNoContent, response.Code)
+}
@@ -2,19 +2,65 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Config holds the application configuration
 type Config struct {
-	Server     ServerConfig
-	Kubernetes KubernetesConfig
-	Log        LogConfig
+	Server          ServerConfig
+	NamespaceServer NamespaceServerConfig
+	Kubernetes      KubernetesConfig
+	VMShape         VMShapeConfig
+	Workload        WorkloadConfig
+	Report          ReportConfig
+	Audit           AuditConfig
+	Auth            AuthConfig
+	RateLimit       RateLimitConfig
+	TLS             TLSConfig
+	Cache           CacheConfig
+	Store           StoreConfig
+	Reconciler      ReconcilerConfig
+	GC              GCConfig
+	NetworkIdentity NetworkIdentityConfig
+	Namespaces      NamespacePolicyConfig
+	SSHKeys         SSHKeysConfig
+	Defaults        DefaultsConfig
+	Log             LogConfig
 }
 
 // ServerConfig holds HTTP server configuration
 type ServerConfig struct {
+	Port         int    `yaml:"port"`
+	GRPCPort     int    `yaml:"grpcPort"`
+	Host         string `yaml:"host"`
+	ReadTimeout  int    `yaml:"readTimeout"`
+	WriteTimeout int    `yaml:"writeTimeout"`
+
+	// Mode selects how the two HTTP APIs are served: "unified" mounts the
+	// deployment and namespace APIs on Port with path-based routing, "split"
+	// keeps the namespace API on its own port.
+	Mode string `yaml:"mode"`
+
+	// GinMode selects the Gin engine mode ("release", "debug" or "test").
+	// TrustedProxies lists the proxy IPs or CIDRs whose forwarded headers
+	// are honoured when resolving client IPs; an empty list trusts none,
+	// so the connection's remote address is used. ForwardedByClientIP
+	// disables forwarded-header handling entirely when false.
+	GinMode             string   `yaml:"ginMode"`
+	TrustedProxies      []string `yaml:"trustedProxies"`
+	ForwardedByClientIP bool     `yaml:"forwardedByClientIP"`
+}
+
+// NamespaceServerConfig holds the HTTP server settings for the namespace API
+// when it runs on its own port in split server mode; unified mode serves the
+// namespace API from the deployment server and ignores these settings
+type NamespaceServerConfig struct {
 	Port         int    `yaml:"port"`
 	Host         string `yaml:"host"`
 	ReadTimeout  int    `yaml:"readTimeout"`
@@ -25,6 +71,261 @@ type ServerConfig struct {
 type KubernetesConfig struct {
 	ConfigPath string `yaml:"configPath"`
 	InCluster  bool   `yaml:"inCluster"`
+
+	// Separate client-side rate limits for read-heavy operations (list/get/watch)
+	// and writes, so dashboard read load cannot starve provisioning writes.
+	// ReadConfigPath optionally points at a kubeconfig with distinct credentials
+	// for the read client; it defaults to the same credentials as writes.
+	ReadQPS        float64 `yaml:"readQPS"`
+	ReadBurst      int     `yaml:"readBurst"`
+	WriteQPS       float64 `yaml:"writeQPS"`
+	WriteBurst     int     `yaml:"writeBurst"`
+	ReadConfigPath string  `yaml:"readConfigPath"`
+
+	// Clusters registers additional target clusters as "name=kubeconfig-path"
+	// entries. Deployments naming one via metadata.cluster are placed on that
+	// cluster instead of the provider's own.
+	Clusters []string `yaml:"clusters"`
+}
+
+// VMShapeConfig holds operator-defined limits for VM shapes, applied on top
+// of the request binding limits. RAM values are in GB per the VM spec.
+// A zero value disables the corresponding check.
+type VMShapeConfig struct {
+	MinCPU         int     `yaml:"minCPU"`
+	MaxCPU         int     `yaml:"maxCPU"`
+	MinRAM         int     `yaml:"minRAM"`
+	MaxRAM         int     `yaml:"maxRAM"`
+	MinRAMPerCPU   float64 `yaml:"minRAMPerCPU"`
+	MaxRAMPerCPU   float64 `yaml:"maxRAMPerCPU"`
+	RAMGranularity int     `yaml:"ramGranularity"`
+	// SnapshotBeforeUpdate snapshots a VM's persistent disks before the
+	// destructive delete-and-recreate update cycle so they can be restored
+	SnapshotBeforeUpdate bool `yaml:"snapshotBeforeUpdate"`
+	// FlavorsFile points at a YAML file of operator-defined VM flavors,
+	// replacing the built-in small/medium/large catalog
+	FlavorsFile string `yaml:"flavorsFile"`
+	// DefaultArchitecture is the guest architecture used when the VM spec
+	// does not pick one (amd64, arm64 or s390x)
+	DefaultArchitecture string `yaml:"defaultArchitecture"`
+}
+
+// WorkloadConfig holds hardening policy applied to tenant workloads.
+// DisableSATokenAutomount turns off automountServiceAccountToken on tenant
+// pods; workloads that need API access opt back in per request with a bound
+// projected token. The resource fields are Kubernetes quantities: the
+// defaults fill unset container limits and the ceilings reject requests or
+// limits above them. Empty values disable the corresponding policy.
+// StrictSpec makes cluster capability checks hard failures: spec fields the
+// cluster cannot honor (extended resources no node advertises, an ingress
+// with no controller installed, an unknown storage class) are rejected
+// instead of logged and passed through.
+// DefaultTolerations lists tolerations injected into every deployment, so
+// tenant workloads can land on dedicated, tainted node pools.
+type WorkloadConfig struct {
+	DisableSATokenAutomount bool   `yaml:"disableSATokenAutomount"`
+	DefaultCPULimit         string `yaml:"defaultCPULimit"`
+	DefaultMemoryLimit      string `yaml:"defaultMemoryLimit"`
+	MaxCPU                  string `yaml:"maxCPU"`
+	MaxMemory               string `yaml:"maxMemory"`
+	NameConflictPolicy      string `yaml:"nameConflictPolicy"` // "reject" or "suffix"
+	StrictSpec              bool   `yaml:"strictSpec"`
+	DefaultTolerations      string `yaml:"defaultTolerations"` // comma-separated "key[=value][:effect]"
+	PodSecurityProfile      string `yaml:"podSecurityProfile"` // "" (none) or "restricted"
+}
+
+// Gin engine modes accepted by ServerConfig.GinMode. These mirror the gin
+// package constants so config does not depend on gin directly.
+const (
+	GinModeRelease = "release"
+	GinModeDebug   = "debug"
+	GinModeTest    = "test"
+)
+
+// Server modes accepted by ServerConfig.Mode
+const (
+	ServerModeUnified = "unified" // both APIs on one port, routed by path
+	ServerModeSplit   = "split"   // namespace API on its own port
+)
+
+// Name uniqueness policies applied when a create request reuses a
+// metadata.name already taken in the target namespace
+const (
+	NameConflictReject = "reject" // refuse the request with a conflict error
+	NameConflictSuffix = "suffix" // make the name unique with a generated suffix
+)
+
+// Pod security profiles accepted by WorkloadConfig.PodSecurityProfile. The
+// restricted profile mirrors the Pod Security Standards "restricted" level:
+// generated pods run as non-root with all capabilities dropped, and specs
+// that explicitly violate the profile are rejected.
+const (
+	PodSecurityRestricted = "restricted"
+)
+
+// CacheConfig controls the informer-backed read cache. When enabled,
+// deployment and VM get/list reads are served from shared informer stores
+// that watch only managed resources, falling back to live LIST calls until
+// the stores sync. ResyncSeconds bounds how often the informers re-list to
+// repair any drift missed by the watches.
+type CacheConfig struct {
+	Enabled       bool `yaml:"enabled"`
+	ResyncSeconds int  `yaml:"resyncSeconds"`
+}
+
+// Store driver values selecting the deployment record store backend
+const (
+	StoreDriverMemory   = "memory"
+	StoreDriverPostgres = "postgres"
+	StoreDriverSQLite   = "sqlite"
+)
+
+// StoreConfig holds the optional deployment record store settings. An empty
+// driver disables the store; "memory" keeps records in process memory, and
+// "postgres" or "sqlite" persist them through database/sql using the pure-Go
+// drivers built into the binary.
+type StoreConfig struct {
+	Driver string `yaml:"driver"`
+	DSN    string `yaml:"dsn"`
+}
+
+// ReconcilerConfig controls the ManagedDeployment reconciler. When enabled,
+// deployment records are written as ManagedDeployment custom resources (the
+// CRD from deploy/crds must be installed) and a controller loop recreates
+// deployments whose cluster objects were removed out-of-band.
+// IntervalSeconds bounds how quickly such drift is corrected.
+type ReconcilerConfig struct {
+	Enabled         bool `yaml:"enabled"`
+	IntervalSeconds int  `yaml:"intervalSeconds"`
+}
+
+// GCConfig controls the background orphan sweeper. When enabled, managed
+// secondary objects whose deployment lost its primary object are detected
+// periodically; with Remove set they are deleted, otherwise only reported.
+// The /maintenance/orphans endpoint triggers the same sweep on demand.
+type GCConfig struct {
+	Enabled         bool `yaml:"enabled"`
+	IntervalSeconds int  `yaml:"intervalSeconds"`
+	Remove          bool `yaml:"remove"`
+}
+
+// NetworkIdentityConfig holds the optional VM network identity registry
+// configuration. When enabled, each VM deployment keeps a stable MAC address
+// and hostname across recreates, persisted in a ConfigMap in Namespace.
+type NetworkIdentityConfig struct {
+	Enabled   bool   `yaml:"enabled"`
+	Namespace string `yaml:"namespace"`
+}
+
+// NamespacePolicyConfig restricts the namespaces deployments may target.
+// Denied and Allowed are comma-separated glob patterns: a namespace matching
+// a denied pattern is always refused, and when Allowed is set, namespaces
+// matching no allowed pattern are refused too. AutoCreate gates the implicit
+// creation of namespaces that do not exist yet.
+type NamespacePolicyConfig struct {
+	Denied     string `yaml:"denied"`
+	Allowed    string `yaml:"allowed"`
+	AutoCreate bool   `yaml:"autoCreate"`
+}
+
+// SSHKeysConfig locates the namespace holding the reusable SSH public key
+// registry. Keys registered through the API are stored there as Secrets and
+// referenced by name from VM specs in any namespace.
+type SSHKeysConfig struct {
+	Namespace string `yaml:"namespace"`
+}
+
+// DefaultsConfig points at the directory holding operator-provided defaults
+// profile files (one YAML profile per file). An empty directory path disables
+// profile defaulting.
+type DefaultsConfig struct {
+	ProfilesDir string `yaml:"profilesDir"`
+}
+
+// Reporting intervals supported by the digest scheduler
+const (
+	ReportIntervalDaily  = "daily"
+	ReportIntervalWeekly = "weekly"
+)
+
+// ReportConfig holds the namespace digest reporting configuration. When
+// enabled, per-namespace summaries of managed deployments, resource requests
+// and quota usage are delivered on the configured cadence to a webhook, an
+// email destination, or both.
+type ReportConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	Interval   string `yaml:"interval"` // "daily" or "weekly"
+	At         string `yaml:"at"`       // HH:MM time of day for digest runs
+	Timezone   string `yaml:"timezone"` // IANA timezone name, defaults to UTC
+	WebhookURL string `yaml:"webhookURL"`
+	SMTPAddr   string `yaml:"smtpAddr"`
+	EmailFrom  string `yaml:"emailFrom"`
+	EmailTo    string `yaml:"emailTo"` // comma-separated recipient list
+}
+
+// AuditConfig holds the SIEM export configuration for the operation audit
+// trail. When export is enabled, recorded operations are forwarded in batches
+// to the configured sinks — an RFC 5424 syslog receiver over TLS, a generic
+// HTTP collector endpoint, or both — in addition to the in-memory store.
+// The request log settings enable a separate router-level trail of every
+// mutating HTTP request, written to a local file, an HTTP collector, or both.
+type AuditConfig struct {
+	ExportEnabled  bool   `yaml:"exportEnabled"`
+	SyslogAddr     string `yaml:"syslogAddr"`   // host:port of the TLS syslog receiver
+	SyslogCAFile   string `yaml:"syslogCAFile"` // optional CA bundle verifying the receiver
+	HTTPURL        string `yaml:"httpURL"`
+	HTTPAuthHeader string `yaml:"httpAuthHeader"` // optional Authorization header value
+	BatchSize      int    `yaml:"batchSize"`
+	FlushSeconds   int    `yaml:"flushSeconds"`
+	BufferSize     int    `yaml:"bufferSize"`
+
+	RequestLogPath       string `yaml:"requestLogPath"` // JSON-lines file receiving audit events
+	RequestLogURL        string `yaml:"requestLogURL"`
+	RequestLogAuthHeader string `yaml:"requestLogAuthHeader"` // optional Authorization header value
+}
+
+// AuthConfig holds the API authentication settings. When enabled, every API
+// request must carry either a bearer token signed by the configured OIDC
+// issuer — whose audience, when set, must appear in the token's aud claim —
+// or one of the configured API keys. Keys are listed as name:scope:sha256-hex
+// entries, so only hashes of the key material reach the configuration.
+//
+// Tenants optionally scopes principals to namespaces. Each entry maps a
+// subject to the namespaces it owns (subject:ns1;ns2, with "*" granting all);
+// principals without an entry own no namespaces.
+type AuthConfig struct {
+	Enabled   bool     `yaml:"enabled"`
+	IssuerURL string   `yaml:"issuerURL"`
+	Audience  string   `yaml:"audience"`
+	APIKeys   []string `yaml:"apiKeys"`
+	Tenants   []string `yaml:"tenants"`
+}
+
+// RateLimitConfig holds the token-bucket rate limits applied to both HTTP
+// servers. The global bucket caps the total request rate; the per-client
+// buckets cap each caller — keyed by API key or verified subject, falling
+// back to client IP — so one runaway client cannot exhaust the global
+// budget. Rates are requests per second.
+type RateLimitConfig struct {
+	Enabled     bool    `yaml:"enabled"`
+	RPS         float64 `yaml:"rps"`
+	Burst       int     `yaml:"burst"`
+	ClientRPS   float64 `yaml:"clientRPS"`
+	ClientBurst int     `yaml:"clientBurst"`
+}
+
+// TLSConfig holds the TLS settings for the HTTP API listeners. When enabled
+// the servers serve HTTPS with the given certificate. Setting ClientCAFile
+// additionally requires every client to present a certificate signed by that
+// CA (mutual TLS); AllowedClients then optionally restricts which certificate
+// identities are accepted — an entry matches the certificate Common Name or
+// any DNS SAN, and an empty list accepts any certificate the CA signed.
+type TLSConfig struct {
+	Enabled        bool     `yaml:"enabled"`
+	CertFile       string   `yaml:"certFile"`
+	KeyFile        string   `yaml:"keyFile"`
+	ClientCAFile   string   `yaml:"clientCAFile"`
+	AllowedClients []string `yaml:"allowedClients"`
 }
 
 // LogConfig holds logging configuration
@@ -39,13 +340,133 @@ func LoadConfig() *Config {
 	config := &Config{
 		Server: ServerConfig{
 			Port:         getEnvAsInt("SERVER_PORT", 8080),
+			GRPCPort:     getEnvAsInt("SERVER_GRPC_PORT", 9090),
 			Host:         getEnv("SERVER_HOST", "0.0.0.0"),
 			ReadTimeout:  getEnvAsInt("SERVER_READ_TIMEOUT", 30),
 			WriteTimeout: getEnvAsInt("SERVER_WRITE_TIMEOUT", 30),
+
+			Mode: getEnv("SERVER_MODE", ServerModeUnified),
+
+			GinMode:             getEnv("SERVER_GIN_MODE", GinModeRelease),
+			TrustedProxies:      getEnvAsSlice("SERVER_TRUSTED_PROXIES", nil),
+			ForwardedByClientIP: getEnvAsBool("SERVER_FORWARDED_BY_CLIENT_IP", true),
+		},
+		NamespaceServer: NamespaceServerConfig{
+			Port:         getEnvAsInt("NAMESPACE_SERVER_PORT", 8081),
+			Host:         getEnv("NAMESPACE_SERVER_HOST", "0.0.0.0"),
+			ReadTimeout:  getEnvAsInt("NAMESPACE_SERVER_READ_TIMEOUT", 30),
+			WriteTimeout: getEnvAsInt("NAMESPACE_SERVER_WRITE_TIMEOUT", 30),
 		},
 		Kubernetes: KubernetesConfig{
-			ConfigPath: getEnv("KUBECONFIG", ""),
-			InCluster:  getEnvAsBool("IN_CLUSTER", false),
+			ConfigPath:     getEnv("KUBECONFIG", ""),
+			InCluster:      getEnvAsBool("IN_CLUSTER", false),
+			ReadQPS:        getEnvAsFloat("K8S_READ_QPS", 20),
+			ReadBurst:      getEnvAsInt("K8S_READ_BURST", 40),
+			WriteQPS:       getEnvAsFloat("K8S_WRITE_QPS", 10),
+			WriteBurst:     getEnvAsInt("K8S_WRITE_BURST", 20),
+			ReadConfigPath: getEnv("K8S_READ_KUBECONFIG", ""),
+			Clusters:       getEnvAsSlice("K8S_CLUSTERS", nil),
+		},
+		VMShape: VMShapeConfig{
+			MinCPU:         getEnvAsInt("VM_MIN_CPU", 1),
+			MaxCPU:         getEnvAsInt("VM_MAX_CPU", 32),
+			MinRAM:         getEnvAsInt("VM_MIN_RAM", 1),
+			MaxRAM:         getEnvAsInt("VM_MAX_RAM", 32),
+			MinRAMPerCPU:   getEnvAsFloat("VM_MIN_RAM_PER_CPU", 1),
+			MaxRAMPerCPU:   getEnvAsFloat("VM_MAX_RAM_PER_CPU", 8),
+			RAMGranularity: getEnvAsInt("VM_RAM_GRANULARITY", 1),
+
+			SnapshotBeforeUpdate: getEnvAsBool("VM_SNAPSHOT_BEFORE_UPDATE", true),
+			FlavorsFile:          getEnv("VM_FLAVORS_FILE", ""),
+			DefaultArchitecture:  getEnv("VM_DEFAULT_ARCHITECTURE", "amd64"),
+		},
+		Workload: WorkloadConfig{
+			DisableSATokenAutomount: getEnvAsBool("WORKLOAD_DISABLE_SA_TOKEN_AUTOMOUNT", true),
+			DefaultCPULimit:         getEnv("WORKLOAD_DEFAULT_CPU_LIMIT", ""),
+			DefaultMemoryLimit:      getEnv("WORKLOAD_DEFAULT_MEMORY_LIMIT", ""),
+			MaxCPU:                  getEnv("WORKLOAD_MAX_CPU", ""),
+			MaxMemory:               getEnv("WORKLOAD_MAX_MEMORY", ""),
+			NameConflictPolicy:      getEnv("WORKLOAD_NAME_CONFLICT_POLICY", NameConflictReject),
+			StrictSpec:              getEnvAsBool("WORKLOAD_STRICT_SPEC", true),
+			DefaultTolerations:      getEnv("WORKLOAD_DEFAULT_TOLERATIONS", ""),
+			PodSecurityProfile:      getEnv("WORKLOAD_POD_SECURITY_PROFILE", ""),
+		},
+		Report: ReportConfig{
+			Enabled:    getEnvAsBool("REPORT_ENABLED", false),
+			Interval:   getEnv("REPORT_INTERVAL", ReportIntervalDaily),
+			At:         getEnv("REPORT_AT", "06:00"),
+			Timezone:   getEnv("REPORT_TIMEZONE", ""),
+			WebhookURL: getEnv("REPORT_WEBHOOK_URL", ""),
+			SMTPAddr:   getEnv("REPORT_SMTP_ADDR", ""),
+			EmailFrom:  getEnv("REPORT_EMAIL_FROM", ""),
+			EmailTo:    getEnv("REPORT_EMAIL_TO", ""),
+		},
+		Audit: AuditConfig{
+			ExportEnabled:  getEnvAsBool("AUDIT_EXPORT_ENABLED", false),
+			SyslogAddr:     getEnv("AUDIT_SYSLOG_ADDR", ""),
+			SyslogCAFile:   getEnv("AUDIT_SYSLOG_CA_FILE", ""),
+			HTTPURL:        getEnv("AUDIT_HTTP_URL", ""),
+			HTTPAuthHeader: getEnv("AUDIT_HTTP_AUTH_HEADER", ""),
+			BatchSize:      getEnvAsInt("AUDIT_EXPORT_BATCH_SIZE", 50),
+			FlushSeconds:   getEnvAsInt("AUDIT_EXPORT_FLUSH_SECONDS", 5),
+			BufferSize:     getEnvAsInt("AUDIT_EXPORT_BUFFER_SIZE", 1000),
+
+			RequestLogPath:       getEnv("AUDIT_REQUEST_LOG_PATH", ""),
+			RequestLogURL:        getEnv("AUDIT_REQUEST_LOG_URL", ""),
+			RequestLogAuthHeader: getEnv("AUDIT_REQUEST_LOG_AUTH_HEADER", ""),
+		},
+		Auth: AuthConfig{
+			Enabled:   getEnvAsBool("AUTH_ENABLED", false),
+			IssuerURL: getEnv("AUTH_OIDC_ISSUER", ""),
+			Audience:  getEnv("AUTH_AUDIENCE", ""),
+			APIKeys:   getEnvAsSlice("AUTH_API_KEYS", nil),
+			Tenants:   getEnvAsSlice("AUTH_TENANTS", nil),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:     getEnvAsBool("RATE_LIMIT_ENABLED", false),
+			RPS:         getEnvAsFloat("RATE_LIMIT_RPS", 50),
+			Burst:       getEnvAsInt("RATE_LIMIT_BURST", 100),
+			ClientRPS:   getEnvAsFloat("RATE_LIMIT_CLIENT_RPS", 10),
+			ClientBurst: getEnvAsInt("RATE_LIMIT_CLIENT_BURST", 20),
+		},
+		TLS: TLSConfig{
+			Enabled:        getEnvAsBool("TLS_ENABLED", false),
+			CertFile:       getEnv("TLS_CERT_FILE", ""),
+			KeyFile:        getEnv("TLS_KEY_FILE", ""),
+			ClientCAFile:   getEnv("TLS_CLIENT_CA_FILE", ""),
+			AllowedClients: getEnvAsSlice("TLS_ALLOWED_CLIENTS", nil),
+		},
+		Cache: CacheConfig{
+			Enabled:       getEnvAsBool("K8S_CACHE_ENABLED", true),
+			ResyncSeconds: getEnvAsInt("K8S_CACHE_RESYNC_SECONDS", 300),
+		},
+		Store: StoreConfig{
+			Driver: getEnv("STORE_DRIVER", ""),
+			DSN:    getEnv("STORE_DSN", ""),
+		},
+		Reconciler: ReconcilerConfig{
+			Enabled:         getEnvAsBool("RECONCILER_ENABLED", false),
+			IntervalSeconds: getEnvAsInt("RECONCILER_INTERVAL_SECONDS", 300),
+		},
+		GC: GCConfig{
+			Enabled:         getEnvAsBool("GC_ENABLED", false),
+			IntervalSeconds: getEnvAsInt("GC_INTERVAL_SECONDS", 3600),
+			Remove:          getEnvAsBool("GC_REMOVE", false),
+		},
+		NetworkIdentity: NetworkIdentityConfig{
+			Enabled:   getEnvAsBool("VM_NETWORK_IDENTITY_ENABLED", false),
+			Namespace: getEnv("VM_NETWORK_IDENTITY_NAMESPACE", "default"),
+		},
+		Namespaces: NamespacePolicyConfig{
+			Denied:     getEnv("NAMESPACE_DENIED", "kube-system,kube-public,kube-node-lease"),
+			Allowed:    getEnv("NAMESPACE_ALLOWED", ""),
+			AutoCreate: getEnvAsBool("NAMESPACE_AUTO_CREATE", true),
+		},
+		SSHKeys: SSHKeysConfig{
+			Namespace: getEnv("SSH_KEYS_NAMESPACE", "default"),
+		},
+		Defaults: DefaultsConfig{
+			ProfilesDir: getEnv("DEFAULTS_PROFILES_DIR", ""),
 		},
 		Log: LogConfig{
 			Level:      getEnv("LOG_LEVEL", "info"),
@@ -65,6 +486,20 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+// getEnvAsSlice gets a comma-separated environment variable as a string slice
+func getEnvAsSlice(key string, fallback []string) []string {
+	if value := os.Getenv(key); value != "" {
+		var result []string
+		for _, part := range strings.Split(value, ",") {
+			if trimmed := strings.TrimSpace(part); trimmed != "" {
+				result = append(result, trimmed)
+			}
+		}
+		return result
+	}
+	return fallback
+}
+
 // getEnvAsInt gets an environment variable as an integer with a fallback value
 func getEnvAsInt(key string, fallback int) int {
 	if value := os.Getenv(key); value != "" {
@@ -75,6 +510,16 @@ func getEnvAsInt(key string, fallback int) int {
 	return fallback
 }
 
+// getEnvAsFloat gets an environment variable as a float with a fallback value
+func getEnvAsFloat(key string, fallback float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return fallback
+}
+
 // getEnvAsBool gets an environment variable as a boolean with a fallback value
 func getEnvAsBool(key string, fallback bool) bool {
 	if value := os.Getenv(key); value != "" {
@@ -91,9 +536,179 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid server port: %d", c.Server.Port)
 	}
 
+	if c.Server.GRPCPort <= 0 || c.Server.GRPCPort > 65535 {
+		return fmt.Errorf("invalid gRPC server port: %d", c.Server.GRPCPort)
+	}
+
 	if c.Server.Host == "" {
 		return fmt.Errorf("server host cannot be empty")
 	}
 
+	switch c.Server.Mode {
+	case "", ServerModeUnified, ServerModeSplit:
+	default:
+		return fmt.Errorf("invalid server mode: %q, expected %q or %q", c.Server.Mode, ServerModeUnified, ServerModeSplit)
+	}
+
+	// The namespace server settings only matter in split mode; unified mode
+	// serves the namespace API from the deployment server
+	if c.Server.Mode == ServerModeSplit {
+		if c.NamespaceServer.Port <= 0 || c.NamespaceServer.Port > 65535 {
+			return fmt.Errorf("invalid namespace server port: %d", c.NamespaceServer.Port)
+		}
+
+		if c.NamespaceServer.Host == "" {
+			return fmt.Errorf("namespace server host cannot be empty")
+		}
+
+		if c.NamespaceServer.Port == c.Server.Port {
+			return fmt.Errorf("namespace server port %d collides with the deployment server port", c.NamespaceServer.Port)
+		}
+	}
+
+	switch c.Server.GinMode {
+	case "", GinModeRelease, GinModeDebug, GinModeTest:
+	default:
+		return fmt.Errorf("invalid gin mode: %q, expected %q, %q or %q", c.Server.GinMode, GinModeRelease, GinModeDebug, GinModeTest)
+	}
+
+	for _, proxy := range c.Server.TrustedProxies {
+		if net.ParseIP(proxy) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			return fmt.Errorf("invalid trusted proxy %q: expected an IP address or CIDR", proxy)
+		}
+	}
+
+	if c.Kubernetes.ReadQPS <= 0 || c.Kubernetes.ReadBurst <= 0 {
+		return fmt.Errorf("invalid kubernetes read rate limits: qps=%v burst=%d", c.Kubernetes.ReadQPS, c.Kubernetes.ReadBurst)
+	}
+
+	if c.Kubernetes.WriteQPS <= 0 || c.Kubernetes.WriteBurst <= 0 {
+		return fmt.Errorf("invalid kubernetes write rate limits: qps=%v burst=%d", c.Kubernetes.WriteQPS, c.Kubernetes.WriteBurst)
+	}
+
+	if c.VMShape.MinCPU > c.VMShape.MaxCPU && c.VMShape.MaxCPU > 0 {
+		return fmt.Errorf("invalid VM shape limits: min cpu %d exceeds max cpu %d", c.VMShape.MinCPU, c.VMShape.MaxCPU)
+	}
+
+	if c.VMShape.MinRAM > c.VMShape.MaxRAM && c.VMShape.MaxRAM > 0 {
+		return fmt.Errorf("invalid VM shape limits: min ram %d exceeds max ram %d", c.VMShape.MinRAM, c.VMShape.MaxRAM)
+	}
+
+	if c.VMShape.MinRAMPerCPU > c.VMShape.MaxRAMPerCPU && c.VMShape.MaxRAMPerCPU > 0 {
+		return fmt.Errorf("invalid VM shape limits: min ram:cpu ratio %v exceeds max %v", c.VMShape.MinRAMPerCPU, c.VMShape.MaxRAMPerCPU)
+	}
+
+	if c.VMShape.RAMGranularity < 0 {
+		return fmt.Errorf("invalid VM shape limits: ram granularity must not be negative, got %d", c.VMShape.RAMGranularity)
+	}
+
+	switch c.VMShape.DefaultArchitecture {
+	case "", "amd64", "arm64", "s390x":
+	default:
+		return fmt.Errorf("invalid VM default architecture: %q, expected amd64, arm64 or s390x", c.VMShape.DefaultArchitecture)
+	}
+
+	workloadQuantities := map[string]string{
+		"WORKLOAD_DEFAULT_CPU_LIMIT":    c.Workload.DefaultCPULimit,
+		"WORKLOAD_DEFAULT_MEMORY_LIMIT": c.Workload.DefaultMemoryLimit,
+		"WORKLOAD_MAX_CPU":              c.Workload.MaxCPU,
+		"WORKLOAD_MAX_MEMORY":           c.Workload.MaxMemory,
+	}
+	for name, value := range workloadQuantities {
+		if value == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return fmt.Errorf("invalid workload resource policy: %s %q is not a valid quantity", name, value)
+		}
+	}
+
+	switch c.Workload.NameConflictPolicy {
+	case "", NameConflictReject, NameConflictSuffix:
+	default:
+		return fmt.Errorf("invalid name conflict policy: %q, expected %q or %q", c.Workload.NameConflictPolicy, NameConflictReject, NameConflictSuffix)
+	}
+
+	switch c.Workload.PodSecurityProfile {
+	case "", PodSecurityRestricted:
+	default:
+		return fmt.Errorf("invalid pod security profile: %q, expected %q", c.Workload.PodSecurityProfile, PodSecurityRestricted)
+	}
+
+	if c.Report.Enabled {
+		if c.Report.Interval != ReportIntervalDaily && c.Report.Interval != ReportIntervalWeekly {
+			return fmt.Errorf("invalid report interval: %q, expected %q or %q", c.Report.Interval, ReportIntervalDaily, ReportIntervalWeekly)
+		}
+
+		if _, err := time.Parse("15:04", c.Report.At); err != nil {
+			return fmt.Errorf("invalid report time %q: expected HH:MM", c.Report.At)
+		}
+
+		hasEmail := c.Report.SMTPAddr != "" && c.Report.EmailFrom != "" && c.Report.EmailTo != ""
+		if c.Report.WebhookURL == "" && !hasEmail {
+			return fmt.Errorf("report enabled but no sink configured: set REPORT_WEBHOOK_URL or the REPORT_SMTP_ADDR/REPORT_EMAIL_FROM/REPORT_EMAIL_TO trio")
+		}
+	}
+
+	if c.Audit.ExportEnabled {
+		if c.Audit.SyslogAddr == "" && c.Audit.HTTPURL == "" {
+			return fmt.Errorf("audit export enabled but no sink configured: set AUDIT_SYSLOG_ADDR or AUDIT_HTTP_URL")
+		}
+
+		if c.Audit.BatchSize <= 0 || c.Audit.FlushSeconds <= 0 || c.Audit.BufferSize <= 0 {
+			return fmt.Errorf("invalid audit export settings: batch size, flush seconds and buffer size must be positive, got %d/%d/%d",
+				c.Audit.BatchSize, c.Audit.FlushSeconds, c.Audit.BufferSize)
+		}
+	}
+
+	if c.Auth.Enabled && c.Auth.IssuerURL == "" && len(c.Auth.APIKeys) == 0 {
+		return fmt.Errorf("authentication enabled but no scheme configured: set AUTH_OIDC_ISSUER or AUTH_API_KEYS")
+	}
+
+	if c.Cache.Enabled && c.Cache.ResyncSeconds <= 0 {
+		return fmt.Errorf("invalid cache resync interval: %d seconds, must be positive", c.Cache.ResyncSeconds)
+	}
+
+	switch c.Store.Driver {
+	case "", StoreDriverMemory:
+	case StoreDriverPostgres, StoreDriverSQLite:
+		if c.Store.DSN == "" {
+			return fmt.Errorf("store driver %s requires a DSN: set STORE_DSN", c.Store.Driver)
+		}
+	default:
+		return fmt.Errorf("invalid store driver: %s (must be memory, postgres or sqlite)", c.Store.Driver)
+	}
+
+	if c.Reconciler.Enabled {
+		if c.Store.Driver != "" {
+			return fmt.Errorf("the reconciler stores records as custom resources: unset STORE_DRIVER or disable RECONCILER_ENABLED")
+		}
+		if c.Reconciler.IntervalSeconds <= 0 {
+			return fmt.Errorf("invalid reconciler interval: %d seconds, must be positive", c.Reconciler.IntervalSeconds)
+		}
+	}
+
+	if c.GC.Enabled && c.GC.IntervalSeconds <= 0 {
+		return fmt.Errorf("invalid gc interval: %d seconds, must be positive", c.GC.IntervalSeconds)
+	}
+
+	if c.TLS.Enabled && (c.TLS.CertFile == "" || c.TLS.KeyFile == "") {
+		return fmt.Errorf("TLS enabled but no server certificate configured: set TLS_CERT_FILE and TLS_KEY_FILE")
+	}
+
+	if len(c.TLS.AllowedClients) > 0 && c.TLS.ClientCAFile == "" {
+		return fmt.Errorf("TLS client allowlist configured without a client CA: set TLS_CLIENT_CA_FILE")
+	}
+
+	if c.RateLimit.Enabled {
+		if c.RateLimit.RPS <= 0 || c.RateLimit.Burst <= 0 || c.RateLimit.ClientRPS <= 0 || c.RateLimit.ClientBurst <= 0 {
+			return fmt.Errorf("invalid rate limit settings: rates and bursts must be positive, got %g/%d global and %g/%d per client",
+				c.RateLimit.RPS, c.RateLimit.Burst, c.RateLimit.ClientRPS, c.RateLimit.ClientBurst)
+		}
+	}
+
 	return nil
 }
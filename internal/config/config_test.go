@@ -19,6 +19,7 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				Server: ServerConfig{
 					Port:         8080,
+					GRPCPort:     9090,
 					Host:         "0.0.0.0",
 					ReadTimeout:  30,
 					WriteTimeout: 30,
@@ -47,6 +48,7 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				Server: ServerConfig{
 					Port:         9090,
+					GRPCPort:     9090,
 					Host:         "127.0.0.1",
 					ReadTimeout:  60,
 					WriteTimeout: 30,
@@ -65,13 +67,14 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "kubeconfig path set",
 			envVars: map[string]string{
-				"KUBECONFIG":    "/custom/kubeconfig",
-				"LOG_LEVEL":     "error",
+				"KUBECONFIG":      "/custom/kubeconfig",
+				"LOG_LEVEL":       "error",
 				"LOG_OUTPUT_PATH": "/var/log/app.log",
 			},
 			expected: &Config{
 				Server: ServerConfig{
 					Port:         8080,
+					GRPCPort:     9090,
 					Host:         "0.0.0.0",
 					ReadTimeout:  30,
 					WriteTimeout: 30,
@@ -334,8 +337,15 @@ func TestConfigValidate(t *testing.T) {
 			name: "valid configuration",
 			config: &Config{
 				Server: ServerConfig{
-					Port: 8080,
-					Host: "0.0.0.0",
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   10,
+					WriteBurst: 20,
 				},
 			},
 			wantErr: false,
@@ -344,8 +354,9 @@ func TestConfigValidate(t *testing.T) {
 			name: "invalid port - too low",
 			config: &Config{
 				Server: ServerConfig{
-					Port: 0,
-					Host: "0.0.0.0",
+					Port:     0,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
 				},
 			},
 			wantErr: true,
@@ -355,8 +366,9 @@ func TestConfigValidate(t *testing.T) {
 			name: "invalid port - too high",
 			config: &Config{
 				Server: ServerConfig{
-					Port: 70000,
-					Host: "0.0.0.0",
+					Port:     70000,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
 				},
 			},
 			wantErr: true,
@@ -366,19 +378,289 @@ func TestConfigValidate(t *testing.T) {
 			name: "empty host",
 			config: &Config{
 				Server: ServerConfig{
-					Port: 8080,
-					Host: "",
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "",
 				},
 			},
 			wantErr: true,
 			errMsg:  "server host cannot be empty",
 		},
+		{
+			name: "invalid gRPC port",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 0,
+					Host:     "0.0.0.0",
+				},
+			},
+			wantErr: true,
+			errMsg:  "invalid gRPC server port",
+		},
+		{
+			name: "invalid kubernetes rate limits",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   0,
+					WriteBurst: 20,
+				},
+			},
+			wantErr: true,
+			errMsg:  "invalid kubernetes write rate limits",
+		},
+		{
+			name: "invalid VM shape limits",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   10,
+					WriteBurst: 20,
+				},
+				VMShape: VMShapeConfig{
+					MinCPU: 8,
+					MaxCPU: 4,
+				},
+			},
+			wantErr: true,
+			errMsg:  "invalid VM shape limits",
+		},
+		{
+			name: "invalid namespace server port in split mode",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+					Mode:     ServerModeSplit,
+				},
+				NamespaceServer: NamespaceServerConfig{
+					Port: 0,
+					Host: "0.0.0.0",
+				},
+			},
+			wantErr: true,
+			errMsg:  "invalid namespace server port",
+		},
+		{
+			name: "namespace server port collides in split mode",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+					Mode:     ServerModeSplit,
+				},
+				NamespaceServer: NamespaceServerConfig{
+					Port: 8080,
+					Host: "0.0.0.0",
+				},
+			},
+			wantErr: true,
+			errMsg:  "collides with the deployment server port",
+		},
+		{
+			name: "store driver without DSN",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   10,
+					WriteBurst: 20,
+				},
+				Store: StoreConfig{
+					Driver: StoreDriverPostgres,
+				},
+			},
+			wantErr: true,
+			errMsg:  "requires a DSN",
+		},
+		{
+			name: "invalid store driver",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   10,
+					WriteBurst: 20,
+				},
+				Store: StoreConfig{
+					Driver: "mysql",
+					DSN:    "root@/records",
+				},
+			},
+			wantErr: true,
+			errMsg:  "invalid store driver",
+		},
+		{
+			name: "reconciler combined with a store driver",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   10,
+					WriteBurst: 20,
+				},
+				Store: StoreConfig{
+					Driver: StoreDriverMemory,
+				},
+				Reconciler: ReconcilerConfig{
+					Enabled:         true,
+					IntervalSeconds: 300,
+				},
+			},
+			wantErr: true,
+			errMsg:  "unset STORE_DRIVER or disable RECONCILER_ENABLED",
+		},
+		{
+			name: "invalid server mode",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+					Mode:     "both",
+				},
+			},
+			wantErr: true,
+			errMsg:  "invalid server mode",
+		},
+		{
+			name: "invalid gin mode",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+					GinMode:  "production",
+				},
+			},
+			wantErr: true,
+			errMsg:  "invalid gin mode",
+		},
+		{
+			name: "invalid trusted proxy",
+			config: &Config{
+				Server: ServerConfig{
+					Port:           8080,
+					GRPCPort:       9090,
+					Host:           "0.0.0.0",
+					GinMode:        GinModeRelease,
+					TrustedProxies: []string{"not-a-cidr"},
+				},
+			},
+			wantErr: true,
+			errMsg:  "invalid trusted proxy",
+		},
+		{
+			name: "valid trusted proxies",
+			config: &Config{
+				Server: ServerConfig{
+					Port:           8080,
+					GRPCPort:       9090,
+					Host:           "0.0.0.0",
+					GinMode:        GinModeRelease,
+					TrustedProxies: []string{"10.0.0.0/8", "192.168.1.1"},
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   10,
+					WriteBurst: 20,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "audit export without sinks",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   10,
+					WriteBurst: 20,
+				},
+				Audit: AuditConfig{
+					ExportEnabled: true,
+					BatchSize:     50,
+					FlushSeconds:  5,
+					BufferSize:    1000,
+				},
+			},
+			wantErr: true,
+			errMsg:  "no sink configured",
+		},
+		{
+			name: "audit export with invalid batch settings",
+			config: &Config{
+				Server: ServerConfig{
+					Port:     8080,
+					GRPCPort: 9090,
+					Host:     "0.0.0.0",
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   10,
+					WriteBurst: 20,
+				},
+				Audit: AuditConfig{
+					ExportEnabled: true,
+					SyslogAddr:    "siem.example.com:6514",
+					BatchSize:     0,
+					FlushSeconds:  5,
+					BufferSize:    1000,
+				},
+			},
+			wantErr: true,
+			errMsg:  "invalid audit export settings",
+		},
 		{
 			name: "valid port boundary - minimum",
 			config: &Config{
 				Server: ServerConfig{
-					Port: 1,
-					Host: "localhost",
+					Port:     1,
+					GRPCPort: 9090,
+					Host:     "localhost",
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   10,
+					WriteBurst: 20,
 				},
 			},
 			wantErr: false,
@@ -387,8 +669,15 @@ func TestConfigValidate(t *testing.T) {
 			name: "valid port boundary - maximum",
 			config: &Config{
 				Server: ServerConfig{
-					Port: 65535,
-					Host: "localhost",
+					Port:     65535,
+					GRPCPort: 9090,
+					Host:     "localhost",
+				},
+				Kubernetes: KubernetesConfig{
+					ReadQPS:    20,
+					ReadBurst:  40,
+					WriteQPS:   10,
+					WriteBurst: 20,
 				},
 			},
 			wantErr: false,
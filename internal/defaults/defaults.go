@@ -0,0 +1,273 @@
+// Package defaults applies operator-provided defaults profiles to deployment
+// requests. Profiles are YAML files loaded at startup; each profile targets a
+// deployment kind and optionally a set of namespace labels (e.g. all VMs in
+// namespaces labeled tier=gold), and fills in spec fields the caller left
+// unset. Profiles are applied in file order and never override request values.
+package defaults
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// Profile is one defaults profile. Kind restricts it to a deployment kind
+// (empty matches every kind); NamespaceLabels restricts it to deployments in
+// namespaces carrying all the given labels (empty matches every namespace).
+type Profile struct {
+	Name            string             `json:"name,omitempty"`
+	Kind            string             `json:"kind,omitempty"`
+	NamespaceLabels map[string]string  `json:"namespaceLabels,omitempty"`
+	Container       *ContainerDefaults `json:"container,omitempty"`
+	VM              *VMDefaults        `json:"vm,omitempty"`
+	Job             *JobDefaults       `json:"job,omitempty"`
+}
+
+// ContainerDefaults holds defaults applied to container specs
+type ContainerDefaults struct {
+	Replicas *int   `json:"replicas,omitempty"`
+	CPU      string `json:"cpu,omitempty"`
+	Memory   string `json:"memory,omitempty"`
+}
+
+// VMDefaults holds defaults applied to VM specs
+type VMDefaults struct {
+	StorageClass        string `json:"storageClass,omitempty"`
+	DisablePasswordAuth *bool  `json:"disablePasswordAuth,omitempty"`
+}
+
+// JobDefaults holds defaults applied to job specs
+type JobDefaults struct {
+	BackoffLimit          *int32 `json:"backoffLimit,omitempty"`
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// LoadProfiles reads every .yaml/.yml file in dir as one profile, sorted by
+// filename so operators control the application order. A profile without an
+// explicit name inherits the filename stem.
+func LoadProfiles(dir string) ([]Profile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read defaults profiles directory: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		if ext == ".yaml" || ext == ".yml" {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	profiles := make([]Profile, 0, len(names))
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(dir, name)) // #nosec G304 -- operator-provided directory
+		if err != nil {
+			return nil, fmt.Errorf("failed to read defaults profile %s: %w", name, err)
+		}
+
+		var profile Profile
+		if err := yaml.UnmarshalStrict(data, &profile); err != nil {
+			return nil, fmt.Errorf("failed to parse defaults profile %s: %w", name, err)
+		}
+		if profile.Name == "" {
+			profile.Name = strings.TrimSuffix(name, filepath.Ext(name))
+		}
+		if err := validateProfileKind(profile.Kind); err != nil {
+			return nil, fmt.Errorf("invalid defaults profile %s: %w", name, err)
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
+// validateProfileKind checks the kind selector of a profile
+func validateProfileKind(kind string) error {
+	switch models.DeploymentKind(kind) {
+	case "", models.DeploymentKindContainer, models.DeploymentKindVM, models.DeploymentKindJob:
+		return nil
+	default:
+		return fmt.Errorf("unsupported kind %q", kind)
+	}
+}
+
+// Engine applies loaded profiles to deployment requests
+type Engine struct {
+	profiles   []Profile
+	readClient kubernetes.Interface
+	logger     *zap.Logger
+}
+
+// NewEngine creates a defaults engine. The read client is used to resolve
+// namespace labels for profiles with a NamespaceLabels selector.
+func NewEngine(profiles []Profile, readClient kubernetes.Interface, logger *zap.Logger) *Engine {
+	return &Engine{
+		profiles:   profiles,
+		readClient: readClient,
+		logger:     logger.Named("defaults_engine"),
+	}
+}
+
+// Profiles returns the loaded profiles in application order
+func (e *Engine) Profiles() []Profile {
+	return e.profiles
+}
+
+// Apply fills unset spec fields of the request from the matching profiles.
+// The request namespace must already be resolved. Namespace label lookups
+// degrade gracefully: on error, label-selected profiles are skipped.
+func (e *Engine) Apply(ctx context.Context, req *models.DeploymentRequest) {
+	var nsLabels map[string]string
+	nsResolved := false
+
+	for i := range e.profiles {
+		profile := &e.profiles[i]
+		if profile.Kind != "" && profile.Kind != string(req.Kind) {
+			continue
+		}
+
+		if len(profile.NamespaceLabels) > 0 {
+			if !nsResolved {
+				nsLabels = e.namespaceLabels(ctx, req.Metadata.Namespace)
+				nsResolved = true
+			}
+			if !labelsMatch(profile.NamespaceLabels, nsLabels) {
+				continue
+			}
+		}
+
+		if applyProfile(profile, req) {
+			e.logger.Info("Applied defaults profile",
+				zap.String("profile", profile.Name),
+				zap.String("kind", string(req.Kind)),
+				zap.String("namespace", req.Metadata.Namespace))
+		}
+	}
+}
+
+// namespaceLabels fetches the labels of a namespace, returning nil on error
+func (e *Engine) namespaceLabels(ctx context.Context, namespace string) map[string]string {
+	ns, err := e.readClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		e.logger.Warn("Failed to resolve namespace labels for defaults profiles",
+			zap.String("namespace", namespace), zap.Error(err))
+		return nil
+	}
+	return ns.Labels
+}
+
+// labelsMatch reports whether every selector label is present on the target
+func labelsMatch(selector, target map[string]string) bool {
+	for key, value := range selector {
+		if target[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// applyProfile fills unset fields on the request spec and reports whether
+// anything changed
+func applyProfile(profile *Profile, req *models.DeploymentRequest) bool {
+	switch req.Kind {
+	case models.DeploymentKindContainer:
+		if spec, ok := req.Spec.(models.ContainerSpec); ok && profile.Container != nil {
+			if applyContainerDefaults(profile.Container, &spec) {
+				req.Spec = spec
+				return true
+			}
+		}
+	case models.DeploymentKindVM:
+		if spec, ok := req.Spec.(models.VMSpec); ok && profile.VM != nil {
+			if applyVMDefaults(profile.VM, &spec) {
+				req.Spec = spec
+				return true
+			}
+		}
+	case models.DeploymentKindJob:
+		if spec, ok := req.Spec.(models.JobSpec); ok && profile.Job != nil {
+			if applyJobDefaults(profile.Job, &spec) {
+				req.Spec = spec
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func applyContainerDefaults(d *ContainerDefaults, spec *models.ContainerSpec) bool {
+	changed := false
+	if d.Replicas != nil && spec.Container.Replicas == nil {
+		replicas := *d.Replicas
+		spec.Container.Replicas = &replicas
+		changed = true
+	}
+	if d.CPU != "" || d.Memory != "" {
+		if spec.Container.Resources == nil {
+			spec.Container.Resources = &models.ResourceConfig{}
+		}
+		if d.CPU != "" && spec.Container.Resources.CPU == "" {
+			spec.Container.Resources.CPU = d.CPU
+			changed = true
+		}
+		if d.Memory != "" && spec.Container.Resources.Memory == "" {
+			spec.Container.Resources.Memory = d.Memory
+			changed = true
+		}
+	}
+	return changed
+}
+
+func applyVMDefaults(d *VMDefaults, spec *models.VMSpec) bool {
+	changed := false
+	if d.StorageClass != "" {
+		if spec.VM.RootDisk != nil && spec.VM.RootDisk.StorageClass == "" {
+			spec.VM.RootDisk.StorageClass = d.StorageClass
+			changed = true
+		}
+		for i := range spec.VM.DataDisks {
+			if spec.VM.DataDisks[i].StorageClass == "" {
+				spec.VM.DataDisks[i].StorageClass = d.StorageClass
+				changed = true
+			}
+		}
+	}
+	// DisablePasswordAuth only tightens: false is indistinguishable from
+	// unset on the request, so profiles can enable it but never clear it.
+	// Requests carrying an explicit password keep password auth.
+	if d.DisablePasswordAuth != nil && *d.DisablePasswordAuth && !spec.VM.DisablePasswordAuth && spec.VM.Password == nil {
+		spec.VM.DisablePasswordAuth = true
+		changed = true
+	}
+	return changed
+}
+
+func applyJobDefaults(d *JobDefaults, spec *models.JobSpec) bool {
+	changed := false
+	if d.BackoffLimit != nil && spec.Job.BackoffLimit == nil {
+		limit := *d.BackoffLimit
+		spec.Job.BackoffLimit = &limit
+		changed = true
+	}
+	if d.ActiveDeadlineSeconds != nil && spec.Job.ActiveDeadlineSeconds == nil {
+		deadline := *d.ActiveDeadlineSeconds
+		spec.Job.ActiveDeadlineSeconds = &deadline
+		changed = true
+	}
+	return changed
+}
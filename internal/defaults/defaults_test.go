@@ -0,0 +1,135 @@
+package defaults
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func writeProfile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+}
+
+func TestLoadProfiles(t *testing.T) {
+	dir := t.TempDir()
+	writeProfile(t, dir, "10-gold-vms.yaml", `
+kind: vm
+namespaceLabels:
+  tier: gold
+vm:
+  storageClass: premium
+`)
+	writeProfile(t, dir, "20-containers.yaml", `
+name: container-baseline
+kind: container
+container:
+  replicas: 2
+  cpu: 100m
+`)
+	writeProfile(t, dir, "ignored.txt", "not a profile")
+
+	profiles, err := LoadProfiles(dir)
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+
+	// Name falls back to the filename stem; file order is preserved
+	assert.Equal(t, "10-gold-vms", profiles[0].Name)
+	assert.Equal(t, "vm", profiles[0].Kind)
+	assert.Equal(t, "premium", profiles[0].VM.StorageClass)
+	assert.Equal(t, "container-baseline", profiles[1].Name)
+}
+
+func TestLoadProfilesInvalidKind(t *testing.T) {
+	dir := t.TempDir()
+	writeProfile(t, dir, "bad.yaml", "kind: spaceship\n")
+
+	_, err := LoadProfiles(dir)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported kind")
+}
+
+func TestEngineApplyContainer(t *testing.T) {
+	replicas := 3
+	engine := NewEngine([]Profile{
+		{
+			Name:      "baseline",
+			Kind:      "container",
+			Container: &ContainerDefaults{Replicas: &replicas, CPU: "100m", Memory: "128Mi"},
+		},
+	}, fake.NewSimpleClientset(), zap.NewNop())
+
+	req := &models.DeploymentRequest{
+		Kind:     models.DeploymentKindContainer,
+		Metadata: models.Metadata{Name: "web", Namespace: "default"},
+		Spec: models.ContainerSpec{
+			Container: models.ContainerConfig{
+				Image:     "nginx:latest",
+				Resources: &models.ResourceConfig{CPU: "250m"},
+			},
+		},
+	}
+
+	engine.Apply(context.Background(), req)
+
+	spec := req.Spec.(models.ContainerSpec)
+	require.NotNil(t, spec.Container.Replicas)
+	assert.Equal(t, 3, *spec.Container.Replicas)
+	// Request values win over profile values
+	assert.Equal(t, "250m", spec.Container.Resources.CPU)
+	assert.Equal(t, "128Mi", spec.Container.Resources.Memory)
+}
+
+func TestEngineApplyNamespaceLabels(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "gold-team",
+			Labels: map[string]string{"tier": "gold"},
+		},
+	})
+
+	engine := NewEngine([]Profile{
+		{
+			Name:            "gold-vms",
+			Kind:            "vm",
+			NamespaceLabels: map[string]string{"tier": "gold"},
+			VM:              &VMDefaults{StorageClass: "premium"},
+		},
+	}, client, zap.NewNop())
+
+	matching := &models.DeploymentRequest{
+		Kind:     models.DeploymentKindVM,
+		Metadata: models.Metadata{Name: "devbox", Namespace: "gold-team"},
+		Spec: models.VMSpec{
+			VM: models.VMConfig{
+				Ram: 4, Cpu: 2, Os: "fedora",
+				RootDisk: &models.VMDiskConfig{Size: "20Gi"},
+			},
+		},
+	}
+	engine.Apply(context.Background(), matching)
+	assert.Equal(t, "premium", matching.Spec.(models.VMSpec).VM.RootDisk.StorageClass)
+
+	// A namespace without the label is left untouched
+	other := &models.DeploymentRequest{
+		Kind:     models.DeploymentKindVM,
+		Metadata: models.Metadata{Name: "devbox", Namespace: "default"},
+		Spec: models.VMSpec{
+			VM: models.VMConfig{
+				Ram: 4, Cpu: 2, Os: "fedora",
+				RootDisk: &models.VMDiskConfig{Size: "20Gi"},
+			},
+		},
+	}
+	engine.Apply(context.Background(), other)
+	assert.Empty(t, other.Spec.(models.VMSpec).VM.RootDisk.StorageClass)
+}
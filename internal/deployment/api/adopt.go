@@ -0,0 +1,68 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// AdoptDeployment handles POST /deployments:adopt
+// It applies the managed-by/app-id labels to an existing workload so the
+// provider starts tracking it as a deployment
+func (h *Handler) AdoptDeployment(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "adopt_deployment"))
+
+	var req models.AdoptDeploymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error("Failed to bind request", zap.Error(err))
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid request format", err)
+		return
+	}
+
+	if (req.Object == "") == (req.Selector == "") {
+		problem(c, http.StatusBadRequest, models.CodeInvalidRequest, "Exactly one of 'object' or 'selector' must be provided", "")
+		return
+	}
+
+	switch req.Kind {
+	case models.DeploymentKindContainer, models.DeploymentKindStatefulSet:
+	default:
+		problem(c, http.StatusBadRequest, models.CodeInvalidRequest, "Adoption is only supported for container and statefulset deployments", "")
+		return
+	}
+
+	deploymentID := uuid.New().String()
+	response, err := h.deployService.AdoptDeployment(c.Request.Context(), &req, deploymentID)
+	h.recordOperation(c, "adopt", string(req.Kind), deploymentID, req.Namespace, err)
+	if err != nil {
+		logger.Error("Failed to adopt deployment", zap.Error(err))
+
+		if models.IsNamespaceForbiddenError(err) {
+			problem(c, http.StatusForbidden, models.CodeNamespaceForbidden, "Target namespace is not allowed for this tenant", err.Error())
+			return
+		}
+
+		if models.IsAlreadyExistsError(err) {
+			problem(c, http.StatusConflict, models.CodeDeploymentIDExists, "Workload is already managed by the provider", err.Error())
+			return
+		}
+
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "No workload matches the adoption request", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeAdoptionFailed, "Failed to adopt deployment", err.Error())
+		return
+	}
+
+	logger.Info("Adopted deployment",
+		zap.String("deployment_id", deploymentID),
+		zap.String("namespace", req.Namespace))
+
+	c.JSON(http.StatusCreated, response)
+}
@@ -0,0 +1,197 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/dcm-project/k8s-service-provider/internal/approval"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// protectedTag marks a deployment whose deletion requires an approved
+// protection override
+const protectedTag = "protected"
+
+// CreateApprovalRequest represents the body for filing an approval request
+type CreateApprovalRequest struct {
+	Action       string `json:"action" binding:"required,oneof=force-delete override-protection system-namespace"`
+	DeploymentID string `json:"deploymentId,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	Reason       string `json:"reason,omitempty" binding:"max=1024"`
+}
+
+// ListApprovalsResponse represents the response for listing approval requests
+type ListApprovalsResponse struct {
+	Approvals []approval.Request `json:"approvals"`
+	Count     int                `json:"count"`
+}
+
+// CreateApproval handles POST /admin/approvals
+func (h *Handler) CreateApproval(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "create_approval"))
+
+	if h.approvalStore == nil {
+		h.approvalsUnavailable(c)
+		return
+	}
+
+	var req CreateApprovalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid request format", err)
+		return
+	}
+
+	created := h.approvalStore.Create(approval.Request{
+		Action:       req.Action,
+		DeploymentID: req.DeploymentID,
+		Namespace:    req.Namespace,
+		Reason:       req.Reason,
+		RequestedBy:  callerIdentity(c),
+	})
+
+	logger.Info("Filed approval request",
+		zap.String("approval_id", created.ID),
+		zap.String("action", created.Action),
+		zap.String("requested_by", created.RequestedBy))
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// ListApprovals handles GET /admin/approvals
+// Supported query parameters: status, action
+func (h *Handler) ListApprovals(c *gin.Context) {
+	if h.approvalStore == nil {
+		h.approvalsUnavailable(c)
+		return
+	}
+
+	approvals := h.approvalStore.List(&approval.Filter{
+		Status: approval.Status(c.Query("status")),
+		Action: c.Query("action"),
+	})
+
+	c.JSON(http.StatusOK, ListApprovalsResponse{
+		Approvals: approvals,
+		Count:     len(approvals),
+	})
+}
+
+// ApproveRequest handles POST /admin/approvals/:id/approve
+func (h *Handler) ApproveRequest(c *gin.Context) {
+	h.decideApproval(c, "approve")
+}
+
+// DenyRequest handles POST /admin/approvals/:id/deny
+func (h *Handler) DenyRequest(c *gin.Context) {
+	h.decideApproval(c, "deny")
+}
+
+func (h *Handler) decideApproval(c *gin.Context, decision string) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", decision+"_approval"))
+
+	if h.approvalStore == nil {
+		h.approvalsUnavailable(c)
+		return
+	}
+
+	approvalID := c.Param("id")
+	approver := callerIdentity(c)
+
+	var decided approval.Request
+	var err error
+	if decision == "approve" {
+		decided, err = h.approvalStore.Approve(approvalID, approver)
+	} else {
+		decided, err = h.approvalStore.Deny(approvalID, approver)
+	}
+	if err != nil {
+		logger.Warn("Failed to decide approval request", zap.String("approval_id", approvalID), zap.Error(err))
+
+		switch {
+		case errors.Is(err, approval.ErrNotFound):
+			problem(c, http.StatusNotFound, models.CodeApprovalNotFound, "Approval request not found", err.Error())
+		case errors.Is(err, approval.ErrSelfApproval):
+			problem(c, http.StatusForbidden, models.CodeSelfApproval, "A second operator must decide this request", err.Error())
+		case errors.Is(err, approval.ErrApproverRequired):
+			problem(c, http.StatusForbidden, models.CodeApproverRequired, "Caller identity is required to decide approval requests", err.Error())
+		default:
+			problem(c, http.StatusConflict, models.CodeApprovalNotPending, "Approval request has already been decided or has expired", err.Error())
+		}
+		return
+	}
+
+	logger.Info("Decided approval request",
+		zap.String("approval_id", decided.ID),
+		zap.String("status", string(decided.Status)),
+		zap.String("decided_by", decided.DecidedBy))
+
+	c.JSON(http.StatusOK, decided)
+}
+
+func (h *Handler) approvalsUnavailable(c *gin.Context) {
+	problem(c, http.StatusServiceUnavailable, models.CodeApprovalsUnavailable, "The approval workflow is not enabled", "")
+}
+
+// requireElevation checks whether deleting the deployment is a gated
+// destructive operation and, if so, consumes the matching approval. It writes
+// the error response and returns false when the delete must not proceed.
+func (h *Handler) requireElevation(c *gin.Context, logger *zap.Logger, deployment *models.DeploymentResponse, force bool) bool {
+	action := ""
+	switch {
+	case isSystemNamespace(deployment.Metadata.Namespace):
+		action = approval.ActionSystemNamespace
+	case models.HasTag(deployment.Metadata.Tags, protectedTag):
+		action = approval.ActionOverrideProtection
+	default:
+		return true
+	}
+
+	if !force {
+		problem(c, http.StatusForbidden, models.CodeProtectedDeployment, "Deleting this deployment requires force=true and an approved elevated operation", "")
+		return false
+	}
+
+	if h.approvalStore == nil {
+		problem(c, http.StatusForbidden, models.CodeApprovalRequired, "Forced deletes require the approval workflow, which is not enabled", "")
+		return false
+	}
+
+	// A force-delete approval scoped to this deployment also covers the
+	// specific gating reason
+	granted, err := h.approvalStore.Consume(action, deployment.ID, deployment.Metadata.Namespace)
+	if err != nil {
+		granted, err = h.approvalStore.Consume(approval.ActionForceDelete, deployment.ID, deployment.Metadata.Namespace)
+	}
+	if err != nil {
+		problem(c, http.StatusForbidden, models.CodeApprovalRequired, "No approved request covers this operation", err.Error())
+		return false
+	}
+
+	logger.Info("Consumed approval for elevated delete",
+		zap.String("approval_id", granted.ID),
+		zap.String("action", granted.Action),
+		zap.String("decided_by", granted.DecidedBy))
+	return true
+}
+
+// isSystemNamespace reports whether the namespace hosts cluster system
+// components and therefore requires elevated approval to operate in
+func isSystemNamespace(namespace string) bool {
+	switch namespace {
+	case "kube-system", "kube-public", "kube-node-lease":
+		return true
+	}
+	return false
+}
+
+// callerIdentity resolves the acting user, preferring the identity forwarded
+// by the authenticating proxy over the legacy X-Actor header
+func callerIdentity(c *gin.Context) string {
+	if creator, ok := identity.FromContext(c.Request.Context()); ok {
+		return creator.Subject
+	}
+	return c.GetHeader("X-Actor")
+}
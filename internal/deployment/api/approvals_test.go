@@ -0,0 +1,177 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/approval"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func newApprovalTestContext(t *testing.T, method, url, actor string, body interface{}) (*gin.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+
+	var reader *bytes.Buffer
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		assert.NoError(t, err)
+		reader = bytes.NewBuffer(bodyBytes)
+	} else {
+		reader = bytes.NewBuffer(nil)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if actor != "" {
+		req.Header.Set("X-Actor", actor)
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	return c, w
+}
+
+func TestApprovalWorkflow(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handler := &Handler{
+		approvalStore: approval.NewStore(time.Hour),
+		logger:        zap.NewNop(),
+	}
+
+	// File a request as alice
+	c, w := newApprovalTestContext(t, "POST", "/api/v1/admin/approvals", "alice", map[string]interface{}{
+		"action":       "force-delete",
+		"deploymentId": "test-id",
+		"reason":       "decommissioning",
+	})
+	handler.CreateApproval(c)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var created approval.Request
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, approval.StatusPending, created.Status)
+	assert.Equal(t, "alice", created.RequestedBy)
+
+	// The requester cannot approve their own request
+	c, w = newApprovalTestContext(t, "POST", "/api/v1/admin/approvals/"+created.ID+"/approve", "alice", nil)
+	c.Params = gin.Params{{Key: "id", Value: created.ID}}
+	handler.ApproveRequest(c)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "SELF_APPROVAL")
+
+	// A second operator can
+	c, w = newApprovalTestContext(t, "POST", "/api/v1/admin/approvals/"+created.ID+"/approve", "bob", nil)
+	c.Params = gin.Params{{Key: "id", Value: created.ID}}
+	handler.ApproveRequest(c)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), string(approval.StatusApproved))
+
+	// The request shows up in the listing
+	c, w = newApprovalTestContext(t, "GET", "/api/v1/admin/approvals", "", nil)
+	handler.ListApprovals(c)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var listed ListApprovalsResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	assert.Equal(t, 1, listed.Count)
+
+	// Unknown requests return 404
+	c, w = newApprovalTestContext(t, "POST", "/api/v1/admin/approvals/missing/deny", "bob", nil)
+	c.Params = gin.Params{{Key: "id", Value: "missing"}}
+	handler.DenyRequest(c)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "APPROVAL_NOT_FOUND")
+}
+
+func TestDeleteDeploymentRequiresApproval(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	protected := &models.DeploymentResponse{
+		ID:   "test-id",
+		Kind: models.DeploymentKindContainer,
+		Metadata: models.Metadata{
+			Name:      "dns-cache",
+			Namespace: "kube-system",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		setupApprovals func(store *approval.Store)
+		setupMock      func(*MockDeploymentService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "gated delete without force",
+			setupMock:      func(m *MockDeploymentService) {},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "PROTECTED_DEPLOYMENT",
+		},
+		{
+			name:           "forced delete without approval",
+			queryParams:    "?force=true",
+			setupMock:      func(m *MockDeploymentService) {},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "APPROVAL_REQUIRED",
+		},
+		{
+			name:        "forced delete with approved request",
+			queryParams: "?force=true",
+			setupApprovals: func(store *approval.Store) {
+				req := store.Create(approval.Request{
+					Action:      approval.ActionSystemNamespace,
+					Namespace:   "kube-system",
+					RequestedBy: "alice",
+				})
+				_, err := store.Approve(req.ID, "bob")
+				assert.NoError(t, err)
+			},
+			setupMock: func(m *MockDeploymentService) {
+				m.On("DeleteDeployment", mock.Anything, "test-id").Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockDeploymentService)
+			mockService.On("GetDeploymentByID", mock.Anything, "test-id").Return(protected, nil)
+			tt.setupMock(mockService)
+
+			store := approval.NewStore(time.Hour)
+			if tt.setupApprovals != nil {
+				tt.setupApprovals(store)
+			}
+
+			handler := &Handler{
+				deployService: mockService,
+				approvalStore: store,
+				logger:        zap.NewNop(),
+			}
+
+			c, w := newApprovalTestContext(t, "DELETE", "/api/v1/deployments/test-id"+tt.queryParams, "alice", nil)
+			c.Params = gin.Params{{Key: "id", Value: "test-id"}}
+			handler.DeleteDeployment(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
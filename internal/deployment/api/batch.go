@@ -0,0 +1,104 @@
+package api
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DeploymentCollectionVerb dispatches the custom collection verbs. Gin
+// captures everything after '/deployments' as the wildcard value, so the
+// verb arrives with its leading colon.
+func (h *Handler) DeploymentCollectionVerb(c *gin.Context) {
+	switch c.Param("verb") {
+	case ":batch":
+		h.BatchCreateDeployments(c)
+	case ":adopt":
+		h.AdoptDeployment(c)
+	default:
+		problem(c, http.StatusNotFound, models.CodeInvalidRequest, "Unknown deployment collection verb", c.Param("verb"))
+	}
+}
+
+// BatchCreateDeployments handles POST /deployments:batch
+// Each item is created concurrently and reported individually in the response
+func (h *Handler) BatchCreateDeployments(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "batch_create_deployments"))
+
+	var req models.BatchDeploymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error("Failed to bind request", zap.Error(err))
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid request format", err)
+		return
+	}
+
+	results := make([]models.BatchDeploymentResult, len(req.Deployments))
+
+	var wg sync.WaitGroup
+	for i := range req.Deployments {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+
+			item := req.Deployments[index]
+			results[index] = models.BatchDeploymentResult{Name: item.Metadata.Name}
+
+			// Set default namespace if not provided
+			if item.Metadata.Namespace == "" {
+				item.Metadata.Namespace = "default"
+			}
+
+			// Parse and validate the spec based on kind
+			if err := h.parseAndValidateSpec(&item); err != nil {
+				results[index].Error = err.Error()
+				return
+			}
+
+			deploymentID := uuid.New().String()
+			err := h.deployService.CreateDeployment(c.Request.Context(), &item, deploymentID)
+			h.recordOperation(c, "create", string(item.Kind), deploymentID, item.Metadata.Namespace, err)
+			if err != nil {
+				results[index].Error = err.Error()
+				return
+			}
+
+			results[index].Success = true
+			results[index].Deployment = &models.DeploymentResponse{
+				ID:       deploymentID,
+				Kind:     item.Kind,
+				Metadata: item.Metadata,
+				Spec:     item.Spec,
+				Status: models.DeploymentStatus{
+					Phase: models.DeploymentPhasePending,
+				},
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	response := models.BatchDeploymentResponse{Results: results}
+	for _, result := range results {
+		if result.Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+
+	logger.Info("Processed batch deployment request",
+		zap.Int("succeeded", response.Succeeded),
+		zap.Int("failed", response.Failed))
+
+	status := http.StatusCreated
+	if response.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	c.JSON(status, response)
+}
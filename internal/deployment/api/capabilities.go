@@ -0,0 +1,20 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// RefreshCapabilities handles POST /capabilities/refresh
+// It re-detects optional cluster capabilities (currently KubeVirt) so that
+// installing the KubeVirt CRDs after startup does not require a restart.
+func (h *Handler) RefreshCapabilities(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "refresh_capabilities"))
+
+	capabilities := h.deployService.RefreshCapabilities(c.Request.Context())
+
+	logger.Info("Capabilities refreshed", zap.Bool("kubevirt", capabilities.KubeVirt))
+	c.JSON(http.StatusOK, capabilities)
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/services"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// ConsoleProxy handles GET /deployments/{id}/console
+// It upgrades the connection to WebSocket and tunnels a KubeVirt VNC or
+// serial console stream, so a browser UI can attach to managed VMs without
+// direct cluster access. The console type is selected via ?type=vnc|serial.
+func (h *Handler) ConsoleProxy(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "console_proxy"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+
+	consoleType := c.DefaultQuery("type", services.ConsoleTypeSerial)
+	if consoleType != services.ConsoleTypeSerial && consoleType != services.ConsoleTypeVNC {
+		problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid console type, expected serial or vnc", "")
+		return
+	}
+
+	stream, err := h.deployService.StreamDeploymentConsole(c.Request.Context(), deploymentID, consoleType)
+	if err != nil {
+		logger.Error("Failed to open console stream", zap.Error(err))
+
+		if models.IsMultipleFoundError(err) {
+			problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
+			return
+		}
+
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		if models.IsConsoleLogNotSupportedError(err) {
+			problem(c, http.StatusBadRequest, models.CodeConsoleNotSupported, "Console access is only available for VM deployments", err.Error())
+			return
+		}
+
+		if models.IsKubeVirtUnavailableError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeKubeVirtUnavailable, "VM deployments are not supported on this cluster", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeConsoleFailed, "Failed to open console stream", err.Error())
+		return
+	}
+
+	backend := stream.AsConn()
+	defer func() {
+		if err := backend.Close(); err != nil {
+			logger.Debug("Failed to close console backend connection", zap.Error(err))
+		}
+	}()
+
+	conn, err := watchUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		logger.Error("Failed to upgrade connection to WebSocket", zap.Error(err))
+		return
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Debug("Failed to close WebSocket connection", zap.Error(err))
+		}
+	}()
+
+	logger.Info("Tunneling VM console",
+		zap.String("deployment_id", deploymentID),
+		zap.String("console_type", consoleType))
+
+	// Client -> guest: forward WebSocket messages to the console stream
+	go func() {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				// Client went away; unblock the read loop below
+				_ = backend.Close()
+				return
+			}
+			if _, err := backend.Write(data); err != nil {
+				return
+			}
+		}
+	}()
+
+	// Guest -> client: forward console output as binary WebSocket messages
+	buf := make([]byte, 4096)
+	for {
+		n, err := backend.Read(buf)
+		if n > 0 {
+			if writeErr := conn.WriteMessage(websocket.BinaryMessage, buf[:n]); writeErr != nil {
+				logger.Debug("Failed to write WebSocket message, closing", zap.Error(writeErr))
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeprecationNotice describes why a route or field is deprecated and when it
+// will be removed. The notice is surfaced through the standard Deprecation,
+// Sunset, Link and Warning response headers, which the envelope middleware
+// also folds into the response envelope for opted-in clients.
+type DeprecationNotice struct {
+	Note   string // human-readable migration hint, sent as a Warning header
+	Sunset string // optional HTTP-date after which the route may be removed
+	Link   string // optional link to migration documentation
+}
+
+// DeprecationRegistry maps routes to their deprecation notices. Routes are
+// keyed by method and Gin route pattern (e.g. "GET /api/v1/deployments/:id"),
+// so marking a route deprecates every request it serves.
+type DeprecationRegistry struct {
+	mu     sync.RWMutex
+	routes map[string]DeprecationNotice
+}
+
+// NewDeprecationRegistry creates an empty deprecation registry
+func NewDeprecationRegistry() *DeprecationRegistry {
+	return &DeprecationRegistry{
+		routes: make(map[string]DeprecationNotice),
+	}
+}
+
+// Mark registers a deprecation notice for a route
+func (r *DeprecationRegistry) Mark(method, path string, notice DeprecationNotice) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.routes[method+" "+path] = notice
+}
+
+// Lookup returns the deprecation notice for a route, if one is registered
+func (r *DeprecationRegistry) Lookup(method, path string) (DeprecationNotice, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	notice, ok := r.routes[method+" "+path]
+	return notice, ok
+}
+
+// Middleware adds deprecation headers to responses from marked routes
+func (r *DeprecationRegistry) Middleware() gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		notice, ok := r.Lookup(c.Request.Method, c.FullPath())
+		if !ok {
+			c.Next()
+			return
+		}
+
+		c.Header("Deprecation", "true")
+		if notice.Sunset != "" {
+			c.Header("Sunset", notice.Sunset)
+		}
+		if notice.Link != "" {
+			c.Header("Link", fmt.Sprintf("<%s>; rel=\"deprecation\"", notice.Link))
+		}
+		note := notice.Note
+		if note == "" {
+			note = "this endpoint is deprecated"
+		}
+		c.Writer.Header().Add("Warning", fmt.Sprintf("299 - %q", note))
+
+		c.Next()
+	})
+}
+
+// DeprecateField adds deprecation signalling to the current response for a
+// request that used a deprecated field. Handlers call this when they observe
+// the field, so only affected clients see the warning.
+func DeprecateField(c *gin.Context, field, note string) {
+	c.Header("Deprecation", "true")
+	c.Writer.Header().Add("Warning", fmt.Sprintf("299 - %q", fmt.Sprintf("field %s is deprecated: %s", field, note)))
+}
+
+// defaultDeprecations returns the registry of currently deprecated routes.
+// Entries are added here as v2 removals are announced; an empty registry
+// means nothing is scheduled for removal.
+func defaultDeprecations() *DeprecationRegistry {
+	return NewDeprecationRegistry()
+}
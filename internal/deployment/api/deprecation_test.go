@@ -0,0 +1,70 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupDeprecationRouter(registry *DeprecationRegistry) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(registry.Middleware())
+	router.GET("/api/v1/deployments/:id", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"id": c.Param("id")})
+	})
+	return router
+}
+
+func TestDeprecationMiddlewareMarkedRoute(t *testing.T) {
+	registry := NewDeprecationRegistry()
+	registry.Mark(http.MethodGet, "/api/v1/deployments/:id", DeprecationNotice{
+		Note:   "use GET /api/v2/deployments/{id} instead",
+		Sunset: "Sat, 01 Jan 2028 00:00:00 GMT",
+		Link:   "https://example.com/docs/v2-migration",
+	})
+	router := setupDeprecationRouter(registry)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments/abc", nil)
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "true", recorder.Header().Get("Deprecation"))
+	assert.Equal(t, "Sat, 01 Jan 2028 00:00:00 GMT", recorder.Header().Get("Sunset"))
+	assert.Equal(t, `<https://example.com/docs/v2-migration>; rel="deprecation"`, recorder.Header().Get("Link"))
+	assert.Equal(t, `299 - "use GET /api/v2/deployments/{id} instead"`, recorder.Header().Get("Warning"))
+}
+
+func TestDeprecationMiddlewareUnmarkedRoute(t *testing.T) {
+	router := setupDeprecationRouter(NewDeprecationRegistry())
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments/abc", nil)
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, recorder.Header().Get("Deprecation"))
+	assert.Empty(t, recorder.Header().Get("Warning"))
+}
+
+func TestDeprecateField(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/api/v1/deployments", func(c *gin.Context) {
+		if c.Query("legacy") != "" {
+			DeprecateField(c, "legacy", "use owner instead")
+		}
+		c.JSON(http.StatusOK, gin.H{})
+	})
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments?legacy=1", nil)
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, "true", recorder.Header().Get("Deprecation"))
+	assert.Equal(t, `299 - "field legacy is deprecated: use owner instead"`, recorder.Header().Get("Warning"))
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// AttachDeploymentDisk handles POST /deployments/:id/disks. The body is a
+// disk configuration; the disk is provisioned as a blank DataVolume and
+// hotplugged into the running VM.
+func (h *Handler) AttachDeploymentDisk(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "attach_deployment_disk"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+
+	var disk models.VMDiskConfig
+	if err := c.ShouldBindJSON(&disk); err != nil {
+		logger.Error("Failed to bind disk request", zap.Error(err))
+		problem(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid disk request", err.Error())
+		return
+	}
+
+	response, err := h.deployService.AttachDeploymentDisk(c.Request.Context(), deploymentID, &disk)
+	if err != nil {
+		logger.Error("Failed to attach disk", zap.Error(err))
+		h.recordOperation(c, models.ActionAttachDisk, "", deploymentID, "", err)
+		h.diskProblem(c, err)
+		return
+	}
+
+	h.recordOperation(c, models.ActionAttachDisk, "", deploymentID, "", nil)
+
+	logger.Info("Accepted disk attach",
+		zap.String("deployment_id", deploymentID),
+		zap.String("disk", disk.Name))
+	c.JSON(http.StatusAccepted, response)
+}
+
+// DetachDeploymentDisk handles DELETE /deployments/:id/disks/:name, unplugging
+// a hotplugged disk while keeping its DataVolume for a later re-attach
+func (h *Handler) DetachDeploymentDisk(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "detach_deployment_disk"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+	diskName := c.Param("name")
+
+	response, err := h.deployService.DetachDeploymentDisk(c.Request.Context(), deploymentID, diskName)
+	if err != nil {
+		logger.Error("Failed to detach disk", zap.Error(err))
+		h.recordOperation(c, models.ActionDetachDisk, "", deploymentID, "", err)
+		h.diskProblem(c, err)
+		return
+	}
+
+	h.recordOperation(c, models.ActionDetachDisk, "", deploymentID, "", nil)
+
+	logger.Info("Accepted disk detach",
+		zap.String("deployment_id", deploymentID),
+		zap.String("disk", diskName))
+	c.JSON(http.StatusAccepted, response)
+}
+
+// diskProblem maps disk hotplug errors onto the problem responses shared with
+// the actions endpoint
+func (h *Handler) diskProblem(c *gin.Context, err error) {
+	switch {
+	case models.IsMultipleFoundError(err):
+		problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
+	case models.IsNotFoundError(err):
+		problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+	case models.IsOperationInProgressError(err):
+		problem(c, http.StatusConflict, models.CodeOperationInProgress, "Another operation is already in progress for this deployment", err.Error())
+	case models.IsActionNotSupportedError(err):
+		problem(c, http.StatusBadRequest, models.CodeActionNotSupported, "Disk hotplug is only supported for VM deployments", err.Error())
+	case models.IsInvalidVMShapeError(err):
+		problem(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid disk configuration", err.Error())
+	case models.IsKubeVirtUnavailableError(err):
+		problem(c, http.StatusNotImplemented, models.CodeKubeVirtUnavailable, "VM deployments are not supported on this cluster", err.Error())
+	default:
+		problem(c, http.StatusInternalServerError, models.CodeActionFailed, "Failed to perform disk action", err.Error())
+	}
+}
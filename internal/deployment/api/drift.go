@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// GetDeploymentDrift handles GET /deployments/{id}/drift
+// It compares the spec recorded at the last write against the live
+// Kubernetes objects and reports the fields that no longer match
+func (h *Handler) GetDeploymentDrift(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "get_deployment_drift"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+
+	response, err := h.deployService.DeploymentDrift(c.Request.Context(), deploymentID)
+	if err != nil {
+		logger.Error("Failed to check deployment drift", zap.Error(err))
+
+		if models.IsMultipleFoundError(err) {
+			problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
+			return
+		}
+
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		if models.IsKubeVirtUnavailableError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeKubeVirtUnavailable, "VM deployments are not supported on this cluster", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeDriftCheckFailed, "Failed to check deployment drift", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
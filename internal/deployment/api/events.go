@@ -0,0 +1,89 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// GetDeploymentEvents handles GET /deployments/{id}/events
+// It returns the Kubernetes events related to the deployment's underlying
+// resources, such as scheduling failures and image pull errors
+func (h *Handler) GetDeploymentEvents(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "get_deployment_events"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+
+	response, err := h.deployService.GetDeploymentEvents(c.Request.Context(), deploymentID)
+	if err != nil {
+		logger.Error("Failed to get deployment events", zap.Error(err))
+
+		if models.IsMultipleFoundError(err) {
+			problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
+			return
+		}
+
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		if models.IsKubeVirtUnavailableError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeKubeVirtUnavailable, "VM deployments are not supported on this cluster", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeEventsFailed, "Failed to get deployment events", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// StreamEvents handles GET /events
+// It streams deployment lifecycle events from the event bus as Server-Sent Events
+func (h *Handler) StreamEvents(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "stream_events"))
+
+	if h.eventBus == nil {
+		problem(c, http.StatusServiceUnavailable, models.CodeEventsUnavailable, "Event streaming is not enabled", "")
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+
+	subscription := h.eventBus.Subscribe(c.Request.Context())
+	logger.Info("Client subscribed to event stream", zap.String("ip", c.ClientIP()))
+
+	// Periodic heartbeats keep intermediaries from closing idle connections
+	heartbeat := time.NewTicker(30 * time.Second)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-subscription:
+			if !ok {
+				return false
+			}
+			c.SSEvent(string(event.Type), event)
+			return true
+		case <-heartbeat.C:
+			c.SSEvent("heartbeat", time.Now().Format(time.RFC3339))
+			return true
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+
+	logger.Info("Client disconnected from event stream", zap.String("ip", c.ClientIP()))
+}
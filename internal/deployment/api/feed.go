@@ -0,0 +1,171 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/audit"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultFeedLimit = 50
+	maxFeedLimit     = 200
+)
+
+// GetNamespaceEvents handles GET /namespaces/:name/events
+// It aggregates provider audit entries and Kubernetes warning events for the
+// managed deployments in the namespace into a single activity feed.
+// Supported query parameters: severity (info|warning|error), from, to
+// (RFC3339), limit and offset.
+func (h *Handler) GetNamespaceEvents(c *gin.Context) {
+	namespace := c.Param("name")
+	logger := h.logger.Named("api_handler").With(
+		zap.String("endpoint", "get_namespace_events"),
+		zap.String("namespace", namespace))
+
+	severity := c.Query("severity")
+	switch severity {
+	case "", models.FeedSeverityInfo, models.FeedSeverityWarning, models.FeedSeverityError:
+	default:
+		problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid 'severity', expected info, warning or error", "")
+		return
+	}
+
+	var from, to time.Time
+	if raw := c.Query("from"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid 'from' timestamp, expected RFC3339", err.Error())
+			return
+		}
+		from = parsed
+	}
+	if raw := c.Query("to"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid 'to' timestamp, expected RFC3339", err.Error())
+			return
+		}
+		to = parsed
+	}
+
+	limit, offset, err := parseFeedPagination(c)
+	if err != nil {
+		problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid pagination parameters", err.Error())
+		return
+	}
+
+	var items []models.NamespaceFeedItem
+
+	if h.auditStore != nil {
+		operations := h.auditStore.List(&audit.Filter{Namespace: namespace, From: from, To: to})
+		for i := range operations {
+			items = append(items, auditFeedItem(&operations[i]))
+		}
+	}
+
+	warnings, err := h.deployService.NamespaceWarningEvents(c.Request.Context(), namespace)
+	if err != nil {
+		logger.Error("Failed to collect cluster warning events", zap.Error(err))
+		problem(c, http.StatusInternalServerError, models.CodeEventFeedFailed, "Failed to collect namespace events", err.Error())
+		return
+	}
+	for i := range warnings {
+		items = append(items, clusterFeedItem(&warnings[i]))
+	}
+
+	filtered := make([]models.NamespaceFeedItem, 0, len(items))
+	for _, item := range items {
+		if severity != "" && item.Severity != severity {
+			continue
+		}
+		if !from.IsZero() && item.Timestamp.Before(from) {
+			continue
+		}
+		if !to.IsZero() && item.Timestamp.After(to) {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+
+	// Newest entries first so the feed reads like an activity stream
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Timestamp.After(filtered[j].Timestamp)
+	})
+
+	total := len(filtered)
+	start := offset
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+
+	logger.Info("Successfully built namespace event feed", zap.Int("total", total))
+
+	c.JSON(http.StatusOK, models.NamespaceEventsResponse{
+		Namespace: namespace,
+		Events:    filtered[start:end],
+		Pagination: models.Pagination{
+			Limit:   limit,
+			Offset:  offset,
+			Total:   total,
+			HasMore: end < total,
+		},
+	})
+}
+
+// parseFeedPagination extracts limit and offset query parameters with feed defaults
+func parseFeedPagination(c *gin.Context) (limit, offset int, err error) {
+	limit = defaultFeedLimit
+	if raw := c.Query("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > maxFeedLimit {
+			return 0, 0, fmt.Errorf("limit must be an integer between 1 and %d", maxFeedLimit)
+		}
+	}
+	if raw := c.Query("offset"); raw != "" {
+		offset, err = strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			return 0, 0, fmt.Errorf("offset must be a non-negative integer")
+		}
+	}
+	return limit, offset, nil
+}
+
+// auditFeedItem converts an audit operation into a feed entry
+func auditFeedItem(op *audit.Operation) models.NamespaceFeedItem {
+	severity := models.FeedSeverityInfo
+	message := fmt.Sprintf("%s %s succeeded", op.Action, op.Kind)
+	if op.Outcome == audit.OutcomeFailure {
+		severity = models.FeedSeverityError
+		message = fmt.Sprintf("%s %s failed: %s", op.Action, op.Kind, op.Error)
+	}
+
+	return models.NamespaceFeedItem{
+		Timestamp:    op.Timestamp,
+		Source:       models.FeedSourceAudit,
+		Severity:     severity,
+		Message:      message,
+		DeploymentID: op.DeploymentID,
+	}
+}
+
+// clusterFeedItem converts a Kubernetes warning event into a feed entry
+func clusterFeedItem(event *models.DeploymentEvent) models.NamespaceFeedItem {
+	return models.NamespaceFeedItem{
+		Timestamp: event.LastSeen,
+		Source:    models.FeedSourceCluster,
+		Severity:  models.FeedSeverityWarning,
+		Message:   fmt.Sprintf("%s: %s", event.Reason, event.Message),
+		Object:    event.Object,
+	}
+}
@@ -2,11 +2,20 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/dcm-project/k8s-service-provider/internal/approval"
+	"github.com/dcm-project/k8s-service-provider/internal/audit"
+	"github.com/dcm-project/k8s-service-provider/internal/defaults"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/services"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/events"
+	"github.com/dcm-project/k8s-service-provider/internal/flavors"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+	"github.com/dcm-project/k8s-service-provider/internal/sshkeys"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -15,17 +24,46 @@ import (
 // Handler handles HTTP requests for the deployment service
 type Handler struct {
 	deployService services.DeploymentServiceInterface
+	auditStore    audit.StoreInterface
+	approvalStore approval.StoreInterface
+	eventBus      events.BusInterface
+	sshKeys       *sshkeys.Registry
 	logger        *zap.Logger
 }
 
 // NewHandler creates a new API handler
-func NewHandler(deployService services.DeploymentServiceInterface, logger *zap.Logger) *Handler {
+func NewHandler(deployService services.DeploymentServiceInterface, auditStore audit.StoreInterface, approvalStore approval.StoreInterface, eventBus events.BusInterface, sshKeys *sshkeys.Registry, logger *zap.Logger) *Handler {
 	return &Handler{
 		deployService: deployService,
+		auditStore:    auditStore,
+		approvalStore: approvalStore,
+		eventBus:      eventBus,
+		sshKeys:       sshKeys,
 		logger:        logger,
 	}
 }
 
+// recordOperation records a mutating operation in the audit store if one is configured
+func (h *Handler) recordOperation(c *gin.Context, action, kind, deploymentID, namespace string, err error) {
+	if h.auditStore == nil {
+		return
+	}
+
+	op := audit.Operation{
+		Actor:        c.GetHeader("X-Actor"),
+		Action:       action,
+		Kind:         kind,
+		DeploymentID: deploymentID,
+		Namespace:    namespace,
+		Outcome:      audit.OutcomeSuccess,
+	}
+	if err != nil {
+		op.Outcome = audit.OutcomeFailure
+		op.Error = err.Error()
+	}
+	h.auditStore.Record(op)
+}
+
 // CreateDeployment handles POST /deployments
 func (h *Handler) CreateDeployment(c *gin.Context) {
 	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "create_deployment"))
@@ -33,12 +71,7 @@ func (h *Handler) CreateDeployment(c *gin.Context) {
 	var req models.DeploymentRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		logger.Error("Failed to bind request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Code:      "INVALID_REQUEST",
-			Message:   "Invalid request format",
-			Details:   err.Error(),
-			Timestamp: time.Now(),
-		})
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid request format", err)
 		return
 	}
 
@@ -53,36 +86,99 @@ func (h *Handler) CreateDeployment(c *gin.Context) {
 	// Parse and validate the spec based on kind
 	if err := h.parseAndValidateSpec(&req); err != nil {
 		logger.Error("Failed to validate spec", zap.Error(err))
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Code:      "INVALID_SPEC",
-			Message:   "Invalid deployment specification",
-			Details:   err.Error(),
-			Timestamp: time.Now(),
-		})
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidSpec, "Invalid deployment specification", err)
+		return
+	}
+
+	// Dry-run: validate and render the manifests without persisting anything
+	if c.Query("dryRun") == "true" {
+		h.dryRunDeployment(c, &req, deploymentID)
+		return
+	}
+
+	// Synchronous create: ?wait=true blocks until the workload settles. The
+	// options are validated up front, before anything is persisted.
+	wait, waitTimeout, err := parseWaitOptions(c)
+	if err != nil {
+		logger.Error("Invalid wait parameters", zap.Error(err))
+		problem(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid wait parameters", err.Error())
 		return
 	}
 
 	// Create the deployment
 	if err := h.deployService.CreateDeployment(c.Request.Context(), &req, deploymentID); err != nil {
 		logger.Error("Failed to create deployment", zap.Error(err))
+		h.recordOperation(c, "create", string(req.Kind), deploymentID, req.Metadata.Namespace, err)
 
 		// Check if error is due to ID conflicts
 		if models.IsConflictError(err) {
-			c.JSON(http.StatusConflict, models.ErrorResponse{
-				Code:      "DEPLOYMENT_ID_EXISTS",
-				Message:   "Deployment ID already exists",
-				Details:   err.Error(),
-				Timestamp: time.Now(),
-			})
+			problem(c, http.StatusConflict, models.CodeDeploymentIDExists, "Deployment ID already exists", err.Error())
+			return
+		}
+
+		// Check if a conflicting operation holds the deployment lock
+		if models.IsOperationInProgressError(err) {
+			problem(c, http.StatusConflict, models.CodeOperationInProgress, "Another operation is already in progress for this deployment", err.Error())
+			return
+		}
+
+		// Check if the cluster lacks KubeVirt support
+		if models.IsKubeVirtUnavailableError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeKubeVirtUnavailable, "VM deployments are not supported on this cluster", err.Error())
+			return
+		}
+
+		// Check if the requested VM shape violates the operator-defined limits
+		if models.IsInvalidVMShapeError(err) {
+			problem(c, http.StatusBadRequest, models.CodeInvalidVMShape, "Requested VM shape is not allowed on this cluster", err.Error())
+			return
+		}
+
+		// Check if a spec field failed service-side validation
+		if models.IsInvalidSpecError(err) {
+			problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidSpec, "Invalid deployment specification", err)
+			return
+		}
+
+		// Check if the spec uses a field the cluster cannot honor
+		if models.IsFieldNotSupportedError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeFieldNotSupported, "Deployment specification uses a field this cluster cannot honor", err.Error())
 			return
 		}
 
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Code:      "DEPLOYMENT_FAILED",
-			Message:   "Failed to create deployment",
-			Details:   err.Error(),
-			Timestamp: time.Now(),
-		})
+		// Check if the name is already taken in the target namespace
+		if models.IsNameConflictError(err) {
+			problem(c, http.StatusConflict, models.CodeNameConflict, "Deployment name is already in use in the target namespace", err.Error())
+			return
+		}
+
+		// Check if the request targets an unregistered cluster
+		if models.IsClusterNotFoundError(err) {
+			problem(c, http.StatusBadRequest, models.CodeClusterNotFound, "Requested cluster is not registered with this provider", err.Error())
+			return
+		}
+
+		// Check if the tenant does not own the target namespace
+		if models.IsNamespaceForbiddenError(err) {
+			problem(c, http.StatusForbidden, models.CodeNamespaceForbidden, "Target namespace is not allowed for this tenant", err.Error())
+			return
+		}
+
+		// Check if the namespace quota has no headroom for the request
+		if models.IsQuotaExceededError(err) {
+			problem(c, http.StatusForbidden, models.CodeQuotaExceeded, "Target namespace resource quota has no headroom for this deployment", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeDeploymentFailed, "Failed to create deployment", err.Error())
+		return
+	}
+
+	h.recordOperation(c, "create", string(req.Kind), deploymentID, req.Metadata.Namespace, nil)
+
+	// Block until the workload settles when the caller asked for it
+	if wait {
+		h.waitForDeployment(c, deploymentID, waitTimeout)
 		return
 	}
 
@@ -109,11 +205,7 @@ func (h *Handler) GetDeployment(c *gin.Context) {
 
 	deploymentID := c.Param("id")
 	if deploymentID == "" {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Code:      "MISSING_ID",
-			Message:   "Deployment ID is required",
-			Timestamp: time.Now(),
-		})
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
 		return
 	}
 
@@ -123,33 +215,18 @@ func (h *Handler) GetDeployment(c *gin.Context) {
 
 		// Check if error indicates multiple deployments found
 		if models.IsMultipleFoundError(err) {
-			c.JSON(http.StatusConflict, models.ErrorResponse{
-				Code:      "DEPLOYMENT_ID_CONFLICT",
-				Message:   "Multiple deployments found with the same ID across different namespaces",
-				Details:   err.Error(),
-				Timestamp: time.Now(),
-			})
+			problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
 			return
 		}
 
 		// Check if deployment not found
 		if models.IsNotFoundError(err) {
-			c.JSON(http.StatusNotFound, models.ErrorResponse{
-				Code:      "DEPLOYMENT_NOT_FOUND",
-				Message:   "Deployment not found",
-				Details:   err.Error(),
-				Timestamp: time.Now(),
-			})
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
 			return
 		}
 
 		// Any other error
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Code:      "INTERNAL_ERROR",
-			Message:   "Internal server error",
-			Details:   err.Error(),
-			Timestamp: time.Now(),
-		})
+		problem(c, http.StatusInternalServerError, models.CodeInternalError, "Internal server error", err.Error())
 		return
 	}
 
@@ -163,23 +240,14 @@ func (h *Handler) UpdateDeployment(c *gin.Context) {
 
 	deploymentID := c.Param("id")
 	if deploymentID == "" {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Code:      "MISSING_ID",
-			Message:   "Deployment ID is required",
-			Timestamp: time.Now(),
-		})
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
 		return
 	}
 
 	var req models.DeploymentRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		logger.Error("Failed to bind request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Code:      "INVALID_REQUEST",
-			Message:   "Invalid request format",
-			Details:   err.Error(),
-			Timestamp: time.Now(),
-		})
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid request format", err)
 		return
 	}
 
@@ -191,97 +259,322 @@ func (h *Handler) UpdateDeployment(c *gin.Context) {
 	// Parse and validate the spec based on kind
 	if err := h.parseAndValidateSpec(&req); err != nil {
 		logger.Error("Failed to validate spec", zap.Error(err))
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Code:      "INVALID_SPEC",
-			Message:   "Invalid deployment specification",
-			Details:   err.Error(),
-			Timestamp: time.Now(),
-		})
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidSpec, "Invalid deployment specification", err)
+		return
+	}
+
+	// Dry-run: validate and render the manifests without persisting anything
+	if c.Query("dryRun") == "true" {
+		h.dryRunDeployment(c, &req, deploymentID)
 		return
 	}
 
 	// Update the deployment
 	if err := h.deployService.UpdateDeployment(c.Request.Context(), &req, deploymentID); err != nil {
 		logger.Error("Failed to update deployment", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Code:      "UPDATE_FAILED",
-			Message:   "Failed to update deployment",
-			Details:   err.Error(),
-			Timestamp: time.Now(),
-		})
+		h.recordOperation(c, "update", string(req.Kind), deploymentID, req.Metadata.Namespace, err)
+
+		// Check if a conflicting operation holds the deployment lock
+		if models.IsOperationInProgressError(err) {
+			problem(c, http.StatusConflict, models.CodeOperationInProgress, "Another operation is already in progress for this deployment", err.Error())
+			return
+		}
+
+		// Check if the cluster lacks KubeVirt support
+		if models.IsKubeVirtUnavailableError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeKubeVirtUnavailable, "VM deployments are not supported on this cluster", err.Error())
+			return
+		}
+
+		// Check if the requested VM shape violates the operator-defined limits
+		if models.IsInvalidVMShapeError(err) {
+			problem(c, http.StatusBadRequest, models.CodeInvalidVMShape, "Requested VM shape is not allowed on this cluster", err.Error())
+			return
+		}
+
+		// Check if a spec field failed service-side validation
+		if models.IsInvalidSpecError(err) {
+			problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidSpec, "Invalid deployment specification", err)
+			return
+		}
+
+		// Check if the spec uses a field the cluster cannot honor
+		if models.IsFieldNotSupportedError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeFieldNotSupported, "Deployment specification uses a field this cluster cannot honor", err.Error())
+			return
+		}
+
+		// Check if the deployment was not found (including IDs outside the
+		// tenant's namespaces)
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		// Check if the request targets an unregistered cluster
+		if models.IsClusterNotFoundError(err) {
+			problem(c, http.StatusBadRequest, models.CodeClusterNotFound, "Requested cluster is not registered with this provider", err.Error())
+			return
+		}
+
+		// Check if the tenant does not own the target namespace
+		if models.IsNamespaceForbiddenError(err) {
+			problem(c, http.StatusForbidden, models.CodeNamespaceForbidden, "Target namespace is not allowed for this tenant", err.Error())
+			return
+		}
+
+		// Check if the namespace quota has no headroom for the request
+		if models.IsQuotaExceededError(err) {
+			problem(c, http.StatusForbidden, models.CodeQuotaExceeded, "Target namespace resource quota has no headroom for this deployment", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeUpdateFailed, "Failed to update deployment", err.Error())
 		return
 	}
 
-	// Return the updated deployment
+	h.recordOperation(c, "update", string(req.Kind), deploymentID, req.Metadata.Namespace, nil)
+
+	// Return the updated deployment with the persisted timestamps, and tell
+	// the caller when an in-place VM resize still waits on a guest restart
+	createdAt := time.Now()
+	updatedAt := time.Now()
+	restartRequired := false
+	if updated, err := h.deployService.GetDeploymentByID(c.Request.Context(), deploymentID); err == nil {
+		createdAt = updated.CreatedAt
+		updatedAt = updated.UpdatedAt
+		restartRequired = updated.Status.RestartRequired
+	}
+
 	response := models.DeploymentResponse{
 		ID:       deploymentID,
 		Kind:     req.Kind,
 		Metadata: req.Metadata,
 		Spec:     req.Spec,
 		Status: models.DeploymentStatus{
-			Phase: models.DeploymentPhasePending,
+			Phase:           models.DeploymentPhasePending,
+			RestartRequired: restartRequired,
 		},
-		CreatedAt: time.Now(), // In a real implementation, preserve original creation time
-		UpdatedAt: time.Now(),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
 	}
 
 	logger.Info("Successfully updated deployment", zap.String("deployment_id", deploymentID))
 	c.JSON(http.StatusOK, response)
 }
 
+// PatchDeploymentMetadata handles PATCH /deployments/:id
+// It updates the ops notes and tags stored on a deployment without
+// recreating any cluster resources.
+func (h *Handler) PatchDeploymentMetadata(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "patch_deployment_metadata"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+
+	var req models.UpdateDeploymentMetadataRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error("Failed to bind request", zap.Error(err))
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid request format", err)
+		return
+	}
+
+	updated, err := h.deployService.UpdateDeploymentMetadata(c.Request.Context(), deploymentID, &req)
+	if err != nil {
+		logger.Error("Failed to update deployment metadata", zap.Error(err))
+
+		// Check if a conflicting operation holds the deployment lock
+		if models.IsOperationInProgressError(err) {
+			problem(c, http.StatusConflict, models.CodeOperationInProgress, "Another operation is already in progress for this deployment", err.Error())
+			return
+		}
+
+		// Check if it's a not found error
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		// Check if multiple deployments were found (data integrity issue)
+		if models.IsMultipleFoundError(err) {
+			problem(c, http.StatusConflict, models.CodeMultipleDeploymentsFound, "Multiple deployments found with the same ID", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeMetadataUpdateFailed, "Failed to update deployment metadata", err.Error())
+		return
+	}
+
+	h.recordOperation(c, "update-metadata", string(updated.Kind), deploymentID, updated.Metadata.Namespace, nil)
+
+	logger.Info("Successfully updated deployment metadata", zap.String("deployment_id", deploymentID))
+	c.JSON(http.StatusOK, updated)
+}
+
+// dryRunDeployment runs the shared dry-run path for create and update,
+// returning the rendered manifests or a mapped validation error
+func (h *Handler) dryRunDeployment(c *gin.Context, req *models.DeploymentRequest, deploymentID string) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "dry_run_deployment"))
+
+	response, err := h.deployService.DryRunDeployment(c.Request.Context(), req, deploymentID)
+	if err != nil {
+		logger.Error("Deployment dry-run failed", zap.Error(err))
+
+		// Check if the cluster lacks KubeVirt support
+		if models.IsKubeVirtUnavailableError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeKubeVirtUnavailable, "VM deployments are not supported on this cluster", err.Error())
+			return
+		}
+
+		// Check if the requested VM shape violates the operator-defined limits
+		if models.IsInvalidVMShapeError(err) {
+			problem(c, http.StatusBadRequest, models.CodeInvalidVMShape, "Requested VM shape is not allowed on this cluster", err.Error())
+			return
+		}
+
+		// Check if a spec field failed service-side validation
+		if models.IsInvalidSpecError(err) {
+			problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidSpec, "Invalid deployment specification", err)
+			return
+		}
+
+		// Check if the spec uses a field the cluster cannot honor
+		if models.IsFieldNotSupportedError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeFieldNotSupported, "Deployment specification uses a field this cluster cannot honor", err.Error())
+			return
+		}
+
+		problem(c, http.StatusBadRequest, models.CodeDryRunFailed, "Deployment dry-run was rejected by the cluster", err.Error())
+		return
+	}
+
+	logger.Info("Deployment dry-run succeeded",
+		zap.String("kind", string(req.Kind)),
+		zap.String("deployment_id", deploymentID))
+	c.JSON(http.StatusOK, response)
+}
+
 // DeleteDeployment handles DELETE /deployments/{id}
 func (h *Handler) DeleteDeployment(c *gin.Context) {
 	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "delete_deployment"))
 
 	deploymentID := c.Param("id")
 	if deploymentID == "" {
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Code:      "MISSING_ID",
-			Message:   "Deployment ID is required",
-			Timestamp: time.Now(),
-		})
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
 		return
 	}
 
+	// Deletes of protected or system-namespace deployments are gated behind
+	// the approval workflow; look the deployment up to classify it first.
+	// Lookup failures fall through so the delete surfaces its own error.
+	force := c.Query("force") == "true"
+	if deployment, err := h.deployService.GetDeploymentByID(c.Request.Context(), deploymentID); err == nil {
+		if !h.requireElevation(c, logger, deployment, force) {
+			return
+		}
+	}
+
 	// Delete the deployment (service will auto-detect namespace and kind)
 	if err := h.deployService.DeleteDeployment(c.Request.Context(), deploymentID); err != nil {
 		logger.Error("Failed to delete deployment", zap.Error(err))
+		h.recordOperation(c, "delete", "", deploymentID, "", err)
 
 		// Check if error indicates multiple deployments found
 		if models.IsMultipleFoundError(err) {
-			c.JSON(http.StatusConflict, models.ErrorResponse{
-				Code:      "DEPLOYMENT_ID_CONFLICT",
-				Message:   "Multiple deployments found with the same ID across different namespaces",
-				Details:   err.Error(),
-				Timestamp: time.Now(),
-			})
+			problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
 			return
 		}
 
 		// Check if deployment not found
 		if models.IsNotFoundError(err) {
-			c.JSON(http.StatusNotFound, models.ErrorResponse{
-				Code:      "DEPLOYMENT_NOT_FOUND",
-				Message:   "Deployment not found",
-				Details:   err.Error(),
-				Timestamp: time.Now(),
-			})
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		// Check if a conflicting operation holds the deployment lock
+		if models.IsOperationInProgressError(err) {
+			problem(c, http.StatusConflict, models.CodeOperationInProgress, "Another operation is already in progress for this deployment", err.Error())
 			return
 		}
 
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Code:      "DELETE_FAILED",
-			Message:   "Failed to delete deployment",
-			Details:   err.Error(),
-			Timestamp: time.Now(),
-		})
+		problem(c, http.StatusInternalServerError, models.CodeDeleteFailed, "Failed to delete deployment", err.Error())
 		return
 	}
 
+	h.recordOperation(c, "delete", "", deploymentID, "", nil)
+
 	logger.Info("Successfully deleted deployment", zap.String("deployment_id", deploymentID))
 	c.AbortWithStatus(http.StatusNoContent)
 }
 
+// PerformDeploymentAction handles POST /deployments/:id/actions
+func (h *Handler) PerformDeploymentAction(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "perform_deployment_action"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+
+	var req models.DeploymentActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error("Failed to bind action request", zap.Error(err))
+		problem(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid action request", err.Error())
+		return
+	}
+
+	response, err := h.deployService.PerformDeploymentAction(c.Request.Context(), deploymentID, req.Action)
+	if err != nil {
+		logger.Error("Failed to perform deployment action", zap.Error(err))
+		h.recordOperation(c, req.Action, "", deploymentID, "", err)
+
+		// Check if error indicates multiple deployments found
+		if models.IsMultipleFoundError(err) {
+			problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
+			return
+		}
+
+		// Check if deployment not found
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		// Check if a conflicting operation holds the deployment lock
+		if models.IsOperationInProgressError(err) {
+			problem(c, http.StatusConflict, models.CodeOperationInProgress, "Another operation is already in progress for this deployment", err.Error())
+			return
+		}
+
+		// Check if the deployment kind does not support power actions
+		if models.IsActionNotSupportedError(err) {
+			problem(c, http.StatusBadRequest, models.CodeActionNotSupported, "Power actions are only supported for VM deployments", err.Error())
+			return
+		}
+
+		// Check if KubeVirt is not installed on the cluster
+		if models.IsKubeVirtUnavailableError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeKubeVirtUnavailable, "VM deployments are not supported on this cluster", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeActionFailed, "Failed to perform deployment action", err.Error())
+		return
+	}
+
+	h.recordOperation(c, req.Action, "", deploymentID, "", nil)
+
+	logger.Info("Accepted deployment action",
+		zap.String("deployment_id", deploymentID),
+		zap.String("action", req.Action),
+		zap.String("phase", string(response.Phase)))
+	c.JSON(http.StatusAccepted, response)
+}
+
 // ListDeployments handles GET /deployments
 func (h *Handler) ListDeployments(c *gin.Context) {
 	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "list_deployments"))
@@ -289,12 +582,7 @@ func (h *Handler) ListDeployments(c *gin.Context) {
 	var req models.ListDeploymentsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
 		logger.Error("Failed to bind query parameters", zap.Error(err))
-		c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Code:      "INVALID_QUERY",
-			Message:   "Invalid query parameters",
-			Details:   err.Error(),
-			Timestamp: time.Now(),
-		})
+		problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid query parameters", err.Error())
 		return
 	}
 
@@ -304,15 +592,28 @@ func (h *Handler) ListDeployments(c *gin.Context) {
 	}
 	// Keep namespace empty if not specified - service will search all namespaces
 
+	// Resolve the "me" owner filter to the caller identity
+	if req.Owner == "me" {
+		creator, ok := identity.FromContext(c.Request.Context())
+		if !ok {
+			problem(c, http.StatusBadRequest, models.CodeMissingIdentity, "The owner=me filter requires a caller identity", fmt.Sprintf("no %s header was forwarded with the request", identity.HeaderForwardedUser))
+			return
+		}
+		req.Owner = creator.Subject
+	}
+
 	response, err := h.deployService.ListDeployments(c.Request.Context(), &req)
 	if err != nil {
+		if models.IsInvalidContinueTokenError(err) {
+			problem(c, http.StatusBadRequest, models.CodeInvalidContinue, "Invalid continue token", "the continue token is not one returned by a previous list response")
+			return
+		}
+		if models.IsClusterNotFoundError(err) {
+			problem(c, http.StatusBadRequest, models.CodeClusterNotFound, "Requested cluster is not registered with this provider", err.Error())
+			return
+		}
 		logger.Error("Failed to list deployments", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Code:      "LIST_FAILED",
-			Message:   "Failed to list deployments",
-			Details:   err.Error(),
-			Timestamp: time.Now(),
-		})
+		problem(c, http.StatusInternalServerError, models.CodeListFailed, "Failed to list deployments", err.Error())
 		return
 	}
 
@@ -320,6 +621,100 @@ func (h *Handler) ListDeployments(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// GetDefaultsProfiles handles GET /defaults/profiles
+func (h *Handler) GetDefaultsProfiles(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "get_defaults_profiles"))
+
+	profiles := h.deployService.DefaultsProfiles(c.Request.Context())
+	if profiles == nil {
+		profiles = []defaults.Profile{}
+	}
+
+	logger.Info("Listed defaults profiles", zap.Int("count", len(profiles)))
+	c.JSON(http.StatusOK, gin.H{
+		"profiles": profiles,
+		"count":    len(profiles),
+	})
+}
+
+// GetVMFlavors handles GET /catalog/flavors
+// It lists the named CPU/RAM/disk shapes a VM spec can reference instead of
+// raw numbers
+func (h *Handler) GetVMFlavors(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "get_vm_flavors"))
+
+	catalog := h.deployService.VMFlavors(c.Request.Context())
+
+	logger.Info("Listed VM flavors", zap.Int("count", len(catalog)))
+	c.JSON(http.StatusOK, flavors.ListResponse{Flavors: catalog})
+}
+
+// BuildSyncPlan handles POST /admin/sync/plan
+func (h *Handler) BuildSyncPlan(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "build_sync_plan"))
+
+	var req models.SyncPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error("Failed to bind request", zap.Error(err))
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid request format", err)
+		return
+	}
+
+	if !h.parseSyncRecordSpecs(c, logger, req.Records) {
+		return
+	}
+
+	response, err := h.deployService.BuildSyncPlan(c.Request.Context(), &req)
+	if err != nil {
+		logger.Error("Failed to build sync plan", zap.Error(err))
+		problem(c, http.StatusInternalServerError, models.CodeSyncPlanFailed, "Failed to build sync plan", err.Error())
+		return
+	}
+
+	logger.Info("Successfully built sync plan", zap.Int("records", len(req.Records)))
+	c.JSON(http.StatusOK, response)
+}
+
+// ApplySyncPlan handles POST /admin/sync/apply
+func (h *Handler) ApplySyncPlan(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "apply_sync_plan"))
+
+	var req models.SyncApplyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error("Failed to bind request", zap.Error(err))
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid request format", err)
+		return
+	}
+
+	if !h.parseSyncRecordSpecs(c, logger, req.Records) {
+		return
+	}
+
+	response, err := h.deployService.ApplySyncPlan(c.Request.Context(), &req)
+	if err != nil {
+		logger.Error("Failed to apply sync plan", zap.Error(err))
+		problem(c, http.StatusInternalServerError, models.CodeSyncApplyFailed, "Failed to apply sync plan", err.Error())
+		return
+	}
+
+	logger.Info("Finished sync apply", zap.Int("records", len(req.Records)))
+	c.JSON(http.StatusOK, response)
+}
+
+// parseSyncRecordSpecs validates the embedded deployment spec of each sync
+// record, writing an error response and returning false on the first failure
+func (h *Handler) parseSyncRecordSpecs(c *gin.Context, logger *zap.Logger, records []models.SyncRecord) bool {
+	for i := range records {
+		if err := h.parseAndValidateSpec(&records[i].Deployment); err != nil {
+			logger.Error("Failed to validate record spec",
+				zap.String("record_id", records[i].ID), zap.Error(err))
+			problem(c, http.StatusBadRequest, models.CodeInvalidSpec, "Invalid deployment specification in sync record", fmt.Sprintf("record %s: %v", records[i].ID, err))
+			return false
+		}
+	}
+	return true
+}
+
 // HealthCheck handles GET /health
 func (h *Handler) HealthCheck(c *gin.Context) {
 	response := models.HealthResponse{
@@ -341,22 +736,68 @@ func (h *Handler) parseAndValidateSpec(req *models.DeploymentRequest) error {
 	case models.DeploymentKindContainer:
 		var containerSpec models.ContainerSpec
 		if err := json.Unmarshal(specBytes, &containerSpec); err != nil {
-			return err
+			return specUnmarshalError(err)
 		}
 		req.Spec = containerSpec
 	case models.DeploymentKindVM:
 		var vmSpec models.VMSpec
 		if err := json.Unmarshal(specBytes, &vmSpec); err != nil {
-			return err
+			return specUnmarshalError(err)
 		}
 		req.Spec = vmSpec
+	case models.DeploymentKindJob:
+		var jobSpec models.JobSpec
+		if err := json.Unmarshal(specBytes, &jobSpec); err != nil {
+			return specUnmarshalError(err)
+		}
+		req.Spec = jobSpec
+	case models.DeploymentKindCronJob:
+		var cronJobSpec models.CronJobSpec
+		if err := json.Unmarshal(specBytes, &cronJobSpec); err != nil {
+			return specUnmarshalError(err)
+		}
+		req.Spec = cronJobSpec
+	case models.DeploymentKindStatefulSet:
+		var statefulSetSpec models.StatefulSetSpec
+		if err := json.Unmarshal(specBytes, &statefulSetSpec); err != nil {
+			return specUnmarshalError(err)
+		}
+		req.Spec = statefulSetSpec
+	case models.DeploymentKindManifest:
+		var manifestSpec models.ManifestSpec
+		if err := json.Unmarshal(specBytes, &manifestSpec); err != nil {
+			return specUnmarshalError(err)
+		}
+		req.Spec = manifestSpec
 	default:
 		return NewValidationError("unsupported deployment kind")
 	}
 
+	// Check the typed spec against field-level constraints, reporting every
+	// offending field at once
+	if violations := validateDeploymentRequest(req); len(violations) > 0 {
+		return models.NewErrSpecViolations(violations)
+	}
+
 	return nil
 }
 
+// specUnmarshalError turns an opaque JSON unmarshal error into a field-level
+// violation, pinning type mismatches to the offending spec field
+func specUnmarshalError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return models.NewErrSpecViolations([]models.FieldViolation{{
+			Field:  "spec." + typeErr.Field,
+			Reason: fmt.Sprintf("expected a %s value", typeErr.Type),
+		}})
+	}
+	return models.NewErrSpecViolations([]models.FieldViolation{{
+		Field:  "spec",
+		Reason: err.Error(),
+	}})
+}
+
 // ValidationError represents a validation error
 type ValidationError struct {
 	message string
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/dcm-project/k8s-service-provider/internal/defaults"
+	"github.com/dcm-project/k8s-service-provider/internal/flavors"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/services"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
 	"github.com/gin-gonic/gin"
@@ -15,6 +18,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
 	"k8s.io/utils/ptr"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 )
 
 // MockDeploymentService is a mock implementation of the deployment service
@@ -30,6 +34,14 @@ func (m *MockDeploymentService) CreateDeployment(ctx context.Context, req *model
 	return args.Error(0)
 }
 
+func (m *MockDeploymentService) DryRunDeployment(ctx context.Context, req *models.DeploymentRequest, id string) (*models.DryRunResponse, error) {
+	args := m.Called(ctx, req, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DryRunResponse), args.Error(1)
+}
+
 func (m *MockDeploymentService) GetDeploymentByID(ctx context.Context, id string) (*models.DeploymentResponse, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -43,6 +55,14 @@ func (m *MockDeploymentService) UpdateDeployment(ctx context.Context, req *model
 	return args.Error(0)
 }
 
+func (m *MockDeploymentService) UpdateDeploymentMetadata(ctx context.Context, id string, req *models.UpdateDeploymentMetadataRequest) (*models.DeploymentResponse, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DeploymentResponse), args.Error(1)
+}
+
 func (m *MockDeploymentService) DeleteDeployment(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -56,6 +76,171 @@ func (m *MockDeploymentService) ListDeployments(ctx context.Context, req *models
 	return args.Get(0).(*models.ListDeploymentsResponse), args.Error(1)
 }
 
+func (m *MockDeploymentService) WatchDeployment(ctx context.Context, id string) (<-chan models.DeploymentResponse, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(<-chan models.DeploymentResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) GetDeploymentLogs(ctx context.Context, id string, req *models.DeploymentLogsRequest) (io.ReadCloser, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockDeploymentService) GetDeploymentConsoleLog(ctx context.Context, id string, req *models.DeploymentLogsRequest) (io.ReadCloser, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockDeploymentService) StreamDeploymentConsole(ctx context.Context, id, consoleType string) (kvcorev1.StreamInterface, error) {
+	args := m.Called(ctx, id, consoleType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(kvcorev1.StreamInterface), args.Error(1)
+}
+
+func (m *MockDeploymentService) GetDeploymentEvents(ctx context.Context, id string) (*models.DeploymentEventsResponse, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DeploymentEventsResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) GetDeploymentMetrics(ctx context.Context, id string) (*models.DeploymentMetricsResponse, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DeploymentMetricsResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) NamespaceWarningEvents(ctx context.Context, namespace string) ([]models.DeploymentEvent, error) {
+	args := m.Called(ctx, namespace)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.DeploymentEvent), args.Error(1)
+}
+
+func (m *MockDeploymentService) PerformDeploymentAction(ctx context.Context, id, action string) (*models.DeploymentActionResponse, error) {
+	args := m.Called(ctx, id, action)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DeploymentActionResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) AttachDeploymentDisk(ctx context.Context, id string, disk *models.VMDiskConfig) (*models.DeploymentActionResponse, error) {
+	args := m.Called(ctx, id, disk)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DeploymentActionResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) DetachDeploymentDisk(ctx context.Context, id, diskName string) (*models.DeploymentActionResponse, error) {
+	args := m.Called(ctx, id, diskName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DeploymentActionResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) AdoptDeployment(ctx context.Context, req *models.AdoptDeploymentRequest, id string) (*models.DeploymentResponse, error) {
+	args := m.Called(ctx, req, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DeploymentResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) DeploymentDrift(ctx context.Context, id string) (*models.DriftResponse, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DriftResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) SweepOrphans(ctx context.Context, remove bool) (*models.OrphanSweepResponse, error) {
+	args := m.Called(ctx, remove)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.OrphanSweepResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) StartNodeMaintenance(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error) {
+	args := m.Called(ctx, node)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.NodeMaintenanceResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) NodeMaintenanceStatus(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error) {
+	args := m.Called(ctx, node)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.NodeMaintenanceResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) EndNodeMaintenance(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error) {
+	args := m.Called(ctx, node)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.NodeMaintenanceResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) DefaultsProfiles(ctx context.Context) []defaults.Profile {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]defaults.Profile)
+}
+
+func (m *MockDeploymentService) VMFlavors(ctx context.Context) []flavors.Flavor {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]flavors.Flavor)
+}
+
+func (m *MockDeploymentService) BuildSyncPlan(ctx context.Context, req *models.SyncPlanRequest) (*models.SyncPlanResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SyncPlanResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) ApplySyncPlan(ctx context.Context, req *models.SyncApplyRequest) (*models.SyncApplyResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SyncApplyResponse), args.Error(1)
+}
+
+func (m *MockDeploymentService) RefreshCapabilities(ctx context.Context) models.Capabilities {
+	args := m.Called(ctx)
+	return args.Get(0).(models.Capabilities)
+}
+
 func TestCreateDeployment(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -252,6 +437,10 @@ func TestDeleteDeployment(t *testing.T) {
 			deploymentID: "test-id",
 			queryParams:  "?kind=container",
 			setupMock: func(m *MockDeploymentService) {
+				m.On("GetDeploymentByID", mock.Anything, "test-id").Return(&models.DeploymentResponse{
+					ID:       "test-id",
+					Metadata: models.Metadata{Name: "test", Namespace: "default"},
+				}, nil)
 				m.On("DeleteDeployment", mock.Anything, "test-id").Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
@@ -261,8 +450,12 @@ func TestDeleteDeployment(t *testing.T) {
 			deploymentID: "test-id",
 			queryParams:  "",
 			setupMock: func(m *MockDeploymentService) {
-				// With new implementation, only DeleteDeployment is called
-				// The service handles the lookup internally
+				// The handler classifies the deployment for approval gating,
+				// then the service handles the delete lookup internally
+				m.On("GetDeploymentByID", mock.Anything, "test-id").Return(&models.DeploymentResponse{
+					ID:       "test-id",
+					Metadata: models.Metadata{Name: "test", Namespace: "default"},
+				}, nil)
 				m.On("DeleteDeployment", mock.Anything, "test-id").Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
@@ -407,6 +600,181 @@ func TestListDeployments(t *testing.T) {
 	}
 }
 
+func TestBuildSyncPlan(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*MockDeploymentService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "successful plan",
+			requestBody: models.SyncPlanRequest{
+				Records: []models.SyncRecord{
+					{
+						ID: "0e7c6c7a-1111-2222-3333-444455556666",
+						Deployment: models.DeploymentRequest{
+							Kind: models.DeploymentKindContainer,
+							Metadata: models.Metadata{
+								Name:      "test-app",
+								Namespace: "default",
+							},
+							Spec: models.ContainerSpec{
+								Container: models.ContainerConfig{
+									Image: "nginx:latest",
+								},
+							},
+						},
+					},
+				},
+			},
+			setupMock: func(m *MockDeploymentService) {
+				m.On("BuildSyncPlan", mock.Anything, mock.AnythingOfType("*models.SyncPlanRequest")).Return(&models.SyncPlanResponse{
+					Entries: []models.SyncPlanEntry{
+						{
+							ID:     "0e7c6c7a-1111-2222-3333-444455556666",
+							Kind:   models.DeploymentKindContainer,
+							Name:   "test-app",
+							Action: models.SyncActionCreate,
+						},
+					},
+					Summary: map[string]int{models.SyncActionCreate: 1},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   models.SyncActionCreate,
+		},
+		{
+			name:           "empty records rejected",
+			requestBody:    models.SyncPlanRequest{},
+			setupMock:      func(m *MockDeploymentService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_REQUEST",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			mockService := new(MockDeploymentService)
+			tt.setupMock(mockService)
+
+			logger := zap.NewNop()
+			handler := &Handler{
+				deployService: mockService,
+				logger:        logger,
+			}
+
+			// Create request
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest("POST", "/api/v1/admin/sync/plan", bytes.NewBuffer(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create gin context
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			// Call handler
+			handler.BuildSyncPlan(c)
+
+			// Assertions
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPatchDeploymentMetadata(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*MockDeploymentService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "successful metadata update",
+			requestBody: map[string]interface{}{
+				"notes": "pending decommission",
+				"tags":  []string{"legacy", "team-a"},
+			},
+			setupMock: func(m *MockDeploymentService) {
+				m.On("UpdateDeploymentMetadata", mock.Anything, "test-id", mock.AnythingOfType("*models.UpdateDeploymentMetadataRequest")).Return(&models.DeploymentResponse{
+					ID:   "test-id",
+					Kind: models.DeploymentKindContainer,
+					Metadata: models.Metadata{
+						Name:      "test-app",
+						Namespace: "default",
+						Notes:     "pending decommission",
+						Tags:      []string{"legacy", "team-a"},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "pending decommission",
+		},
+		{
+			name:        "deployment not found",
+			requestBody: map[string]interface{}{"notes": "x"},
+			setupMock: func(m *MockDeploymentService) {
+				m.On("UpdateDeploymentMetadata", mock.Anything, "test-id", mock.AnythingOfType("*models.UpdateDeploymentMetadataRequest")).Return(nil, models.NewErrDeploymentNotFound("test-id"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "DEPLOYMENT_NOT_FOUND",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			mockService := new(MockDeploymentService)
+			tt.setupMock(mockService)
+
+			logger := zap.NewNop()
+			handler := &Handler{
+				deployService: mockService,
+				logger:        logger,
+			}
+
+			// Create request
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest("PATCH", "/api/v1/deployments/test-id", bytes.NewBuffer(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create gin context
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{{Key: "id", Value: "test-id"}}
+
+			// Call handler
+			handler.PatchDeploymentMetadata(c)
+
+			// Assertions
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
 func TestHealthCheck(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
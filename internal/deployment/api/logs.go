@@ -0,0 +1,162 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// GetDeploymentLogs handles GET /deployments/{id}/logs
+// It proxies pod logs for container deployments as a plain text stream.
+// With follow=true the stream stays open until the pod or client disconnects.
+func (h *Handler) GetDeploymentLogs(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "get_deployment_logs"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+
+	var req models.DeploymentLogsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error("Failed to bind query parameters", zap.Error(err))
+		problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid query parameters", err.Error())
+		return
+	}
+
+	// Validate the since duration up front so bad input maps to 400, not 500
+	if req.Since != "" {
+		if _, err := time.ParseDuration(req.Since); err != nil {
+			problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid since duration, expected a Go duration such as 5m or 1h", err.Error())
+			return
+		}
+	}
+
+	stream, err := h.deployService.GetDeploymentLogs(c.Request.Context(), deploymentID, &req)
+	if err != nil {
+		logger.Error("Failed to get deployment logs", zap.Error(err))
+
+		if models.IsMultipleFoundError(err) {
+			problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
+			return
+		}
+
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		if models.IsLogsNotSupportedError(err) {
+			problem(c, http.StatusBadRequest, models.CodeLogsNotSupported, "Logs are only available for container deployments", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeLogsFailed, "Failed to get deployment logs", err.Error())
+		return
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			logger.Debug("Failed to close log stream", zap.Error(err))
+		}
+	}()
+
+	logger.Info("Streaming deployment logs",
+		zap.String("deployment_id", deploymentID),
+		zap.Bool("follow", req.Follow))
+
+	streamPlainText(c, stream, logger)
+}
+
+// GetDeploymentConsoleLog handles GET /deployments/{id}/console-log
+// It streams the serial console output of a VM deployment so boot failures
+// can be diagnosed through the API.
+func (h *Handler) GetDeploymentConsoleLog(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "get_deployment_console_log"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+
+	var req models.DeploymentLogsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Error("Failed to bind query parameters", zap.Error(err))
+		problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid query parameters", err.Error())
+		return
+	}
+
+	// Validate the since duration up front so bad input maps to 400, not 500
+	if req.Since != "" {
+		if _, err := time.ParseDuration(req.Since); err != nil {
+			problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid since duration, expected a Go duration such as 5m or 1h", err.Error())
+			return
+		}
+	}
+
+	stream, err := h.deployService.GetDeploymentConsoleLog(c.Request.Context(), deploymentID, &req)
+	if err != nil {
+		logger.Error("Failed to get VM console log", zap.Error(err))
+
+		if models.IsMultipleFoundError(err) {
+			problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
+			return
+		}
+
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		if models.IsConsoleLogNotSupportedError(err) {
+			problem(c, http.StatusBadRequest, models.CodeConsoleLogNotSupported, "Console logs are only available for VM deployments", err.Error())
+			return
+		}
+
+		if models.IsKubeVirtUnavailableError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeKubeVirtUnavailable, "VM deployments are not supported on this cluster", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeConsoleLogFailed, "Failed to get VM console log", err.Error())
+		return
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			logger.Debug("Failed to close console log stream", zap.Error(err))
+		}
+	}()
+
+	logger.Info("Streaming VM console log",
+		zap.String("deployment_id", deploymentID),
+		zap.Bool("follow", req.Follow))
+
+	streamPlainText(c, stream, logger)
+}
+
+// streamPlainText copies a log stream to the response in chunks, flushing
+// after each one so followed logs arrive promptly
+func streamPlainText(c *gin.Context, stream io.Reader, logger *zap.Logger) {
+	c.Header("Content-Type", "text/plain; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	buf := make([]byte, 4096)
+	for {
+		n, err := stream.Read(buf)
+		if n > 0 {
+			if _, writeErr := c.Writer.Write(buf[:n]); writeErr != nil {
+				logger.Debug("Failed to write log chunk, closing", zap.Error(writeErr))
+				return
+			}
+			c.Writer.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
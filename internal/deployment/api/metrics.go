@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// GetDeploymentMetrics handles GET /deployments/{id}/metrics
+// It returns the current CPU and memory usage of the pods backing the
+// deployment, normalized per pod, so dashboards can chart usage without
+// direct cluster metrics access
+func (h *Handler) GetDeploymentMetrics(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "get_deployment_metrics"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+
+	response, err := h.deployService.GetDeploymentMetrics(c.Request.Context(), deploymentID)
+	if err != nil {
+		logger.Error("Failed to get deployment metrics", zap.Error(err))
+
+		if models.IsMultipleFoundError(err) {
+			problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
+			return
+		}
+
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		if models.IsMetricsUnavailableError(err) {
+			problem(c, http.StatusNotImplemented, models.CodeMetricsUnavailable, "The metrics API is not available on this cluster", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeMetricsFailed, "Failed to get deployment metrics", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
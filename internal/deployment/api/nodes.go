@@ -0,0 +1,79 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// StartNodeMaintenance handles POST /admin/nodes/:name/maintenance.
+// The node is cordoned and its managed workloads are evacuated: VMs through
+// live migration, container and job pods through evictions their controllers
+// reschedule. Evacuation is asynchronous; poll the GET endpoint for progress.
+func (h *Handler) StartNodeMaintenance(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "start_node_maintenance"))
+
+	node := c.Param("name")
+	response, err := h.deployService.StartNodeMaintenance(c.Request.Context(), node)
+	if err != nil {
+		logger.Error("Failed to start node maintenance", zap.String("node", node), zap.Error(err))
+
+		if models.IsNodeNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeNodeNotFound, "Node not found", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeMaintenanceFailed, "Failed to start node maintenance", err.Error())
+		return
+	}
+
+	logger.Info("Started node maintenance",
+		zap.String("node", node),
+		zap.Int("workloads", len(response.Evacuations)))
+	c.JSON(http.StatusAccepted, response)
+}
+
+// GetNodeMaintenance handles GET /admin/nodes/:name/maintenance
+func (h *Handler) GetNodeMaintenance(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "get_node_maintenance"))
+
+	node := c.Param("name")
+	response, err := h.deployService.NodeMaintenanceStatus(c.Request.Context(), node)
+	if err != nil {
+		logger.Error("Failed to get node maintenance status", zap.String("node", node), zap.Error(err))
+
+		if models.IsNodeNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeNodeNotFound, "Node not found", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeMaintenanceStatusFailed, "Failed to get node maintenance status", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// EndNodeMaintenance handles DELETE /admin/nodes/:name/maintenance
+func (h *Handler) EndNodeMaintenance(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "end_node_maintenance"))
+
+	node := c.Param("name")
+	response, err := h.deployService.EndNodeMaintenance(c.Request.Context(), node)
+	if err != nil {
+		logger.Error("Failed to end node maintenance", zap.String("node", node), zap.Error(err))
+
+		if models.IsNodeNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeNodeNotFound, "Node not found", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeMaintenanceFailed, "Failed to end node maintenance", err.Error())
+		return
+	}
+
+	logger.Info("Ended node maintenance", zap.String("node", node))
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestStartNodeMaintenance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		node           string
+		setupMock      func(*MockDeploymentService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "successful evacuation",
+			node: "worker-1",
+			setupMock: func(m *MockDeploymentService) {
+				m.On("StartNodeMaintenance", mock.Anything, "worker-1").Return(&models.NodeMaintenanceResponse{
+					Node:          "worker-1",
+					Unschedulable: true,
+					Evacuations: []models.NodeEvacuation{
+						{
+							DeploymentID: "test-id",
+							Kind:         models.DeploymentKindVM,
+							Name:         "test-vm",
+							Namespace:    "default",
+							Status:       models.EvacuationStatusMigrating,
+						},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+			expectedBody:   models.EvacuationStatusMigrating,
+		},
+		{
+			name: "node not found",
+			node: "missing",
+			setupMock: func(m *MockDeploymentService) {
+				m.On("StartNodeMaintenance", mock.Anything, "missing").Return(nil, models.NewErrNodeNotFound("missing"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "NODE_NOT_FOUND",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockDeploymentService)
+			tt.setupMock(mockService)
+
+			handler := &Handler{
+				deployService: mockService,
+				logger:        zap.NewNop(),
+			}
+
+			req, _ := http.NewRequest("POST", "/api/v1/admin/nodes/"+tt.node+"/maintenance", nil)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{{Key: "name", Value: tt.node}}
+
+			handler.StartNodeMaintenance(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEndNodeMaintenance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := new(MockDeploymentService)
+	mockService.On("EndNodeMaintenance", mock.Anything, "worker-1").Return(&models.NodeMaintenanceResponse{
+		Node:          "worker-1",
+		Unschedulable: false,
+	}, nil)
+
+	handler := &Handler{
+		deployService: mockService,
+		logger:        zap.NewNop(),
+	}
+
+	req, _ := http.NewRequest("DELETE", "/api/v1/admin/nodes/worker-1/maintenance", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{{Key: "name", Value: "worker-1"}}
+
+	handler.EndNodeMaintenance(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "worker-1")
+	mockService.AssertExpectations(t)
+}
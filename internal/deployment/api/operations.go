@@ -0,0 +1,100 @@
+package api
+
+import (
+	"encoding/csv"
+	"net/http"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/audit"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ListOperationsResponse represents the response for listing operations
+type ListOperationsResponse struct {
+	Operations []audit.Operation `json:"operations"`
+	Count      int               `json:"count"`
+}
+
+// ListOperations handles GET /operations
+// Supported query parameters: actor, kind, outcome, from, to (RFC3339) and format=csv
+func (h *Handler) ListOperations(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "list_operations"))
+
+	if h.auditStore == nil {
+		problem(c, http.StatusServiceUnavailable, models.CodeAuditUnavailable, "Operation auditing is not enabled", "")
+		return
+	}
+
+	filter := &audit.Filter{
+		Actor:   c.Query("actor"),
+		Kind:    c.Query("kind"),
+		Outcome: audit.Outcome(c.Query("outcome")),
+	}
+
+	if from := c.Query("from"); from != "" {
+		parsed, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid 'from' timestamp, expected RFC3339", err.Error())
+			return
+		}
+		filter.From = parsed
+	}
+
+	if to := c.Query("to"); to != "" {
+		parsed, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			problem(c, http.StatusBadRequest, models.CodeInvalidQuery, "Invalid 'to' timestamp, expected RFC3339", err.Error())
+			return
+		}
+		filter.To = parsed
+	}
+
+	operations := h.auditStore.List(filter)
+	logger.Info("Successfully listed operations", zap.Int("count", len(operations)))
+
+	if c.Query("format") == "csv" {
+		h.writeOperationsCSV(c, operations)
+		return
+	}
+
+	c.JSON(http.StatusOK, ListOperationsResponse{
+		Operations: operations,
+		Count:      len(operations),
+	})
+}
+
+// writeOperationsCSV writes the operations as a CSV attachment
+func (h *Handler) writeOperationsCSV(c *gin.Context, operations []audit.Operation) {
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", "attachment; filename=operations.csv")
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	defer writer.Flush()
+
+	header := []string{"id", "timestamp", "actor", "action", "kind", "deployment_id", "namespace", "outcome", "error"}
+	if err := writer.Write(header); err != nil {
+		h.logger.Error("Failed to write CSV header", zap.Error(err))
+		return
+	}
+
+	for _, op := range operations {
+		record := []string{
+			op.ID,
+			op.Timestamp.Format(time.RFC3339),
+			op.Actor,
+			op.Action,
+			op.Kind,
+			op.DeploymentID,
+			op.Namespace,
+			string(op.Outcome),
+			op.Error,
+		}
+		if err := writer.Write(record); err != nil {
+			h.logger.Error("Failed to write CSV record", zap.Error(err))
+			return
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// ListOrphans handles GET /maintenance/orphans. It reports managed secondary
+// objects whose deployment no longer has a primary object, without deleting
+// anything.
+func (h *Handler) ListOrphans(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "list_orphans"))
+
+	response, err := h.deployService.SweepOrphans(c.Request.Context(), false)
+	if err != nil {
+		logger.Error("Failed to sweep for orphaned resources", zap.Error(err))
+		problem(c, http.StatusInternalServerError, models.CodeOrphanSweepFailed, "Failed to sweep for orphaned resources", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SweepOrphans handles DELETE /maintenance/orphans, removing the orphaned
+// resources it finds and reporting what was deleted
+func (h *Handler) SweepOrphans(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "sweep_orphans"))
+
+	response, err := h.deployService.SweepOrphans(c.Request.Context(), true)
+	if err != nil {
+		logger.Error("Failed to sweep orphaned resources", zap.Error(err))
+		problem(c, http.StatusInternalServerError, models.CodeOrphanSweepFailed, "Failed to sweep orphaned resources", err.Error())
+		return
+	}
+
+	logger.Info("Swept orphaned resources", zap.Int("orphans", len(response.Orphans)))
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// problem writes an RFC 7807 problem document with the problem+json media
+// type. Title is the stable human summary for the code; detail describes this
+// occurrence and may be empty. All handler error responses go through here so
+// the two APIs stay consistent.
+func problem(c *gin.Context, status int, code, title, detail string) {
+	writeProblem(c, makeProblem(c, status, code, title, detail))
+}
+
+// problemWithViolations is problem with field-level violations extracted from
+// the binding or spec validation error, so clients can map the failure back
+// to request fields
+func problemWithViolations(c *gin.Context, status int, code, title string, err error) {
+	document := makeProblem(c, status, code, title, err.Error())
+	document.Errors = fieldViolations(err)
+	writeProblem(c, document)
+}
+
+func makeProblem(c *gin.Context, status int, code, title, detail string) models.ErrorResponse {
+	document := models.NewProblem(status, code, title, detail)
+	document.Instance = c.Request.URL.Path
+	return document
+}
+
+func writeProblem(c *gin.Context, document models.ErrorResponse) {
+	// Gin's JSON renderer only sets the content type when none is present
+	c.Header("Content-Type", models.ContentTypeProblem)
+	c.JSON(document.Status, document)
+}
+
+// fieldViolations maps validation errors to the request fields that caused
+// them. Binding errors carry the struct namespace of each failed field;
+// service-side spec errors carry the JSON path directly.
+func fieldViolations(err error) []models.FieldViolation {
+	var specViolations *models.ErrSpecViolations
+	if errors.As(err, &specViolations) {
+		return specViolations.Violations
+	}
+
+	var invalidSpec *models.ErrInvalidSpec
+	if errors.As(err, &invalidSpec) {
+		return []models.FieldViolation{{Field: invalidSpec.Field, Reason: invalidSpec.Reason}}
+	}
+
+	var bindingErrs validator.ValidationErrors
+	if errors.As(err, &bindingErrs) {
+		violations := make([]models.FieldViolation, 0, len(bindingErrs))
+		for _, fieldErr := range bindingErrs {
+			violations = append(violations, models.FieldViolation{
+				Field:  fieldErr.Namespace(),
+				Reason: fmt.Sprintf("failed %q validation", fieldErr.Tag()),
+			})
+		}
+		return violations
+	}
+
+	return nil
+}
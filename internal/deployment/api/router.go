@@ -1,26 +1,40 @@
 package api
 
 import (
+	"github.com/dcm-project/k8s-service-provider/internal/approval"
+	"github.com/dcm-project/k8s-service-provider/internal/audit"
+	"github.com/dcm-project/k8s-service-provider/internal/config"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/services"
+	"github.com/dcm-project/k8s-service-provider/internal/events"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+	"github.com/dcm-project/k8s-service-provider/internal/sshkeys"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
 
 // SetupRouter sets up the HTTP router with all routes
-func SetupRouter(deployService services.DeploymentServiceInterface, logger *zap.Logger) *gin.Engine {
-	// Set Gin mode based on environment
-	gin.SetMode(gin.ReleaseMode)
+func SetupRouter(serverCfg config.ServerConfig, deployService services.DeploymentServiceInterface, auditStore audit.StoreInterface, approvalStore approval.StoreInterface, eventBus events.BusInterface, sshKeys *sshkeys.Registry, logger *zap.Logger) *gin.Engine {
+	// Gin mode, trusted proxies and forwarded-header handling come from
+	// server configuration so ClientIP() resolves correctly behind the ingress
+	gin.SetMode(serverCfg.GinMode)
 
 	router := gin.New()
+	router.ForwardedByClientIP = serverCfg.ForwardedByClientIP
+	if err := router.SetTrustedProxies(serverCfg.TrustedProxies); err != nil {
+		// Config validation parses the proxy list, so this should not happen
+		logger.Warn("Invalid trusted proxy configuration", zap.Error(err))
+	}
 
 	// Add middleware
 	router.Use(gin.Logger())
 	router.Use(gin.Recovery())
 	router.Use(CORSMiddleware())
+	router.Use(IdentityMiddleware())
+	router.Use(defaultDeprecations().Middleware())
 	router.Use(LoggingMiddleware(logger))
 
 	// Create handler
-	handler := NewHandler(deployService, logger)
+	handler := NewHandler(deployService, auditStore, approvalStore, eventBus, sshKeys, logger)
 
 	// API v1 routes
 	v1 := router.Group("/api/v1")
@@ -28,6 +42,11 @@ func SetupRouter(deployService services.DeploymentServiceInterface, logger *zap.
 		// Health check
 		v1.GET("/health", handler.HealthCheck)
 
+		// Collection verbs (':batch', ':adopt') registered outside the group:
+		// Gin reads the colon as a wildcard and allows only one per node, so
+		// the verbs share a single route and dispatch on the captured suffix
+		v1.POST("/deployments:verb", handler.DeploymentCollectionVerb)
+
 		// Deployment routes
 		deployments := v1.Group("/deployments")
 		{
@@ -35,8 +54,68 @@ func SetupRouter(deployService services.DeploymentServiceInterface, logger *zap.
 			deployments.GET("", handler.ListDeployments)
 			deployments.GET("/:id", handler.GetDeployment)
 			deployments.PUT("/:id", handler.UpdateDeployment)
+			deployments.PATCH("/:id", handler.PatchDeploymentMetadata)
 			deployments.DELETE("/:id", handler.DeleteDeployment)
+			deployments.GET("/:id/watch", handler.WatchDeployment)
+			deployments.GET("/:id/logs", handler.GetDeploymentLogs)
+			deployments.GET("/:id/console-log", handler.GetDeploymentConsoleLog)
+			deployments.GET("/:id/console", handler.ConsoleProxy)
+			deployments.GET("/:id/events", handler.GetDeploymentEvents)
+			deployments.GET("/:id/metrics", handler.GetDeploymentMetrics)
+			deployments.GET("/:id/drift", handler.GetDeploymentDrift)
+			deployments.POST("/:id/actions", handler.PerformDeploymentAction)
+			deployments.POST("/:id/disks", handler.AttachDeploymentDisk)
+			deployments.DELETE("/:id/disks/:name", handler.DetachDeploymentDisk)
+		}
+
+		// Namespace activity feed (audit entries + cluster warning events)
+		v1.GET("/namespaces/:name/events", handler.GetNamespaceEvents)
+
+		// Operator defaults profiles applied at create time
+		v1.GET("/defaults/profiles", handler.GetDefaultsProfiles)
+
+		// VM flavor catalog referenced by name from VM specs
+		v1.GET("/catalog/flavors", handler.GetVMFlavors)
+
+		// Admin operations (differential sync after restore)
+		admin := v1.Group("/admin")
+		{
+			admin.POST("/sync/plan", handler.BuildSyncPlan)
+			admin.POST("/sync/apply", handler.ApplySyncPlan)
+
+			// Time-boxed approvals for destructive operations
+			admin.POST("/approvals", handler.CreateApproval)
+			admin.GET("/approvals", handler.ListApprovals)
+			admin.POST("/approvals/:id/approve", handler.ApproveRequest)
+			admin.POST("/approvals/:id/deny", handler.DenyRequest)
+
+			// Node maintenance and workload evacuation
+			admin.POST("/nodes/:name/maintenance", handler.StartNodeMaintenance)
+			admin.GET("/nodes/:name/maintenance", handler.GetNodeMaintenance)
+			admin.DELETE("/nodes/:name/maintenance", handler.EndNodeMaintenance)
 		}
+
+		// Reusable SSH public keys referenced by name in VM specs
+		keys := v1.Group("/sshkeys")
+		{
+			keys.POST("", handler.RegisterSSHKey)
+			keys.GET("", handler.ListSSHKeys)
+			keys.GET("/:name", handler.GetSSHKey)
+			keys.DELETE("/:name", handler.DeleteSSHKey)
+		}
+
+		// Orphaned resource inspection and cleanup
+		v1.GET("/maintenance/orphans", handler.ListOrphans)
+		v1.DELETE("/maintenance/orphans", handler.SweepOrphans)
+
+		// Operation audit trail
+		v1.GET("/operations", handler.ListOperations)
+
+		// Capability re-detection (e.g. KubeVirt installed after startup)
+		v1.POST("/capabilities/refresh", handler.RefreshCapabilities)
+
+		// Lifecycle event stream (SSE)
+		v1.GET("/events", handler.StreamEvents)
 	}
 
 	return router
@@ -48,7 +127,7 @@ func CORSMiddleware() gin.HandlerFunc {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Credentials", "true")
 		c.Header("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Header("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
+		c.Header("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, PATCH, DELETE")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -59,6 +138,21 @@ func CORSMiddleware() gin.HandlerFunc {
 	})
 }
 
+// IdentityMiddleware resolves the caller identity from forwarded headers and
+// stores it on the request context for creator stamping and owner filters.
+// An identity already on the context — attached by the authentication
+// middleware from a verified token — takes precedence over the headers.
+func IdentityMiddleware() gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		if _, ok := identity.FromContext(c.Request.Context()); !ok {
+			if creator := identity.FromRequest(c.Request); creator != nil {
+				c.Request = c.Request.WithContext(identity.WithCreator(c.Request.Context(), creator))
+			}
+		}
+		c.Next()
+	})
+}
+
 // LoggingMiddleware adds structured logging to requests
 func LoggingMiddleware(logger *zap.Logger) gin.HandlerFunc {
 	return gin.HandlerFunc(func(c *gin.Context) {
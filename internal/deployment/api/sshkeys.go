@@ -0,0 +1,120 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/sshkeys"
+)
+
+// RegisterSSHKey handles POST /sshkeys
+// It stores a public key once so VM specs can reference it by name in any
+// namespace
+func (h *Handler) RegisterSSHKey(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "register_ssh_key"))
+
+	if h.sshKeys == nil {
+		problem(c, http.StatusNotImplemented, models.CodeSSHKeysUnavailable, "SSH key registry is not configured", "")
+		return
+	}
+
+	var req sshkeys.RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error("Failed to bind request", zap.Error(err))
+		problemWithViolations(c, http.StatusBadRequest, models.CodeInvalidRequest, "Invalid request format", err)
+		return
+	}
+
+	key, err := h.sshKeys.Register(c.Request.Context(), req.Name, req.PublicKey)
+	if err != nil {
+		logger.Error("Failed to register SSH key", zap.String("name", req.Name), zap.Error(err))
+
+		if errors.Is(err, sshkeys.ErrAlreadyExists) {
+			problem(c, http.StatusConflict, models.CodeSSHKeyExists, "An SSH key is already registered under this name", err.Error())
+			return
+		}
+
+		problem(c, http.StatusBadRequest, models.CodeInvalidRequest, "Failed to register SSH key", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, key)
+}
+
+// ListSSHKeys handles GET /sshkeys
+func (h *Handler) ListSSHKeys(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "list_ssh_keys"))
+
+	if h.sshKeys == nil {
+		problem(c, http.StatusNotImplemented, models.CodeSSHKeysUnavailable, "SSH key registry is not configured", "")
+		return
+	}
+
+	keys, err := h.sshKeys.List(c.Request.Context())
+	if err != nil {
+		logger.Error("Failed to list SSH keys", zap.Error(err))
+		problem(c, http.StatusInternalServerError, models.CodeInternalError, "Failed to list SSH keys", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, sshkeys.ListResponse{Keys: keys})
+}
+
+// GetSSHKey handles GET /sshkeys/{name}
+func (h *Handler) GetSSHKey(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "get_ssh_key"))
+
+	if h.sshKeys == nil {
+		problem(c, http.StatusNotImplemented, models.CodeSSHKeysUnavailable, "SSH key registry is not configured", "")
+		return
+	}
+
+	key, err := h.sshKeys.Get(c.Request.Context(), c.Param("name"))
+	if err != nil {
+		logger.Error("Failed to get SSH key", zap.String("name", c.Param("name")), zap.Error(err))
+
+		if errors.Is(err, sshkeys.ErrNotFound) {
+			problem(c, http.StatusNotFound, models.CodeSSHKeyNotFound, "SSH key not found", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeInternalError, "Failed to get SSH key", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, key)
+}
+
+// DeleteSSHKey handles DELETE /sshkeys/{name}
+// Keys still referenced by VM deployments are refused with a conflict
+func (h *Handler) DeleteSSHKey(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "delete_ssh_key"))
+
+	if h.sshKeys == nil {
+		problem(c, http.StatusNotImplemented, models.CodeSSHKeysUnavailable, "SSH key registry is not configured", "")
+		return
+	}
+
+	if err := h.sshKeys.Delete(c.Request.Context(), c.Param("name")); err != nil {
+		logger.Error("Failed to delete SSH key", zap.String("name", c.Param("name")), zap.Error(err))
+
+		if errors.Is(err, sshkeys.ErrInUse) {
+			problem(c, http.StatusConflict, models.CodeSSHKeyInUse, "SSH key is still referenced by deployments", err.Error())
+			return
+		}
+
+		if errors.Is(err, sshkeys.ErrNotFound) {
+			problem(c, http.StatusNotFound, models.CodeSSHKeyNotFound, "SSH key not found", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeInternalError, "Failed to delete SSH key", err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// imageRefPattern is a pragmatic check on container image references:
+// an optional registry and repository path, an optional tag and an optional
+// digest. It rejects whitespace and shell metacharacters without trying to
+// reimplement the full distribution grammar.
+var imageRefPattern = regexp.MustCompile(`^[a-z0-9]+((\.|__|_|-+|/|:)[a-zA-Z0-9]+)*(@sha256:[a-f0-9]{64})?$`)
+
+// vmOperatingSystems are the OS names the VM manifests can map to a
+// containerDisk image
+var vmOperatingSystems = []string{"fedora", "ubuntu", "centos", "rhel"}
+
+// validateDeploymentRequest checks the request metadata and the typed spec
+// against field-level constraints, returning one violation per offending
+// field so clients can fix everything in a single round trip. It expects the
+// spec to already be parsed to its typed form.
+func validateDeploymentRequest(req *models.DeploymentRequest) []models.FieldViolation {
+	var violations []models.FieldViolation
+
+	// Deployment names end up in object names and label values, which
+	// Kubernetes requires to be DNS-1123 labels
+	for _, reason := range k8svalidation.IsDNS1123Label(req.Metadata.Name) {
+		violations = append(violations, models.FieldViolation{
+			Field:  "metadata.name",
+			Reason: reason,
+		})
+	}
+
+	switch spec := req.Spec.(type) {
+	case models.ContainerSpec:
+		violations = append(violations, validateContainerConfig(spec.Container)...)
+	case models.VMSpec:
+		violations = append(violations, validateVMConfig(spec.VM)...)
+	}
+
+	return violations
+}
+
+// validateContainerConfig checks the container spec fields the binding layer
+// cannot: image reference shape, port ranges and resource quantities
+func validateContainerConfig(container models.ContainerConfig) []models.FieldViolation {
+	var violations []models.FieldViolation
+
+	switch {
+	case container.Image == "":
+		violations = append(violations, models.FieldViolation{
+			Field:  "spec.container.image",
+			Reason: "image is required",
+		})
+	case !imageRefPattern.MatchString(container.Image):
+		violations = append(violations, models.FieldViolation{
+			Field:  "spec.container.image",
+			Reason: fmt.Sprintf("%q is not a valid image reference", container.Image),
+		})
+	}
+
+	if container.Replicas != nil && *container.Replicas < 0 {
+		violations = append(violations, models.FieldViolation{
+			Field:  "spec.container.replicas",
+			Reason: "replicas cannot be negative",
+		})
+	}
+
+	for i, port := range container.Ports {
+		if port.ContainerPort < 1 || port.ContainerPort > 65535 {
+			violations = append(violations, models.FieldViolation{
+				Field:  fmt.Sprintf("spec.container.ports[%d].containerPort", i),
+				Reason: "port must be between 1 and 65535",
+			})
+		}
+		if port.ServicePort != 0 && (port.ServicePort < 1 || port.ServicePort > 65535) {
+			violations = append(violations, models.FieldViolation{
+				Field:  fmt.Sprintf("spec.container.ports[%d].servicePort", i),
+				Reason: "port must be between 1 and 65535",
+			})
+		}
+		switch strings.ToUpper(port.Protocol) {
+		case "", "TCP", "UDP", "SCTP":
+		default:
+			violations = append(violations, models.FieldViolation{
+				Field:  fmt.Sprintf("spec.container.ports[%d].protocol", i),
+				Reason: fmt.Sprintf("unsupported protocol %q, expected TCP, UDP or SCTP", port.Protocol),
+			})
+		}
+	}
+
+	if container.Resources != nil {
+		violations = append(violations, validateQuantity("spec.container.resources.cpu", container.Resources.CPU)...)
+		violations = append(violations, validateQuantity("spec.container.resources.memory", container.Resources.Memory)...)
+		violations = append(violations, validateQuantity("spec.container.resources.cpuLimit", container.Resources.CPULimit)...)
+		violations = append(violations, validateQuantity("spec.container.resources.memoryLimit", container.Resources.MemoryLimit)...)
+	}
+
+	if container.Ingress != nil && container.Ingress.Host != "" {
+		for _, reason := range k8svalidation.IsDNS1123Subdomain(container.Ingress.Host) {
+			violations = append(violations, models.FieldViolation{
+				Field:  "spec.container.ingress.host",
+				Reason: reason,
+			})
+		}
+	}
+
+	return violations
+}
+
+// validateVMConfig checks the VM spec fields against the request-level
+// bounds; operator-configured shape limits are enforced in the service layer
+func validateVMConfig(vm models.VMConfig) []models.FieldViolation {
+	var violations []models.FieldViolation
+
+	if vm.Ram < 1 || vm.Ram > 32 {
+		violations = append(violations, models.FieldViolation{
+			Field:  "spec.vm.ram",
+			Reason: "ram must be between 1 and 32 GB",
+		})
+	}
+
+	if vm.Cpu < 1 || vm.Cpu > 32 {
+		violations = append(violations, models.FieldViolation{
+			Field:  "spec.vm.cpu",
+			Reason: "cpu must be between 1 and 32 cores",
+		})
+	}
+
+	supported := false
+	for _, os := range vmOperatingSystems {
+		if vm.Os == os {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		violations = append(violations, models.FieldViolation{
+			Field:  "spec.vm.os",
+			Reason: fmt.Sprintf("unsupported operating system %q, expected one of %s", vm.Os, strings.Join(vmOperatingSystems, ", ")),
+		})
+	}
+
+	return violations
+}
+
+// validateQuantity rejects resource strings Kubernetes cannot parse; empty
+// values are allowed and filled from the operator defaults
+func validateQuantity(field, value string) []models.FieldViolation {
+	if value == "" {
+		return nil
+	}
+	if _, err := resource.ParseQuantity(value); err != nil {
+		return []models.FieldViolation{{
+			Field:  field,
+			Reason: fmt.Sprintf("%q is not a valid quantity", value),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// violationFields extracts the offending field paths for compact assertions
+func violationFields(violations []models.FieldViolation) []string {
+	var fields []string
+	for _, violation := range violations {
+		fields = append(fields, violation.Field)
+	}
+	return fields
+}
+
+func TestValidateDeploymentRequest_Container(t *testing.T) {
+	replicas := -1
+	tests := []struct {
+		name    string
+		request models.DeploymentRequest
+		expect  []string
+	}{
+		{
+			name: "valid container spec",
+			request: models.DeploymentRequest{
+				Kind:     models.DeploymentKindContainer,
+				Metadata: models.Metadata{Name: "web"},
+				Spec: models.ContainerSpec{Container: models.ContainerConfig{
+					Image:     "quay.io/app/web:1.2.3",
+					Ports:     []models.PortConfig{{ContainerPort: 8080, ServicePort: 80, Protocol: "TCP"}},
+					Resources: &models.ResourceConfig{CPU: "100m", Memory: "128Mi"},
+				}},
+			},
+		},
+		{
+			name: "invalid name and image",
+			request: models.DeploymentRequest{
+				Kind:     models.DeploymentKindContainer,
+				Metadata: models.Metadata{Name: "Not_A_DNS_Name"},
+				Spec: models.ContainerSpec{Container: models.ContainerConfig{
+					Image: "nginx latest",
+				}},
+			},
+			expect: []string{"metadata.name", "spec.container.image"},
+		},
+		{
+			name: "every port problem reported at once",
+			request: models.DeploymentRequest{
+				Kind:     models.DeploymentKindContainer,
+				Metadata: models.Metadata{Name: "web"},
+				Spec: models.ContainerSpec{Container: models.ContainerConfig{
+					Image:    "nginx:latest",
+					Replicas: &replicas,
+					Ports: []models.PortConfig{
+						{ContainerPort: 0},
+						{ContainerPort: 8080, ServicePort: 70000, Protocol: "ICMP"},
+					},
+				}},
+			},
+			expect: []string{
+				"spec.container.replicas",
+				"spec.container.ports[0].containerPort",
+				"spec.container.ports[1].servicePort",
+				"spec.container.ports[1].protocol",
+			},
+		},
+		{
+			name: "unparseable resource quantities",
+			request: models.DeploymentRequest{
+				Kind:     models.DeploymentKindContainer,
+				Metadata: models.Metadata{Name: "web"},
+				Spec: models.ContainerSpec{Container: models.ContainerConfig{
+					Image:     "nginx:latest",
+					Resources: &models.ResourceConfig{CPU: "lots", MemoryLimit: "128Zi2"},
+				}},
+			},
+			expect: []string{"spec.container.resources.cpu", "spec.container.resources.memoryLimit"},
+		},
+		{
+			name: "invalid ingress host",
+			request: models.DeploymentRequest{
+				Kind:     models.DeploymentKindContainer,
+				Metadata: models.Metadata{Name: "web"},
+				Spec: models.ContainerSpec{Container: models.ContainerConfig{
+					Image:   "nginx:latest",
+					Ingress: &models.IngressConfig{Host: "bad_host!"},
+				}},
+			},
+			expect: []string{"spec.container.ingress.host"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := validateDeploymentRequest(&tt.request)
+			assert.Equal(t, tt.expect, violationFields(violations))
+		})
+	}
+}
+
+func TestValidateDeploymentRequest_VM(t *testing.T) {
+	tests := []struct {
+		name   string
+		vm     models.VMConfig
+		expect []string
+	}{
+		{
+			name: "valid vm spec",
+			vm:   models.VMConfig{Ram: 4, Cpu: 2, Os: "fedora"},
+		},
+		{
+			name:   "out of bounds shape and unknown os",
+			vm:     models.VMConfig{Ram: 64, Cpu: 0, Os: "plan9"},
+			expect: []string{"spec.vm.ram", "spec.vm.cpu", "spec.vm.os"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := models.DeploymentRequest{
+				Kind:     models.DeploymentKindVM,
+				Metadata: models.Metadata{Name: "vm-1"},
+				Spec:     models.VMSpec{VM: tt.vm},
+			}
+			assert.Equal(t, tt.expect, violationFields(validateDeploymentRequest(&req)))
+		})
+	}
+}
+
+func TestSpecUnmarshalErrorPinsField(t *testing.T) {
+	req := models.DeploymentRequest{
+		Kind:     models.DeploymentKindContainer,
+		Metadata: models.Metadata{Name: "web"},
+		Spec:     map[string]interface{}{"container": map[string]interface{}{"image": 42}},
+	}
+
+	err := (&Handler{}).parseAndValidateSpec(&req)
+	assert.True(t, models.IsSpecViolationsError(err))
+	assert.Equal(t, []string{"spec.container.image"}, violationFields(fieldViolations(err)))
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// Bounds on the ?wait=true synchronous create
+const (
+	defaultWaitTimeout = 5 * time.Minute
+	maxWaitTimeout     = 30 * time.Minute
+)
+
+// parseWaitOptions reads the ?wait and ?timeout query parameters of a
+// synchronous create. The timeout accepts Go duration notation (e.g. "300s")
+// and is capped at maxWaitTimeout so a client cannot pin a handler forever.
+func parseWaitOptions(c *gin.Context) (bool, time.Duration, error) {
+	if c.Query("wait") != "true" {
+		return false, 0, nil
+	}
+
+	timeout := defaultWaitTimeout
+	if raw := c.Query("timeout"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return false, 0, fmt.Errorf("invalid timeout %q: %w", raw, err)
+		}
+		if parsed <= 0 {
+			return false, 0, fmt.Errorf("timeout must be positive, got %q", raw)
+		}
+		if parsed > maxWaitTimeout {
+			parsed = maxWaitTimeout
+		}
+		timeout = parsed
+	}
+	return true, timeout, nil
+}
+
+// waitSettled reports whether a phase ends the synchronous create wait: the
+// workload is serving, finished, or demonstrably broken
+func waitSettled(phase models.DeploymentPhase) bool {
+	switch phase {
+	case models.DeploymentPhaseRunning, models.DeploymentPhaseSucceeded,
+		models.DeploymentPhaseFailed, models.DeploymentPhaseCrashLoop:
+		return true
+	}
+	return false
+}
+
+// waitForDeployment blocks a synchronous create until the workload settles,
+// driven by the same watch that backs the WebSocket endpoint rather than
+// polling. A settled phase returns 201 with the final status; a timeout
+// returns 202 with the last observed status, since the deployment exists
+// either way.
+func (h *Handler) waitForDeployment(c *gin.Context, id string, timeout time.Duration) {
+	logger := h.logger.Named("api_handler").With(
+		zap.String("endpoint", "create_deployment"),
+		zap.String("deployment_id", id))
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	defer cancel()
+
+	updates, err := h.deployService.WatchDeployment(ctx, id)
+	if err != nil {
+		logger.Error("Failed to watch created deployment", zap.Error(err))
+		problem(c, http.StatusInternalServerError, models.CodeWatchFailed, "Deployment was created but waiting on it failed", err.Error())
+		return
+	}
+
+	var latest *models.DeploymentResponse
+	for update := range updates {
+		update := update
+		latest = &update
+		if waitSettled(update.Status.Phase) {
+			logger.Info("Deployment settled", zap.String("phase", string(update.Status.Phase)))
+			c.JSON(http.StatusCreated, update)
+			return
+		}
+	}
+
+	// The watch closed without a settled phase: the timeout expired or the
+	// client went away. Report the last state seen instead of an error.
+	if latest == nil {
+		if current, err := h.deployService.GetDeploymentByID(c.Request.Context(), id); err == nil {
+			latest = current
+		}
+	}
+	if latest == nil {
+		problem(c, http.StatusInternalServerError, models.CodeWatchFailed, "Deployment was created but waiting on it failed", "watch ended before any status was observed")
+		return
+	}
+
+	logger.Info("Wait timed out before the deployment settled",
+		zap.String("phase", string(latest.Status.Phase)))
+	c.JSON(http.StatusAccepted, latest)
+}
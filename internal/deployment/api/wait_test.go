@@ -0,0 +1,113 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestParseWaitOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	request := func(query string) *gin.Context {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("POST", "/api/v1/deployments"+query, nil)
+		return c
+	}
+
+	// No wait requested
+	wait, _, err := parseWaitOptions(request(""))
+	require.NoError(t, err)
+	assert.False(t, wait)
+
+	// Default timeout applies when none is given
+	wait, timeout, err := parseWaitOptions(request("?wait=true"))
+	require.NoError(t, err)
+	assert.True(t, wait)
+	assert.Equal(t, defaultWaitTimeout, timeout)
+
+	// Go duration notation
+	wait, timeout, err = parseWaitOptions(request("?wait=true&timeout=300s"))
+	require.NoError(t, err)
+	assert.True(t, wait)
+	assert.Equal(t, 5*time.Minute, timeout)
+
+	// Excessive timeouts are capped, not rejected
+	_, timeout, err = parseWaitOptions(request("?wait=true&timeout=24h"))
+	require.NoError(t, err)
+	assert.Equal(t, maxWaitTimeout, timeout)
+
+	// Garbage and non-positive timeouts are rejected
+	_, _, err = parseWaitOptions(request("?wait=true&timeout=forever"))
+	assert.Error(t, err)
+	_, _, err = parseWaitOptions(request("?wait=true&timeout=-5s"))
+	assert.Error(t, err)
+}
+
+func TestWaitSettled(t *testing.T) {
+	assert.True(t, waitSettled(models.DeploymentPhaseRunning))
+	assert.True(t, waitSettled(models.DeploymentPhaseSucceeded))
+	assert.True(t, waitSettled(models.DeploymentPhaseFailed))
+	assert.True(t, waitSettled(models.DeploymentPhaseCrashLoop))
+
+	assert.False(t, waitSettled(models.DeploymentPhasePending))
+	assert.False(t, waitSettled(models.DeploymentPhaseProvisioning))
+	assert.False(t, waitSettled(models.DeploymentPhaseStarting))
+}
+
+func TestCreateDeploymentWait(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	requestBody := models.DeploymentRequest{
+		Kind: models.DeploymentKindContainer,
+		Metadata: models.Metadata{
+			Name:      "test-app",
+			Namespace: "default",
+		},
+		Spec: models.ContainerSpec{
+			Container: models.ContainerConfig{
+				Image: "nginx:latest",
+			},
+		},
+	}
+
+	// The watch emits a pending state first and then the settled one
+	updates := make(chan models.DeploymentResponse, 2)
+	updates <- models.DeploymentResponse{Status: models.DeploymentStatus{Phase: models.DeploymentPhasePending}}
+	updates <- models.DeploymentResponse{Status: models.DeploymentStatus{Phase: models.DeploymentPhaseRunning}}
+	close(updates)
+
+	mockService := new(MockDeploymentService)
+	mockService.On("CreateDeployment", mock.Anything, mock.AnythingOfType("*models.DeploymentRequest"), mock.AnythingOfType("string")).Return(nil)
+	mockService.On("WatchDeployment", mock.Anything, mock.AnythingOfType("string")).Return((<-chan models.DeploymentResponse)(updates), nil)
+
+	handler := &Handler{
+		deployService: mockService,
+		logger:        zap.NewNop(),
+	}
+
+	bodyBytes, _ := json.Marshal(requestBody)
+	req, _ := http.NewRequest("POST", "/api/v1/deployments?wait=true&timeout=10s", bytes.NewBuffer(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler.CreateDeployment(c)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"phase":"running"`)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// watchUpgrader upgrades HTTP connections to WebSocket for deployment watches
+var watchUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// The deployment service already allows all origins via CORS
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// WatchDeployment handles GET /deployments/{id}/watch
+// It upgrades the connection to WebSocket and streams phase transitions
+func (h *Handler) WatchDeployment(c *gin.Context) {
+	logger := h.logger.Named("api_handler").With(zap.String("endpoint", "watch_deployment"))
+
+	deploymentID := c.Param("id")
+	if deploymentID == "" {
+		problem(c, http.StatusBadRequest, models.CodeMissingID, "Deployment ID is required", "")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	updates, err := h.deployService.WatchDeployment(ctx, deploymentID)
+	if err != nil {
+		logger.Error("Failed to watch deployment", zap.Error(err))
+
+		if models.IsMultipleFoundError(err) {
+			problem(c, http.StatusConflict, models.CodeDeploymentIDConflict, "Multiple deployments found with the same ID across different namespaces", err.Error())
+			return
+		}
+
+		if models.IsNotFoundError(err) {
+			problem(c, http.StatusNotFound, models.CodeDeploymentNotFound, "Deployment not found", err.Error())
+			return
+		}
+
+		problem(c, http.StatusInternalServerError, models.CodeWatchFailed, "Failed to watch deployment", err.Error())
+		return
+	}
+
+	conn, err := watchUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		logger.Error("Failed to upgrade connection to WebSocket", zap.Error(err))
+		return
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Debug("Failed to close WebSocket connection", zap.Error(err))
+		}
+	}()
+
+	// Cancel the watch when the client closes the connection
+	go func() {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	logger.Info("Streaming deployment status", zap.String("deployment_id", deploymentID))
+
+	// Only forward updates when the phase actually changes
+	var lastPhase models.DeploymentPhase
+	first := true
+	for update := range updates {
+		if !first && update.Status.Phase == lastPhase {
+			continue
+		}
+		first = false
+		lastPhase = update.Status.Phase
+
+		if err := conn.WriteJSON(update); err != nil {
+			logger.Debug("Failed to write WebSocket message, closing", zap.Error(err))
+			return
+		}
+	}
+}
@@ -1,7 +1,10 @@
 package models
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -9,16 +12,75 @@ import (
 type DeploymentKind string
 
 const (
-	DeploymentKindContainer DeploymentKind = "container"
-	DeploymentKindVM        DeploymentKind = "vm"
+	DeploymentKindContainer   DeploymentKind = "container"
+	DeploymentKindVM          DeploymentKind = "vm"
+	DeploymentKindJob         DeploymentKind = "job"
+	DeploymentKindCronJob     DeploymentKind = "cronjob"
+	DeploymentKindStatefulSet DeploymentKind = "statefulset"
+	DeploymentKindManifest    DeploymentKind = "manifest"
 )
 
+// ErrUnsupportedKind is wrapped by ParseSpec when the kind has no spec type
+var ErrUnsupportedKind = errors.New("unsupported deployment kind")
+
+// ParseSpec decodes a JSON spec document into the typed spec value for a
+// deployment kind, so programmatic writers such as the reconciler share the
+// same kind-to-type mapping as the HTTP binding layer
+func ParseSpec(kind DeploymentKind, data []byte) (interface{}, error) {
+	switch kind {
+	case DeploymentKindContainer:
+		var spec ContainerSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return nil, err
+		}
+		return spec, nil
+	case DeploymentKindVM:
+		var spec VMSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return nil, err
+		}
+		return spec, nil
+	case DeploymentKindJob:
+		var spec JobSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return nil, err
+		}
+		return spec, nil
+	case DeploymentKindCronJob:
+		var spec CronJobSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return nil, err
+		}
+		return spec, nil
+	case DeploymentKindStatefulSet:
+		var spec StatefulSetSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return nil, err
+		}
+		return spec, nil
+	case DeploymentKindManifest:
+		var spec ManifestSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return nil, err
+		}
+		return spec, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKind, kind)
+	}
+}
+
 // Label keys for Kubernetes resources
 const (
 	LabelManagedBy        = "managed-by"
 	LabelAppID            = "app-id"
 	LabelApp              = "app"
 	LabelSSHSecretCreated = "ssh-secret-created" // #nosec G101
+	// LabelCredentialsSecretCreated marks VMs whose generated guest password
+	// was stored in a Secret this service created (for cleanup tracking)
+	LabelCredentialsSecretCreated = "credentials-secret-created" // #nosec G101
+	// LabelManifestRecord marks the ConfigMap tracking the objects applied by
+	// a raw manifest deployment, distinguishing it from other managed ConfigMaps
+	LabelManifestRecord = "manifest-record"
 )
 
 // Label values
@@ -26,9 +88,31 @@ const (
 	LabelValueManagedBy = "k8s-service-provider"
 )
 
+// ReservedAnnotationPrefix marks the annotation keys the provider manages
+// itself. Caller-supplied metadata annotations must not use it.
+const ReservedAnnotationPrefix = "k8s-service-provider/"
+
+// Annotation keys for Kubernetes resources
+const (
+	AnnotationCreatedAt      = "k8s-service-provider/created-at"
+	AnnotationUpdatedAt      = "k8s-service-provider/updated-at"
+	AnnotationCreatedBy      = "k8s-service-provider/created-by"
+	AnnotationCreatedByEmail = "k8s-service-provider/created-by-email"
+	AnnotationNotes          = "k8s-service-provider/notes"
+	AnnotationTags           = "k8s-service-provider/tags" // comma-separated tag list
+	// AnnotationSpec persists the deployment spec as submitted, so responses
+	// can return it and schema migrations can upgrade it in place.
+	// AnnotationSpecVersion records the spec schema version it was written at.
+	AnnotationSpec        = "k8s-service-provider/spec"
+	AnnotationSpecVersion = "k8s-service-provider/spec-version"
+	// AnnotationPreUpdateSnapshot names the VirtualMachineSnapshot taken of
+	// the previous revision's persistent disks before a destructive update
+	AnnotationPreUpdateSnapshot = "k8s-service-provider/pre-update-snapshot"
+)
+
 // DeploymentRequest represents the request payload for creating/updating deployments
 type DeploymentRequest struct {
-	Kind     DeploymentKind `json:"kind" binding:"required,oneof=container vm"`
+	Kind     DeploymentKind `json:"kind" binding:"required,oneof=container vm job cronjob statefulset manifest"`
 	Metadata Metadata       `json:"metadata" binding:"required"`
 	Spec     interface{}    `json:"spec" binding:"required"`
 }
@@ -40,15 +124,35 @@ type DeploymentResponse struct {
 	Metadata  Metadata          `json:"metadata"`
 	Spec      interface{}       `json:"spec"`
 	Status    DeploymentStatus  `json:"status"`
+	CreatedBy *Creator          `json:"createdBy,omitempty"`
 	CreatedAt time.Time         `json:"createdAt"`
 	UpdatedAt time.Time         `json:"updatedAt"`
 }
 
-// Metadata represents common metadata for deployments
+// Creator identifies who issued the create request for a deployment
+type Creator struct {
+	Subject string `json:"subject"`
+	Email   string `json:"email,omitempty"`
+}
+
+// Metadata represents common metadata for deployments. Notes and Tags are
+// free-form ops annotations distinct from Kubernetes labels: they are stored
+// as resource annotations, never used as selectors, and tags are filterable
+// when listing deployments.
 type Metadata struct {
 	Name      string            `json:"name" binding:"required,max=63,min=1"`
 	Namespace string            `json:"namespace,omitempty"`
+	// Cluster names a registered target cluster to place the deployment on.
+	// Empty targets the provider's own cluster. Responses carry the cluster
+	// a deployment was found on so callers can route follow-up operations.
+	Cluster   string            `json:"cluster,omitempty"`
 	Labels    map[string]string `json:"labels,omitempty"`
+	// Annotations are copied onto generated objects and their pod templates,
+	// where integrations like Prometheus scraping or sidecar injectors read
+	// them. Keys under the provider's reserved prefix are rejected.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Notes       string            `json:"notes,omitempty" binding:"max=2048"`
+	Tags        []string          `json:"tags,omitempty" binding:"omitempty,dive,min=1,max=63,excludes=0x2C"`
 }
 
 // ContainerSpec represents the specification for container deployments
@@ -58,11 +162,149 @@ type ContainerSpec struct {
 
 // ContainerConfig represents container configuration
 type ContainerConfig struct {
-	Image       string                `json:"image" binding:"required"`
-	Replicas    *int                  `json:"replicas,omitempty"`
-	Ports       []PortConfig          `json:"ports,omitempty"`
-	Resources   *ResourceConfig       `json:"resources,omitempty"`
-	Environment []EnvironmentVariable `json:"environment,omitempty"`
+	Image               string                     `json:"image" binding:"required"`
+	Replicas            *int                       `json:"replicas,omitempty"`
+	Ports               []PortConfig               `json:"ports,omitempty"`
+	Resources           *ResourceConfig            `json:"resources,omitempty"`
+	Environment         []EnvironmentVariable      `json:"environment,omitempty"`
+	EnvFrom             []EnvFromSource            `json:"envFrom,omitempty"`
+	Ingress             *IngressConfig             `json:"ingress,omitempty"`
+	Service             *ServiceConfig             `json:"service,omitempty"`
+	ServiceAccountToken *ServiceAccountTokenConfig `json:"serviceAccountToken,omitempty"`
+	Networks            []NetworkAttachmentConfig  `json:"networks,omitempty" binding:"omitempty,dive"`
+
+	// Optional entrypoint overrides, rendered verbatim in exec form. No
+	// shell is involved: entries are never word-split, expanded or
+	// interpolated. WorkingDir must be an absolute path.
+	Command    []string `json:"command,omitempty"`
+	Args       []string `json:"args,omitempty"`
+	WorkingDir string   `json:"workingDir,omitempty"`
+
+	// Private registry access: names of existing pull Secrets, or inline
+	// docker-registry credentials the provider materializes as a Secret for
+	// this deployment
+	ImagePullSecrets    []string             `json:"imagePullSecrets,omitempty" binding:"omitempty,dive,max=253"`
+	RegistryCredentials *RegistryCredentials `json:"registryCredentials,omitempty"`
+
+	// Extra containers rendered into the pod template: init containers run
+	// to completion before the main container starts (e.g. schema
+	// migrations), sidecars run alongside it (e.g. log shippers). Mounts
+	// attach the pod's shared scratch volumes to the main container.
+	InitContainers []AuxiliaryContainer   `json:"initContainers,omitempty" binding:"omitempty,dive"`
+	Sidecars       []AuxiliaryContainer   `json:"sidecars,omitempty" binding:"omitempty,dive"`
+	Mounts         []ContainerMountConfig `json:"mounts,omitempty" binding:"omitempty,dive"`
+
+	// Optional node placement: selector, co-scheduling rules and tolerations
+	Placement *PlacementConfig `json:"placement,omitempty"`
+
+	// Optional security hardening applied to every container in the pod
+	Security *SecurityConfig `json:"security,omitempty"`
+}
+
+// AuxiliaryContainer describes an extra container rendered into the pod
+// template next to the main one. Resources follow the same request/limit
+// semantics as the main container's.
+type AuxiliaryContainer struct {
+	Name        string                 `json:"name" binding:"required,max=63"`
+	Image       string                 `json:"image" binding:"required"`
+	Command     []string               `json:"command,omitempty"`
+	Args        []string               `json:"args,omitempty"`
+	Environment []EnvironmentVariable  `json:"environment,omitempty"`
+	Resources   *ResourceConfig        `json:"resources,omitempty"`
+	Mounts      []ContainerMountConfig `json:"mounts,omitempty" binding:"omitempty,dive"`
+}
+
+// ContainerMountConfig mounts one of the pod's shared scratch volumes into a
+// container. Each distinct volume name becomes an emptyDir, so an init
+// container can prepare data the main container or a sidecar reads.
+type ContainerMountConfig struct {
+	Name      string `json:"name" binding:"required,max=63"`
+	MountPath string `json:"mountPath" binding:"required"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+// SecurityConfig hardens the containers rendered for a deployment. The
+// fields map onto the Kubernetes securityContext of the same names and apply
+// to the main container, init containers and sidecars alike. When the
+// operator enforces a pod security profile, unset fields are filled from the
+// profile and values violating it are rejected.
+type SecurityConfig struct {
+	RunAsNonRoot           *bool    `json:"runAsNonRoot,omitempty"`
+	ReadOnlyRootFilesystem *bool    `json:"readOnlyRootFilesystem,omitempty"`
+	DropCapabilities       []string `json:"dropCapabilities,omitempty"`
+}
+
+// PlacementConfig steers which nodes a deployment's pods or VM instances can
+// land on. NodeSelector and Tolerations map straight onto the pod fields of
+// the same names. Affinity and AntiAffinity take pod label maps and render as
+// required pod (anti-)affinity rules on the kubernetes.io/hostname topology,
+// so deployments can be co-scheduled with or spread away from other workloads
+// by label without writing raw Kubernetes affinity terms.
+type PlacementConfig struct {
+	NodeSelector map[string]string  `json:"nodeSelector,omitempty"`
+	Tolerations  []TolerationConfig `json:"tolerations,omitempty" binding:"omitempty,dive"`
+	Affinity     map[string]string  `json:"affinity,omitempty"`
+	AntiAffinity map[string]string  `json:"antiAffinity,omitempty"`
+}
+
+// TolerationConfig mirrors a Kubernetes toleration. Operator defaults to
+// Equal; an Exists toleration must leave Value empty.
+type TolerationConfig struct {
+	Key      string `json:"key" binding:"required"`
+	Operator string `json:"operator,omitempty" binding:"omitempty,oneof=Exists Equal"`
+	Value    string `json:"value,omitempty"`
+	Effect   string `json:"effect,omitempty" binding:"omitempty,oneof=NoSchedule PreferNoSchedule NoExecute"`
+}
+
+// RegistryCredentials carries inline docker-registry credentials. The
+// provider stores them as a kubernetes.io/dockerconfigjson Secret owned by
+// the deployment and references it from the pod's imagePullSecrets.
+type RegistryCredentials struct {
+	Server   string `json:"server" binding:"required"`
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+	Email    string `json:"email,omitempty" binding:"omitempty,email"`
+}
+
+// NetworkAttachmentConfig attaches a secondary interface backed by a Multus
+// NetworkAttachmentDefinition. Name may be qualified as "namespace/name" and
+// defaults to the deployment's namespace. Binding selects the KubeVirt
+// interface binding and applies to VM interfaces only; it defaults to bridge.
+// MacAddress pins the interface MAC. IPAddress requests a static address from
+// the CNI IPAM and applies to container pods only; VM interface addresses
+// come from the definition's own IPAM.
+type NetworkAttachmentConfig struct {
+	Name       string `json:"name" binding:"required"`
+	Binding    string `json:"binding,omitempty" binding:"omitempty,oneof=bridge masquerade sriov"`
+	MacAddress string `json:"macAddress,omitempty"`
+	IPAddress  string `json:"ipAddress,omitempty"`
+}
+
+// ServiceConfig customizes the Service created for a container deployment.
+// Type defaults to NodePort; annotations are merged onto the Service for
+// integrations like cloud load balancer configuration.
+type ServiceConfig struct {
+	Type        string            `json:"type,omitempty" binding:"omitempty,oneof=ClusterIP NodePort LoadBalancer"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// IngressConfig exposes a container deployment outside the cluster via an
+// Ingress routed to the deployment's Service. The rule targets the first
+// declared port; Path defaults to "/" with prefix matching.
+type IngressConfig struct {
+	Host      string `json:"host" binding:"required"`
+	Path      string `json:"path,omitempty"`
+	TLSSecret string `json:"tlsSecret,omitempty"`
+	ClassName string `json:"className,omitempty"`
+}
+
+// ServiceAccountTokenConfig opts a container deployment into API access via a
+// bound projected service account token. The legacy automounted token stays
+// disabled; the pod receives a token scoped to the audience with the given
+// expiry instead.
+type ServiceAccountTokenConfig struct {
+	Audience          string `json:"audience,omitempty"`
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty" binding:"omitempty,min=600"`
 }
 
 // PortConfig represents port configuration
@@ -72,16 +314,200 @@ type PortConfig struct {
 	Protocol      string `json:"protocol,omitempty"`
 }
 
-// ResourceConfig represents resource configuration
+// ResourceConfig represents resource configuration. CPU and Memory set the
+// container requests; CPULimit and MemoryLimit set the limits. Unset limits
+// are filled from the operator's workload policy when one is configured.
 type ResourceConfig struct {
-	CPU    string `json:"cpu,omitempty"`
-	Memory string `json:"memory,omitempty"`
+	CPU         string `json:"cpu,omitempty"`
+	Memory      string `json:"memory,omitempty"`
+	CPULimit    string `json:"cpuLimit,omitempty"`
+	MemoryLimit string `json:"memoryLimit,omitempty"`
+
+	// Extended maps extended resource names (e.g. "nvidia.com/gpu",
+	// "hugepages-2Mi") to quantities. Extended resources are applied to both
+	// requests and limits, as Kubernetes requires them to be equal, and are
+	// validated against the resources advertised by cluster nodes.
+	Extended map[string]string `json:"extended,omitempty"`
 }
 
-// EnvironmentVariable represents an environment variable
+// EnvironmentVariable represents an environment variable with either a literal
+// value or a reference to a key in an existing Secret or ConfigMap
 type EnvironmentVariable struct {
-	Name  string `json:"name" binding:"required"`
-	Value string `json:"value" binding:"required"`
+	Name      string        `json:"name" binding:"required"`
+	Value     string        `json:"value,omitempty"`
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty"`
+}
+
+// EnvVarSource references a single key in an existing cluster object.
+// Exactly one of the fields must be set.
+type EnvVarSource struct {
+	SecretKeyRef    *KeySelector `json:"secretKeyRef,omitempty"`
+	ConfigMapKeyRef *KeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// KeySelector selects a key of a named Secret or ConfigMap
+type KeySelector struct {
+	Name     string `json:"name" binding:"required"`
+	Key      string `json:"key" binding:"required"`
+	Optional *bool  `json:"optional,omitempty"`
+}
+
+// EnvFromSource injects all entries of an existing Secret or ConfigMap as
+// environment variables. Exactly one of secretRef/configMapRef must be set.
+type EnvFromSource struct {
+	Prefix       string          `json:"prefix,omitempty"`
+	SecretRef    *SourceSelector `json:"secretRef,omitempty"`
+	ConfigMapRef *SourceSelector `json:"configMapRef,omitempty"`
+}
+
+// SourceSelector selects a named Secret or ConfigMap
+type SourceSelector struct {
+	Name     string `json:"name" binding:"required"`
+	Optional *bool  `json:"optional,omitempty"`
+}
+
+// JobSpec represents the specification for one-off job deployments
+type JobSpec struct {
+	Job JobConfig `json:"job" binding:"required"`
+}
+
+// JobConfig represents batch job configuration. Completions, parallelism and
+// activeDeadlineSeconds map directly onto the Kubernetes Job spec so batch
+// workloads such as migrations can bound their runtime and fan-out.
+type JobConfig struct {
+	Image                 string                `json:"image" binding:"required"`
+	Command               []string              `json:"command,omitempty"`
+	Args                  []string              `json:"args,omitempty"`
+	Completions           *int32                `json:"completions,omitempty" binding:"omitempty,min=1"`
+	Parallelism           *int32                `json:"parallelism,omitempty" binding:"omitempty,min=1"`
+	ActiveDeadlineSeconds *int64                `json:"activeDeadlineSeconds,omitempty" binding:"omitempty,min=1"`
+	BackoffLimit          *int32                `json:"backoffLimit,omitempty" binding:"omitempty,min=0"`
+	Resources             *ResourceConfig       `json:"resources,omitempty"`
+	Environment           []EnvironmentVariable `json:"environment,omitempty"`
+}
+
+// Evacuation states reported while moving managed workloads off a node
+// under maintenance
+const (
+	EvacuationStatusMigrating  = "migrating"  // VM live migration triggered
+	EvacuationStatusEvicted    = "evicted"    // pods evicted for rescheduling
+	EvacuationStatusEvacuating = "evacuating" // pods still running on the node
+	EvacuationStatusFailed     = "failed"
+)
+
+// NodeEvacuation reports the evacuation progress of one managed deployment
+// with pods on a node under maintenance
+type NodeEvacuation struct {
+	DeploymentID string         `json:"deploymentId"`
+	Kind         DeploymentKind `json:"kind"`
+	Name         string         `json:"name"`
+	Namespace    string         `json:"namespace"`
+	Status       string         `json:"status"`
+	Message      string         `json:"message,omitempty"`
+}
+
+// NodeMaintenanceResponse reports a node's maintenance state and the managed
+// workloads moved, or still being moved, off it
+type NodeMaintenanceResponse struct {
+	Node          string           `json:"node"`
+	Unschedulable bool             `json:"unschedulable"`
+	Evacuations   []NodeEvacuation `json:"evacuations"`
+}
+
+// OrphanedResource reports one managed secondary object whose deployment no
+// longer has a primary object. Removed reports whether the sweep deleted it.
+type OrphanedResource struct {
+	Resource     string `json:"resource"` // service, ingress or secret
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	DeploymentID string `json:"deploymentId,omitempty"`
+	Removed      bool   `json:"removed"`
+}
+
+// OrphanSweepResponse reports the orphaned resources found by one sweep
+type OrphanSweepResponse struct {
+	Orphans []OrphanedResource `json:"orphans"`
+}
+
+// DriftedField reports one field whose live value no longer matches the
+// spec recorded at the last write
+type DriftedField struct {
+	Field    string `json:"field"` // spec path, e.g. spec.container.replicas
+	Recorded string `json:"recorded"`
+	Live     string `json:"live"`
+}
+
+// DriftResponse reports whether a deployment's live Kubernetes objects still
+// match its recorded spec. Message explains an empty comparison: no recorded
+// spec, or a kind without drift detection.
+type DriftResponse struct {
+	ID      string         `json:"id"`
+	Kind    DeploymentKind `json:"kind"`
+	Drifted bool           `json:"drifted"`
+	Fields  []DriftedField `json:"fields"`
+	Message string         `json:"message,omitempty"`
+}
+
+// AdoptDeploymentRequest asks the provider to take over an existing cluster
+// workload as a managed deployment. Exactly one of Object or Selector locates
+// the primary object; when a selector is given, matching Services and
+// Ingresses are labeled along with it.
+type AdoptDeploymentRequest struct {
+	Kind      DeploymentKind `json:"kind" binding:"required"`
+	Namespace string         `json:"namespace" binding:"required"`
+	Object    string         `json:"object,omitempty"`   // name of the primary object
+	Selector  string         `json:"selector,omitempty"` // label selector locating the primary object
+}
+
+// CronJobSpec represents the specification for scheduled job deployments
+type CronJobSpec struct {
+	CronJob CronJobConfig `json:"cronjob" binding:"required"`
+}
+
+// CronJobConfig represents scheduled job configuration. The job fields are
+// shared with one-off jobs; schedule uses standard cron syntax and the
+// concurrency policy and history limits map directly onto the Kubernetes
+// CronJob spec.
+type CronJobConfig struct {
+	JobConfig
+	Schedule                   string `json:"schedule" binding:"required"`
+	ConcurrencyPolicy          string `json:"concurrencyPolicy,omitempty" binding:"omitempty,oneof=Allow Forbid Replace"`
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty" binding:"omitempty,min=0"`
+	FailedJobsHistoryLimit     *int32 `json:"failedJobsHistoryLimit,omitempty" binding:"omitempty,min=0"`
+	StartingDeadlineSeconds    *int64 `json:"startingDeadlineSeconds,omitempty" binding:"omitempty,min=1"`
+	Suspend                    *bool  `json:"suspend,omitempty"`
+}
+
+// StatefulSetSpec represents the specification for stateful workloads
+type StatefulSetSpec struct {
+	StatefulSet StatefulSetConfig `json:"statefulset" binding:"required"`
+}
+
+// StatefulSetConfig represents stateful workload configuration. The container
+// fields are shared with container deployments; volume claims add per-replica
+// persistent storage, and replicas roll out in order through a StatefulSet.
+type StatefulSetConfig struct {
+	ContainerConfig
+	VolumeClaims []VolumeClaimConfig `json:"volumeClaims,omitempty" binding:"omitempty,dive"`
+}
+
+// VolumeClaimConfig describes a PersistentVolumeClaim template giving each
+// StatefulSet replica its own volume mounted at MountPath. Size uses
+// Kubernetes quantity notation (e.g. "10Gi").
+type VolumeClaimConfig struct {
+	Name         string `json:"name" binding:"required,max=63"`
+	Size         string `json:"size" binding:"required"`
+	MountPath    string `json:"mountPath" binding:"required"`
+	StorageClass string `json:"storageClass,omitempty"`
+	AccessMode   string `json:"accessMode,omitempty" binding:"omitempty,oneof=ReadWriteOnce ReadWriteMany ReadOnlyMany"`
+}
+
+// ManifestSpec represents the specification for raw manifest deployments.
+// Each entry is an arbitrary Kubernetes object applied as-is except for the
+// managed-by labels injected into its metadata, so the resources stay
+// visible to the normal lifecycle endpoints.
+type ManifestSpec struct {
+	Manifests []map[string]interface{} `json:"manifests" binding:"required,min=1"`
 }
 
 // VMSpec represents the specification for virtual machine deployments
@@ -91,19 +517,157 @@ type VMSpec struct {
 
 // VMConfig represents virtual machine configuration aligned with CatalogVm
 type VMConfig struct {
-	Ram          int     `json:"ram" binding:"required,min=1,max=32"`
-	Cpu          int     `json:"cpu" binding:"required,min=1,max=32"`
+	Ram          int     `json:"ram" binding:"omitempty,min=1,max=32"`
+	Cpu          int     `json:"cpu" binding:"omitempty,min=1,max=32"`
 	Os           string  `json:"os" binding:"required"`
+	Flavor       string  `json:"flavor,omitempty" binding:"omitempty,max=63"` // Optional: catalog flavor instead of raw ram/cpu
+
+	// Optional guest architecture and machine type. Architecture defaults to
+	// the operator-configured default; the machine type defaults per
+	// architecture (q35 on amd64, virt on arm64, s390-ccw-virtio on s390x).
+	Architecture string `json:"architecture,omitempty" binding:"omitempty,oneof=amd64 arm64 s390x"`
+	MachineType  string `json:"machineType,omitempty" binding:"omitempty,max=63"`
 	SshPublicKey *string `json:"sshPublicKey,omitempty"` // Optional: SSH public key content
 	SshKeyName   *string `json:"sshKeyName,omitempty"`   // Optional: Secret name
+
+	// SshServiceType optionally exposes the guest's SSH port through a
+	// Service of that type, reported on the deployment status. Requires one
+	// of the SSH key fields.
+	SshServiceType string `json:"sshServiceType,omitempty" binding:"omitempty,oneof=NodePort LoadBalancer"`
+
+	// Optional guest login credentials applied through the generated
+	// cloud-init. Username defaults to the OS name; without a password the
+	// service generates one and stores it in a Secret labeled for this
+	// deployment. These fields cannot be combined with a custom cloud-init
+	// source, which carries its own credentials.
+	Username            string  `json:"username,omitempty" binding:"omitempty,max=32"`
+	Password            *string `json:"password,omitempty"`
+	DisablePasswordAuth bool    `json:"disablePasswordAuth,omitempty"`
+
+	// Optional cloud-init sources used instead of the generated default user
+	// data: an inline document carried in the request, or a reference to an
+	// existing GitOps-managed object. At most one may be set.
+	CloudInitUserData  *string `json:"cloudInitUserData,omitempty"`  // Inline cloud-init document (#cloud-config or script)
+	CloudInitSecret    *string `json:"cloudInitSecret,omitempty"`    // Secret referenced from the VM (userdata key)
+	CloudInitConfigMap *string `json:"cloudInitConfigMap,omitempty"` // ConfigMap whose userdata key is inlined at create time
+
+	// ImagePullSecret names an existing docker-registry Secret in the target
+	// namespace, used to pull the OS containerdisk from a private registry
+	ImagePullSecret string `json:"imagePullSecret,omitempty" binding:"omitempty,max=253"`
+
+	// SysprepConfigMap names a ConfigMap holding Windows answer files
+	// (autounattend.xml/unattend.xml), attached as a Sysprep disk. Windows
+	// guests only; they take no cloud-init.
+	SysprepConfigMap *string `json:"sysprepConfigMap,omitempty"`
+
+	// Optional persistent disks backed by CDI DataVolumes. RootDisk replaces
+	// the ephemeral containerDisk with a DataVolume imported from the OS
+	// image, so data survives restarts; DataDisks attach additional blank
+	// persistent disks.
+	RootDisk  *VMDiskConfig  `json:"rootDisk,omitempty"`
+	DataDisks []VMDiskConfig `json:"dataDisks,omitempty" binding:"omitempty,dive"`
+
+	// Optional secondary interfaces backed by Multus
+	// NetworkAttachmentDefinitions, attached alongside the default pod network
+	Networks []NetworkAttachmentConfig `json:"networks,omitempty" binding:"omitempty,dive"`
+
+	// Optional performance tuning for latency-sensitive guests
+	Performance *VMPerformanceConfig `json:"performance,omitempty"`
+
+	// Optional node placement: selector, co-scheduling rules and tolerations
+	Placement *PlacementConfig `json:"placement,omitempty"`
+}
+
+// VMPerformanceConfig tunes a VM for latency-sensitive workloads.
+// DedicatedCpuPlacement pins guest vCPUs to host cores, HugepagesSize backs
+// guest memory with host hugepages of that size, and NumaPassthrough maps the
+// host NUMA topology of the pinned resources into the guest. NUMA passthrough
+// only makes sense on pinned, hugepage-backed memory, so it requires both
+// other knobs.
+type VMPerformanceConfig struct {
+	DedicatedCpuPlacement bool   `json:"dedicatedCpuPlacement,omitempty"`
+	HugepagesSize         string `json:"hugepagesSize,omitempty" binding:"omitempty,oneof=2Mi 1Gi"`
+	NumaPassthrough       bool   `json:"numaPassthrough,omitempty"`
+}
+
+// VMDiskConfig describes a persistent disk provisioned through a CDI
+// DataVolume. Size uses Kubernetes quantity notation (e.g. "10Gi").
+// SourceImage applies to the root disk only and defaults to the containerdisk
+// image for the requested OS; data disks always start blank. Bus selects the
+// disk bus exposed to the guest and defaults to virtio; hotplugged disks must
+// use scsi, the only bus KubeVirt supports for volume hotplug.
+type VMDiskConfig struct {
+	Name         string `json:"name,omitempty" binding:"omitempty,max=63"`
+	Size         string `json:"size" binding:"required"`
+	StorageClass string `json:"storageClass,omitempty"`
+	SourceImage  string `json:"sourceImage,omitempty"`
+	Bus          string `json:"bus,omitempty" binding:"omitempty,oneof=virtio sata scsi"`
 }
 
 // DeploymentStatus represents the status of a deployment
 type DeploymentStatus struct {
-	Phase         DeploymentPhase `json:"phase"`
-	Message       string          `json:"message,omitempty"`
-	ReadyReplicas int             `json:"readyReplicas,omitempty"`
-	Conditions    []Condition     `json:"conditions,omitempty"`
+	Phase         DeploymentPhase  `json:"phase"`
+	Message       string           `json:"message,omitempty"`
+	ReadyReplicas int              `json:"readyReplicas,omitempty"`
+	Conditions    []Condition      `json:"conditions,omitempty"`
+	Result        *JobResult       `json:"result,omitempty"`    // Only set for finished job deployments
+	Migration     *MigrationStatus `json:"migration,omitempty"` // Only set for VM deployments with a live migration
+	Service       *ServiceStatus   `json:"service,omitempty"`   // Only set for container deployments with a Service
+	Runtime       *VMRuntimeStatus `json:"runtime,omitempty"`   // Only set for VM deployments with a running instance
+
+	// PreUpdateSnapshot names the snapshot of the previous revision's
+	// persistent disks, restorable via the "restore" action
+	PreUpdateSnapshot string `json:"preUpdateSnapshot,omitempty"`
+
+	// RestartRequired reports that spec changes applied in place — typically
+	// a CPU/memory resize beyond what hotplug covers — are waiting on a guest
+	// restart. Mirrors KubeVirt's RestartRequired condition; VM only.
+	RestartRequired bool `json:"restartRequired,omitempty"`
+}
+
+// ServiceStatus reports how a container deployment's Service is exposed:
+// the service type, any allocated node ports, and the load balancer address
+// once the cloud provider assigns one
+type ServiceStatus struct {
+	Type           string  `json:"type"`
+	NodePorts      []int32 `json:"nodePorts,omitempty"`
+	LoadBalancerIP string  `json:"loadBalancerIP,omitempty"`
+}
+
+// MigrationStatus reports the progress of the most recent live migration of a
+// VM deployment, driven by its VirtualMachineInstanceMigration object
+type MigrationStatus struct {
+	Name      string `json:"name"`
+	Phase     string `json:"phase"`
+	Completed bool   `json:"completed"`
+	Failed    bool   `json:"failed"`
+}
+
+// VMRuntimeStatus reports where a VM instance runs and how to reach it, read
+// from the backing VirtualMachineInstance. Guest OS details require the qemu
+// guest agent; they stay empty until it connects.
+type VMRuntimeStatus struct {
+	Node                string              `json:"node,omitempty"`
+	Interfaces          []VMInterfaceStatus `json:"interfaces,omitempty"`
+	GuestOS             string              `json:"guestOS,omitempty"`
+	KernelVersion       string              `json:"kernelVersion,omitempty"`
+	GuestAgentConnected bool                `json:"guestAgentConnected,omitempty"`
+}
+
+// VMInterfaceStatus describes one network interface of a running VM instance
+type VMInterfaceStatus struct {
+	Name string   `json:"name,omitempty"`
+	IP   string   `json:"ip,omitempty"`
+	IPs  []string `json:"ips,omitempty"`
+	Mac  string   `json:"mac,omitempty"`
+}
+
+// JobResult captures how a job deployment ended so one-off tasks can be
+// inspected after their pods are gone from view
+type JobResult struct {
+	ExitCode    *int32 `json:"exitCode,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+	LogsExcerpt string `json:"logsExcerpt,omitempty"`
 }
 
 // DeploymentPhase represents the phase of a deployment
@@ -112,9 +676,20 @@ type DeploymentPhase string
 const (
 	DeploymentPhasePending   DeploymentPhase = "pending"
 	DeploymentPhaseRunning   DeploymentPhase = "running"
+	DeploymentPhasePaused    DeploymentPhase = "paused"
 	DeploymentPhaseSucceeded DeploymentPhase = "succeeded"
 	DeploymentPhaseFailed    DeploymentPhase = "failed"
 	DeploymentPhaseUnknown   DeploymentPhase = "unknown"
+
+	// VM lifecycle phases derived from KubeVirt's printable status, so a VM
+	// that isn't ready yet reports what it is actually doing instead of a
+	// generic "pending"
+	DeploymentPhaseProvisioning DeploymentPhase = "provisioning"
+	DeploymentPhaseStarting     DeploymentPhase = "starting"
+	DeploymentPhaseMigrating    DeploymentPhase = "migrating"
+	DeploymentPhaseStopping     DeploymentPhase = "stopping"
+	DeploymentPhaseStopped      DeploymentPhase = "stopped"
+	DeploymentPhaseCrashLoop    DeploymentPhase = "crashloopbackoff"
 )
 
 // Condition represents a deployment condition
@@ -126,39 +701,216 @@ type Condition struct {
 	Message            string    `json:"message,omitempty"`
 }
 
-// ListDeploymentsRequest represents the request for listing deployments
+// Power actions supported by the deployment actions endpoint
+const (
+	ActionStart   = "start"
+	ActionStop    = "stop"
+	ActionRestart = "restart"
+	ActionPause   = "pause"
+	ActionUnpause = "unpause"
+	ActionMigrate = "migrate"
+	ActionRestore = "restore" // roll back to the pre-update disk snapshot
+)
+
+// Schedule actions supported for cronjob deployments
+const (
+	ActionTrigger = "trigger" // run the job now, outside the schedule
+	ActionSuspend = "suspend"
+	ActionResume  = "resume"
+)
+
+// Disk actions reported by the VM disk hotplug endpoints
+const (
+	ActionAttachDisk = "attach-disk"
+	ActionDetachDisk = "detach-disk"
+)
+
+// DeploymentActionRequest represents the request payload for deployment actions
+type DeploymentActionRequest struct {
+	Action string `json:"action" binding:"required,oneof=start stop restart pause unpause migrate restore trigger suspend resume"`
+}
+
+// DeploymentActionResponse acknowledges an accepted deployment action and
+// reports the phase observed right after it. Most actions are asynchronous,
+// so the phase may still change; the watch and events endpoints track the
+// transition.
+type DeploymentActionResponse struct {
+	ID        string          `json:"id"`
+	Action    string          `json:"action"`
+	Phase     DeploymentPhase `json:"phase,omitempty"`
+	Timestamp time.Time       `json:"timestamp"`
+}
+
+// BatchDeploymentRequest represents the request payload for creating multiple deployments
+type BatchDeploymentRequest struct {
+	Deployments []DeploymentRequest `json:"deployments" binding:"required,min=1,max=50,dive"`
+}
+
+// BatchDeploymentResult represents the outcome of a single item in a batch request
+type BatchDeploymentResult struct {
+	Name       string              `json:"name"`
+	Success    bool                `json:"success"`
+	Error      string              `json:"error,omitempty"`
+	Deployment *DeploymentResponse `json:"deployment,omitempty"`
+}
+
+// BatchDeploymentResponse represents the response for a batch create request
+type BatchDeploymentResponse struct {
+	Results   []BatchDeploymentResult `json:"results"`
+	Succeeded int                     `json:"succeeded"`
+	Failed    int                     `json:"failed"`
+}
+
+// DeploymentLogsRequest represents the query parameters for fetching deployment logs
+type DeploymentLogsRequest struct {
+	Container string `form:"container"`
+	Tail      int64  `form:"tail,default=0" binding:"min=0"`
+	Follow    bool   `form:"follow"`
+	Since     string `form:"since"` // Go duration, e.g. "5m" or "1h"
+}
+
+// ListDeploymentsRequest represents the request for listing deployments.
+// Owner filters by creator subject; the literal "me" is resolved to the
+// caller identity before the request reaches the service. Tag filters by the
+// ops tags stored on deployments. Continue resumes a previous listing from
+// the opaque token of its last page and takes precedence over Offset.
 type ListDeploymentsRequest struct {
 	Namespace string         `form:"namespace"`
 	Kind      DeploymentKind `form:"kind"`
+	// Cluster restricts the listing to one registered target cluster; empty
+	// aggregates the provider's own cluster and every registered target
+	Cluster   string         `form:"cluster"`
+	Owner     string         `form:"owner"`
+	Tag       string         `form:"tag"`
 	Limit     int            `form:"limit,default=20" binding:"min=1,max=100"`
 	Offset    int            `form:"offset,default=0" binding:"min=0"`
+	Continue  string         `form:"continue"`
 }
 
-// ListDeploymentsResponse represents the response for listing deployments
+// UpdateDeploymentMetadataRequest patches the ops metadata of a deployment
+// in place, without recreating any cluster resources. Nil fields are left
+// unchanged; empty values clear the stored data.
+type UpdateDeploymentMetadataRequest struct {
+	Notes *string   `json:"notes,omitempty" binding:"omitempty,max=2048"`
+	Tags  *[]string `json:"tags,omitempty"`
+}
+
+// ListDeploymentsResponse represents the response for listing deployments.
+// Warnings names the per-kind backends whose results were omitted when an
+// aggregated listing degraded to partial results.
 type ListDeploymentsResponse struct {
 	Deployments []DeploymentResponse `json:"deployments"`
 	Pagination  Pagination           `json:"pagination"`
+	Warnings    []string             `json:"warnings,omitempty"`
 }
 
-// Pagination represents pagination information
+// Pagination represents pagination information. Continue is an opaque token
+// resuming the listing after the last returned deployment; it is only set
+// when more results remain.
 type Pagination struct {
-	Limit   int  `json:"limit"`
-	Offset  int  `json:"offset"`
-	Total   int  `json:"total"`
-	HasMore bool `json:"hasMore"`
+	Limit    int    `json:"limit"`
+	Offset   int    `json:"offset"`
+	Total    int    `json:"total"`
+	HasMore  bool   `json:"hasMore"`
+	Continue string `json:"continue,omitempty"`
 }
 
-// HealthResponse represents the health check response
-type HealthResponse struct {
-	Status    string    `json:"status"`
-	Timestamp time.Time `json:"timestamp"`
+// DryRunResponse represents the rendered manifests from a dry-run create or
+// update; nothing is persisted on the cluster
+type DryRunResponse struct {
+	ID        string         `json:"id"`
+	Kind      DeploymentKind `json:"kind"`
+	Manifests []interface{}  `json:"manifests"`
 }
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Code      string    `json:"code"`
+// DeploymentEvent represents a Kubernetes event related to a deployment's
+// underlying resources (scheduling failures, image pull errors, etc.)
+type DeploymentEvent struct {
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason"`
 	Message   string    `json:"message"`
-	Details   string    `json:"details,omitempty"`
+	Object    string    `json:"object"` // "<kind>/<name>" of the involved object
+	Count     int32     `json:"count"`
+	FirstSeen time.Time `json:"firstSeen"`
+	LastSeen  time.Time `json:"lastSeen"`
+}
+
+// DeploymentEventsResponse represents the response for listing deployment events
+type DeploymentEventsResponse struct {
+	Events []DeploymentEvent `json:"events"`
+	Count  int               `json:"count"`
+}
+
+// ResourceUsage holds a normalized point-in-time usage sample
+type ResourceUsage struct {
+	CPUMillicores int64 `json:"cpuMillicores"`
+	MemoryBytes   int64 `json:"memoryBytes"`
+}
+
+// ContainerUsage represents the usage of a single container within a pod
+type ContainerUsage struct {
+	Name  string        `json:"name"`
+	Usage ResourceUsage `json:"usage"`
+}
+
+// PodUsage represents the usage of a single pod backing a deployment, with
+// per-container breakdown and the pod total
+type PodUsage struct {
+	Name       string           `json:"name"`
+	Timestamp  time.Time        `json:"timestamp"`
+	Containers []ContainerUsage `json:"containers"`
+	Usage      ResourceUsage    `json:"usage"`
+}
+
+// DeploymentMetricsResponse represents the resource usage of the pods backing
+// a deployment, normalized per pod with deployment totals so dashboards can
+// chart usage without direct cluster metrics access
+type DeploymentMetricsResponse struct {
+	ID        string         `json:"id"`
+	Kind      DeploymentKind `json:"kind"`
+	Namespace string         `json:"namespace"`
+	Pods      []PodUsage     `json:"pods"`
+	Totals    ResourceUsage  `json:"totals"`
+}
+
+// Namespace feed sources
+const (
+	FeedSourceAudit   = "audit"   // provider audit log entries
+	FeedSourceCluster = "cluster" // Kubernetes warning events
+)
+
+// Namespace feed severities
+const (
+	FeedSeverityInfo    = "info"
+	FeedSeverityWarning = "warning"
+	FeedSeverityError   = "error"
+)
+
+// NamespaceFeedItem represents a single entry in the namespace activity feed
+type NamespaceFeedItem struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Source       string    `json:"source"`
+	Severity     string    `json:"severity"`
+	Message      string    `json:"message"`
+	DeploymentID string    `json:"deploymentId,omitempty"`
+	Object       string    `json:"object,omitempty"` // "<kind>/<name>" of the involved object
+}
+
+// NamespaceEventsResponse represents the paginated namespace activity feed
+type NamespaceEventsResponse struct {
+	Namespace  string              `json:"namespace"`
+	Events     []NamespaceFeedItem `json:"events"`
+	Pagination Pagination          `json:"pagination"`
+}
+
+// Capabilities represents the optional cluster capabilities detected by the provider
+type Capabilities struct {
+	KubeVirt bool `json:"kubevirt"`
+}
+
+// HealthResponse represents the health check response
+type HealthResponse struct {
+	Status    string    `json:"status"`
 	Timestamp time.Time `json:"timestamp"`
 }
 
@@ -180,3 +932,193 @@ func BuildDeploymentLabels(id, name string) map[string]string {
 		LabelManagedBy: LabelValueManagedBy,
 	}
 }
+
+// BuildTimestampAnnotations creates the annotations that persist deployment timestamps
+func BuildTimestampAnnotations(createdAt, updatedAt time.Time) map[string]string {
+	return map[string]string{
+		AnnotationCreatedAt: createdAt.UTC().Format(time.RFC3339),
+		AnnotationUpdatedAt: updatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// BuildCreatorAnnotations creates the annotations that persist the creator identity.
+// A nil creator yields no annotations, keeping anonymous creates unchanged.
+func BuildCreatorAnnotations(creator *Creator) map[string]string {
+	if creator == nil {
+		return nil
+	}
+	annotations := map[string]string{
+		AnnotationCreatedBy: creator.Subject,
+	}
+	if creator.Email != "" {
+		annotations[AnnotationCreatedByEmail] = creator.Email
+	}
+	return annotations
+}
+
+// CreatorFromAnnotations reads the persisted creator identity from resource
+// annotations, returning nil for resources created before identities were
+// stamped or by anonymous callers
+func CreatorFromAnnotations(annotations map[string]string) *Creator {
+	subject, ok := annotations[AnnotationCreatedBy]
+	if !ok {
+		return nil
+	}
+	return &Creator{
+		Subject: subject,
+		Email:   annotations[AnnotationCreatedByEmail],
+	}
+}
+
+// TimestampsFromAnnotations reads the persisted timestamps from resource annotations,
+// falling back to the provided time (typically the resource's CreationTimestamp)
+// for resources created before timestamps were persisted
+func TimestampsFromAnnotations(annotations map[string]string, fallback time.Time) (createdAt, updatedAt time.Time) {
+	createdAt = fallback
+	updatedAt = fallback
+
+	if value, ok := annotations[AnnotationCreatedAt]; ok {
+		if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+			createdAt = parsed
+		}
+	}
+	if value, ok := annotations[AnnotationUpdatedAt]; ok {
+		if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+			updatedAt = parsed
+		}
+	}
+	return createdAt, updatedAt
+}
+
+// ApplyOpsAnnotations stores the notes and tags from request metadata in the
+// resource annotation map. Empty values clear the corresponding annotation.
+func ApplyOpsAnnotations(annotations map[string]string, notes string, tags []string) {
+	if notes != "" {
+		annotations[AnnotationNotes] = notes
+	} else {
+		delete(annotations, AnnotationNotes)
+	}
+	if len(tags) > 0 {
+		annotations[AnnotationTags] = strings.Join(tags, ",")
+	} else {
+		delete(annotations, AnnotationTags)
+	}
+}
+
+// ValidateUserAnnotations rejects caller-supplied annotations that would
+// shadow the provider's own reserved keys
+func ValidateUserAnnotations(annotations map[string]string) error {
+	for key := range annotations {
+		if strings.HasPrefix(key, ReservedAnnotationPrefix) {
+			return NewErrInvalidSpec("metadata.annotations",
+				fmt.Sprintf("key %q uses the reserved %q prefix", key, ReservedAnnotationPrefix))
+		}
+	}
+	return nil
+}
+
+// MergeUserAnnotations copies caller-supplied annotations into a managed
+// resource's annotation map
+func MergeUserAnnotations(annotations, user map[string]string) {
+	for key, value := range user {
+		annotations[key] = value
+	}
+}
+
+// UserAnnotations filters a managed resource's annotations down to the
+// caller-supplied ones, for responses and pod template propagation. Returns
+// nil when only reserved keys are present.
+func UserAnnotations(annotations map[string]string) map[string]string {
+	var user map[string]string
+	for key, value := range annotations {
+		if strings.HasPrefix(key, ReservedAnnotationPrefix) {
+			continue
+		}
+		if user == nil {
+			user = make(map[string]string)
+		}
+		user[key] = value
+	}
+	return user
+}
+
+// TagsFromAnnotations reads the stored tag list from resource annotations
+func TagsFromAnnotations(annotations map[string]string) []string {
+	value, ok := annotations[AnnotationTags]
+	if !ok || value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// HasTag reports whether the tag list contains the tag
+func HasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Sync actions produced by the differential sync planner
+const (
+	SyncActionCreate   = "create"   // record is missing from the cluster; apply recreates it
+	SyncActionAdopt    = "adopt"    // a matching deployment already exists and is left as-is
+	SyncActionConflict = "conflict" // the ID exists with a different identity; needs manual resolution
+)
+
+// Sync apply statuses reported per record
+const (
+	SyncStatusApplied = "applied"
+	SyncStatusSkipped = "skipped"
+	SyncStatusFailed  = "failed"
+)
+
+// SyncRecord is one deployment record from a backup or export, diffed
+// against the live cluster during a differential sync
+type SyncRecord struct {
+	ID         string            `json:"id" binding:"required,uuid"`
+	Deployment DeploymentRequest `json:"deployment" binding:"required"`
+}
+
+// SyncPlanRequest carries the records to diff against the cluster
+type SyncPlanRequest struct {
+	Records []SyncRecord `json:"records" binding:"required,min=1,dive"`
+}
+
+// SyncPlanEntry is the planned action for one record
+type SyncPlanEntry struct {
+	ID        string         `json:"id"`
+	Kind      DeploymentKind `json:"kind"`
+	Name      string         `json:"name"`
+	Namespace string         `json:"namespace,omitempty"`
+	Action    string         `json:"action"`
+	Reason    string         `json:"reason,omitempty"`
+}
+
+// SyncPlanResponse is the full differential sync plan
+type SyncPlanResponse struct {
+	Entries []SyncPlanEntry `json:"entries"`
+	Summary map[string]int  `json:"summary"`
+}
+
+// SyncApplyRequest applies planned creates. IDs selects a subset of records;
+// when empty, every planned create is applied.
+type SyncApplyRequest struct {
+	Records []SyncRecord `json:"records" binding:"required,min=1,dive"`
+	IDs     []string     `json:"ids,omitempty"`
+}
+
+// SyncApplyResult reports the outcome for one record
+type SyncApplyResult struct {
+	ID     string `json:"id"`
+	Action string `json:"action"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// SyncApplyResponse is the per-record outcome of a selective apply
+type SyncApplyResponse struct {
+	Results []SyncApplyResult `json:"results"`
+}
@@ -304,27 +304,27 @@ func TestMetadata_Validation(t *testing.T) {
 }
 
 func TestErrorResponse_JSON(t *testing.T) {
-	now := time.Now()
-	errorResp := ErrorResponse{
-		Code:      "DEPLOYMENT_FAILED",
-		Message:   "Failed to create deployment",
-		Details:   "Kubernetes API error: namespace not found",
-		Timestamp: now,
-	}
+	errorResp := NewProblem(500, CodeDeploymentFailed,
+		"Failed to create deployment", "Kubernetes API error: namespace not found")
 
-	// Test marshaling
+	// The type URI is derived from the code
+	assert.Equal(t, ProblemTypeBase+"deployment-failed", errorResp.Type)
+
+	// Test marshaling into the RFC 7807 members
 	jsonData, err := json.Marshal(errorResp)
 	assert.NoError(t, err)
 	assert.Contains(t, string(jsonData), "DEPLOYMENT_FAILED")
-	assert.Contains(t, string(jsonData), "Failed to create deployment")
+	assert.Contains(t, string(jsonData), `"title":"Failed to create deployment"`)
+	assert.Contains(t, string(jsonData), `"detail":"Kubernetes API error: namespace not found"`)
+	assert.Contains(t, string(jsonData), `"status":500`)
 
 	// Test unmarshaling
 	var unmarshaled ErrorResponse
 	err = json.Unmarshal(jsonData, &unmarshaled)
 	assert.NoError(t, err)
 	assert.Equal(t, errorResp.Code, unmarshaled.Code)
-	assert.Equal(t, errorResp.Message, unmarshaled.Message)
-	assert.Equal(t, errorResp.Details, unmarshaled.Details)
+	assert.Equal(t, errorResp.Title, unmarshaled.Title)
+	assert.Equal(t, errorResp.Detail, unmarshaled.Detail)
 }
 
 func TestHealthResponse_JSON(t *testing.T) {
@@ -345,3 +345,61 @@ func TestHealthResponse_JSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, healthResp.Status, unmarshaled.Status)
 }
+
+func TestTimestampAnnotations(t *testing.T) {
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2024, 2, 20, 15, 30, 0, 0, time.UTC)
+
+	annotations := BuildTimestampAnnotations(createdAt, updatedAt)
+	assert.Equal(t, "2024-01-15T10:00:00Z", annotations[AnnotationCreatedAt])
+	assert.Equal(t, "2024-02-20T15:30:00Z", annotations[AnnotationUpdatedAt])
+
+	// Round trip through annotations
+	gotCreated, gotUpdated := TimestampsFromAnnotations(annotations, time.Now())
+	assert.True(t, createdAt.Equal(gotCreated))
+	assert.True(t, updatedAt.Equal(gotUpdated))
+}
+
+func TestTimestampsFromAnnotations_Fallback(t *testing.T) {
+	fallback := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Missing annotations fall back to the provided time
+	gotCreated, gotUpdated := TimestampsFromAnnotations(nil, fallback)
+	assert.True(t, fallback.Equal(gotCreated))
+	assert.True(t, fallback.Equal(gotUpdated))
+
+	// Unparseable values also fall back
+	gotCreated, gotUpdated = TimestampsFromAnnotations(map[string]string{
+		AnnotationCreatedAt: "not-a-timestamp",
+		AnnotationUpdatedAt: "also-not-a-timestamp",
+	}, fallback)
+	assert.True(t, fallback.Equal(gotCreated))
+	assert.True(t, fallback.Equal(gotUpdated))
+}
+
+func TestValidateUserAnnotations(t *testing.T) {
+	assert.NoError(t, ValidateUserAnnotations(nil))
+	assert.NoError(t, ValidateUserAnnotations(map[string]string{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   "9090",
+	}))
+
+	err := ValidateUserAnnotations(map[string]string{
+		ReservedAnnotationPrefix + "created-at": "2024-01-15T10:00:00Z",
+	})
+	assert.Error(t, err)
+	assert.True(t, IsInvalidSpecError(err))
+	assert.Contains(t, err.Error(), "reserved")
+}
+
+func TestUserAnnotations(t *testing.T) {
+	annotations := BuildTimestampAnnotations(time.Now(), time.Now())
+	MergeUserAnnotations(annotations, map[string]string{"prometheus.io/scrape": "true"})
+
+	user := UserAnnotations(annotations)
+	assert.Equal(t, map[string]string{"prometheus.io/scrape": "true"}, user)
+
+	// Only reserved keys yields nil, keeping responses free of empty maps
+	assert.Nil(t, UserAnnotations(BuildTimestampAnnotations(time.Now(), time.Now())))
+	assert.Nil(t, UserAnnotations(nil))
+}
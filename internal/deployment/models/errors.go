@@ -68,6 +68,267 @@ func NewErrDeploymentAlreadyExists(id, namespace string, kind DeploymentKind) *E
 	}
 }
 
+// ErrNameConflict represents an error when a create request reuses a
+// metadata.name already taken in the target namespace
+type ErrNameConflict struct {
+	Name      string
+	Namespace string
+}
+
+func (e *ErrNameConflict) Error() string {
+	return fmt.Sprintf("deployment name %q is already in use in namespace %s", e.Name, e.Namespace)
+}
+
+// NewErrNameConflict creates a new ErrNameConflict
+func NewErrNameConflict(name, namespace string) *ErrNameConflict {
+	return &ErrNameConflict{
+		Name:      name,
+		Namespace: namespace,
+	}
+}
+
+// ErrKubeVirtUnavailable represents an error when KubeVirt is not installed on the cluster
+type ErrKubeVirtUnavailable struct{}
+
+func (e *ErrKubeVirtUnavailable) Error() string {
+	return "KubeVirt is not available on this cluster: VM deployments are not supported until the KubeVirt CRDs are installed"
+}
+
+// NewErrKubeVirtUnavailable creates a new ErrKubeVirtUnavailable
+func NewErrKubeVirtUnavailable() *ErrKubeVirtUnavailable {
+	return &ErrKubeVirtUnavailable{}
+}
+
+// ErrMetricsUnavailable represents an error when the metrics API
+// (metrics-server) is not installed on the cluster
+type ErrMetricsUnavailable struct{}
+
+func (e *ErrMetricsUnavailable) Error() string {
+	return "metrics API is not available on this cluster"
+}
+
+// NewErrMetricsUnavailable creates a new ErrMetricsUnavailable
+func NewErrMetricsUnavailable() *ErrMetricsUnavailable {
+	return &ErrMetricsUnavailable{}
+}
+
+// ErrLogsNotSupported represents an error when logs are requested for a deployment kind without log support
+type ErrLogsNotSupported struct {
+	ID   string
+	Kind DeploymentKind
+}
+
+func (e *ErrLogsNotSupported) Error() string {
+	return fmt.Sprintf("logs are not supported for deployment %s (kind: %s)", e.ID, e.Kind)
+}
+
+// NewErrLogsNotSupported creates a new ErrLogsNotSupported
+func NewErrLogsNotSupported(id string, kind DeploymentKind) *ErrLogsNotSupported {
+	return &ErrLogsNotSupported{ID: id, Kind: kind}
+}
+
+// ErrConsoleLogNotSupported represents an error when console logs are requested for a non-VM deployment
+type ErrConsoleLogNotSupported struct {
+	ID   string
+	Kind DeploymentKind
+}
+
+func (e *ErrConsoleLogNotSupported) Error() string {
+	return fmt.Sprintf("console logs are not supported for deployment %s (kind: %s)", e.ID, e.Kind)
+}
+
+// NewErrConsoleLogNotSupported creates a new ErrConsoleLogNotSupported
+func NewErrConsoleLogNotSupported(id string, kind DeploymentKind) *ErrConsoleLogNotSupported {
+	return &ErrConsoleLogNotSupported{ID: id, Kind: kind}
+}
+
+// ErrActionNotSupported represents an error when an action is requested for a deployment kind that does not support it
+type ErrActionNotSupported struct {
+	ID     string
+	Kind   DeploymentKind
+	Action string
+}
+
+func (e *ErrActionNotSupported) Error() string {
+	return fmt.Sprintf("action %s is not supported for deployment %s (kind: %s)", e.Action, e.ID, e.Kind)
+}
+
+// NewErrActionNotSupported creates a new ErrActionNotSupported
+func NewErrActionNotSupported(id string, kind DeploymentKind, action string) *ErrActionNotSupported {
+	return &ErrActionNotSupported{ID: id, Kind: kind, Action: action}
+}
+
+// ErrOperationInProgress represents an error when a conflicting operation
+// already holds the per-deployment lock
+type ErrOperationInProgress struct {
+	ID        string
+	Operation string // The operation currently holding the lock
+}
+
+func (e *ErrOperationInProgress) Error() string {
+	return fmt.Sprintf("a %s operation is already in progress for deployment %s", e.Operation, e.ID)
+}
+
+// NewErrOperationInProgress creates a new ErrOperationInProgress
+func NewErrOperationInProgress(id, operation string) *ErrOperationInProgress {
+	return &ErrOperationInProgress{ID: id, Operation: operation}
+}
+
+// ErrInvalidVMShape represents an error when a requested VM shape violates the operator-defined limits
+type ErrInvalidVMShape struct {
+	Reason string
+}
+
+func (e *ErrInvalidVMShape) Error() string {
+	return fmt.Sprintf("invalid VM shape: %s", e.Reason)
+}
+
+// NewErrInvalidVMShape creates a new ErrInvalidVMShape
+func NewErrInvalidVMShape(reason string) *ErrInvalidVMShape {
+	return &ErrInvalidVMShape{Reason: reason}
+}
+
+// ErrInvalidSpec represents a field-level validation error in a deployment
+// spec, caught before any Kubernetes API call is made
+type ErrInvalidSpec struct {
+	Field  string
+	Reason string
+}
+
+func (e *ErrInvalidSpec) Error() string {
+	return fmt.Sprintf("invalid spec: %s: %s", e.Field, e.Reason)
+}
+
+// NewErrInvalidSpec creates a new ErrInvalidSpec
+func NewErrInvalidSpec(field, reason string) *ErrInvalidSpec {
+	return &ErrInvalidSpec{Field: field, Reason: reason}
+}
+
+// ErrSpecViolations aggregates field-level spec validation failures so a
+// single response can report every offending field at once
+type ErrSpecViolations struct {
+	Violations []FieldViolation
+}
+
+func (e *ErrSpecViolations) Error() string {
+	if len(e.Violations) == 1 {
+		return fmt.Sprintf("invalid spec: %s: %s", e.Violations[0].Field, e.Violations[0].Reason)
+	}
+	return fmt.Sprintf("invalid spec: %d field violations", len(e.Violations))
+}
+
+// NewErrSpecViolations creates a new ErrSpecViolations
+func NewErrSpecViolations(violations []FieldViolation) *ErrSpecViolations {
+	return &ErrSpecViolations{Violations: violations}
+}
+
+// ErrInvalidContinueToken represents an error when the continue token from a
+// previous list response cannot be decoded
+type ErrInvalidContinueToken struct{}
+
+func (e *ErrInvalidContinueToken) Error() string {
+	return "invalid continue token"
+}
+
+// NewErrInvalidContinueToken creates a new ErrInvalidContinueToken
+func NewErrInvalidContinueToken() *ErrInvalidContinueToken {
+	return &ErrInvalidContinueToken{}
+}
+
+// ErrFieldNotSupported represents a spec field that is well-formed but that
+// the current cluster cannot honor, e.g. an ingress section on a cluster
+// without an ingress controller. Distinct from ErrInvalidSpec so handlers can
+// report it as 501 Not Implemented rather than a client error.
+type ErrFieldNotSupported struct {
+	Field  string
+	Reason string
+}
+
+func (e *ErrFieldNotSupported) Error() string {
+	return fmt.Sprintf("field not supported: %s: %s", e.Field, e.Reason)
+}
+
+// NewErrFieldNotSupported creates a new ErrFieldNotSupported
+func NewErrFieldNotSupported(field, reason string) *ErrFieldNotSupported {
+	return &ErrFieldNotSupported{Field: field, Reason: reason}
+}
+
+// ErrNamespaceForbidden represents an error when a request targets a
+// namespace the tenant may not use, either outside their allowed set or
+// refused by the operator's namespace policy
+type ErrNamespaceForbidden struct {
+	Namespace string
+	Reason    string
+}
+
+func (e *ErrNamespaceForbidden) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("namespace %s is not allowed: %s", e.Namespace, e.Reason)
+	}
+	return fmt.Sprintf("namespace %s is not allowed for this tenant", e.Namespace)
+}
+
+// NewErrNamespaceForbidden creates a new ErrNamespaceForbidden
+func NewErrNamespaceForbidden(namespace string) *ErrNamespaceForbidden {
+	return &ErrNamespaceForbidden{Namespace: namespace}
+}
+
+// NewErrNamespaceDenied creates an ErrNamespaceForbidden carrying the policy
+// reason the namespace was refused
+func NewErrNamespaceDenied(namespace, reason string) *ErrNamespaceForbidden {
+	return &ErrNamespaceForbidden{Namespace: namespace, Reason: reason}
+}
+
+// ErrQuotaExceeded represents an error when a namespace ResourceQuota leaves
+// no headroom for the resources a deployment requests
+type ErrQuotaExceeded struct {
+	Namespace string
+	Resource  string
+	Requested string
+	Used      string
+	Limit     string
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("quota exceeded in namespace %s: requested %s %s, used %s of %s",
+		e.Namespace, e.Requested, e.Resource, e.Used, e.Limit)
+}
+
+// NewErrQuotaExceeded creates a new ErrQuotaExceeded
+func NewErrQuotaExceeded(namespace, resource, requested, used, limit string) *ErrQuotaExceeded {
+	return &ErrQuotaExceeded{Namespace: namespace, Resource: resource, Requested: requested, Used: used, Limit: limit}
+}
+
+// ErrClusterNotFound represents an error when a request targets a cluster
+// that is not registered with the provider
+type ErrClusterNotFound struct {
+	Cluster string
+}
+
+func (e *ErrClusterNotFound) Error() string {
+	return fmt.Sprintf("cluster %s is not registered", e.Cluster)
+}
+
+// NewErrClusterNotFound creates a new ErrClusterNotFound
+func NewErrClusterNotFound(cluster string) *ErrClusterNotFound {
+	return &ErrClusterNotFound{Cluster: cluster}
+}
+
+// ErrNodeNotFound represents an error when a node maintenance operation
+// targets a node that does not exist in the cluster
+type ErrNodeNotFound struct {
+	Node string
+}
+
+func (e *ErrNodeNotFound) Error() string {
+	return fmt.Sprintf("node %q not found", e.Node)
+}
+
+// NewErrNodeNotFound creates a new ErrNodeNotFound
+func NewErrNodeNotFound(node string) *ErrNodeNotFound {
+	return &ErrNodeNotFound{Node: node}
+}
+
 // Helper functions for error type checking
 
 // IsNotFoundError checks if an error is a deployment not found error
@@ -97,3 +358,100 @@ func IsAlreadyExistsError(err error) bool {
 	_, ok := err.(*ErrDeploymentAlreadyExists)
 	return ok
 }
+
+// IsNameConflictError checks if an error is a deployment name conflict error
+func IsNameConflictError(err error) bool {
+	_, ok := err.(*ErrNameConflict)
+	return ok
+}
+
+// IsLogsNotSupportedError checks if an error indicates the deployment kind has no log support
+func IsLogsNotSupportedError(err error) bool {
+	_, ok := err.(*ErrLogsNotSupported)
+	return ok
+}
+
+// IsConsoleLogNotSupportedError checks if an error indicates the deployment kind has no console log support
+func IsConsoleLogNotSupportedError(err error) bool {
+	_, ok := err.(*ErrConsoleLogNotSupported)
+	return ok
+}
+
+// IsActionNotSupportedError checks if an error indicates the deployment kind does not support the requested action
+func IsActionNotSupportedError(err error) bool {
+	_, ok := err.(*ErrActionNotSupported)
+	return ok
+}
+
+// IsOperationInProgressError checks if an error indicates a conflicting operation holds the deployment lock
+func IsOperationInProgressError(err error) bool {
+	_, ok := err.(*ErrOperationInProgress)
+	return ok
+}
+
+// IsInvalidVMShapeError checks if an error indicates the requested VM shape violates the operator-defined limits
+func IsInvalidVMShapeError(err error) bool {
+	_, ok := err.(*ErrInvalidVMShape)
+	return ok
+}
+
+// IsInvalidSpecError checks if an error is a field-level spec validation error
+func IsInvalidSpecError(err error) bool {
+	_, ok := err.(*ErrInvalidSpec)
+	return ok
+}
+
+// IsSpecViolationsError checks if an error carries aggregated field-level spec violations
+func IsSpecViolationsError(err error) bool {
+	_, ok := err.(*ErrSpecViolations)
+	return ok
+}
+
+// IsInvalidContinueTokenError checks if an error indicates a continue token could not be decoded
+func IsInvalidContinueTokenError(err error) bool {
+	_, ok := err.(*ErrInvalidContinueToken)
+	return ok
+}
+
+// IsMetricsUnavailableError checks if an error indicates the metrics API is not installed
+func IsMetricsUnavailableError(err error) bool {
+	_, ok := err.(*ErrMetricsUnavailable)
+	return ok
+}
+
+// IsFieldNotSupportedError checks if an error marks a spec field the current
+// cluster cannot honor
+func IsFieldNotSupportedError(err error) bool {
+	_, ok := err.(*ErrFieldNotSupported)
+	return ok
+}
+
+// IsKubeVirtUnavailableError checks if an error indicates KubeVirt is not installed
+func IsKubeVirtUnavailableError(err error) bool {
+	_, ok := err.(*ErrKubeVirtUnavailable)
+	return ok
+}
+
+// IsNamespaceForbiddenError checks if an error indicates the tenant does not own the target namespace
+func IsNamespaceForbiddenError(err error) bool {
+	_, ok := err.(*ErrNamespaceForbidden)
+	return ok
+}
+
+// IsQuotaExceededError checks if an error indicates the namespace quota has no headroom
+func IsQuotaExceededError(err error) bool {
+	_, ok := err.(*ErrQuotaExceeded)
+	return ok
+}
+
+// IsClusterNotFoundError checks if an error indicates the target cluster is not registered
+func IsClusterNotFoundError(err error) bool {
+	_, ok := err.(*ErrClusterNotFound)
+	return ok
+}
+
+// IsNodeNotFoundError checks if an error is a node not found error
+func IsNodeNotFoundError(err error) bool {
+	_, ok := err.(*ErrNodeNotFound)
+	return ok
+}
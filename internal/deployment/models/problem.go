@@ -0,0 +1,147 @@
+package models
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ContentTypeProblem is the RFC 7807 media type for error responses
+const ContentTypeProblem = "application/problem+json"
+
+// ProblemTypeBase is the base URI reference for problem types. The full type
+// is the base plus the lowercased, hyphenated error code, so clients can
+// dereference it against the service for documentation.
+const ProblemTypeBase = "/problems/"
+
+// Machine-readable error codes carried in the "code" member of every problem
+// document. These are stable: clients match on them, so codes are never
+// renamed or reused; new failure modes get new codes.
+const (
+	// Request shape and validation
+	CodeInvalidRequest  = "INVALID_REQUEST"  // malformed body or parameters
+	CodeInvalidSpec     = "INVALID_SPEC"     // spec failed field-level validation
+	CodeInvalidQuery    = "INVALID_QUERY"    // malformed query parameters
+	CodeInvalidContinue = "INVALID_CONTINUE" // undecodable list continue token
+	CodeInvalidVMShape  = "INVALID_VM_SHAPE" // VM shape outside operator limits
+	CodeMissingID       = "MISSING_ID"       // deployment ID missing from the path
+	CodeMissingIdentity = "MISSING_IDENTITY" // no caller identity on the request
+
+	// Authentication and authorization
+	CodeUnauthorized       = "UNAUTHORIZED"
+	CodeForbidden          = "FORBIDDEN"
+	CodeNamespaceForbidden = "NAMESPACE_FORBIDDEN"
+	CodeRateLimited        = "RATE_LIMITED"
+
+	// Lookup and conflicts
+	CodeDeploymentNotFound       = "DEPLOYMENT_NOT_FOUND"
+	CodeDeploymentIDExists       = "DEPLOYMENT_ID_EXISTS"
+	CodeDeploymentIDConflict     = "DEPLOYMENT_ID_CONFLICT"
+	CodeMultipleDeploymentsFound = "MULTIPLE_DEPLOYMENTS_FOUND"
+	CodeNameConflict             = "NAME_CONFLICT"
+	CodeClusterNotFound          = "CLUSTER_NOT_FOUND"
+	CodeNodeNotFound             = "NODE_NOT_FOUND"
+	CodeOperationInProgress      = "OPERATION_IN_PROGRESS"
+	CodeProtectedDeployment      = "PROTECTED_DEPLOYMENT"
+	CodeQuotaExceeded            = "QUOTA_EXCEEDED"
+
+	// Approval workflow
+	CodeApprovalRequired     = "APPROVAL_REQUIRED"
+	CodeApprovalNotFound     = "APPROVAL_NOT_FOUND"
+	CodeApprovalNotPending   = "APPROVAL_NOT_PENDING"
+	CodeApprovalsUnavailable = "APPROVALS_UNAVAILABLE"
+	CodeApproverRequired     = "APPROVER_REQUIRED"
+	CodeSelfApproval         = "SELF_APPROVAL"
+
+	// Cluster capabilities the request depends on
+	CodeKubeVirtUnavailable = "KUBEVIRT_UNAVAILABLE"
+	CodeMetricsUnavailable  = "METRICS_UNAVAILABLE"
+	CodeEventsUnavailable   = "EVENTS_UNAVAILABLE"
+	CodeAuditUnavailable    = "AUDIT_UNAVAILABLE"
+	CodeFieldNotSupported   = "FIELD_NOT_SUPPORTED"
+
+	// Operations the deployment kind does not support
+	CodeLogsNotSupported       = "LOGS_NOT_SUPPORTED"
+	CodeConsoleNotSupported    = "CONSOLE_NOT_SUPPORTED"
+	CodeConsoleLogNotSupported = "CONSOLE_LOG_NOT_SUPPORTED"
+	CodeActionNotSupported     = "ACTION_NOT_SUPPORTED"
+
+	// Operation failures
+	CodeDeploymentFailed        = "DEPLOYMENT_FAILED"
+	CodeUpdateFailed            = "UPDATE_FAILED"
+	CodeDeleteFailed            = "DELETE_FAILED"
+	CodeListFailed              = "LIST_FAILED"
+	CodeDryRunFailed            = "DRY_RUN_FAILED"
+	CodeWatchFailed             = "WATCH_FAILED"
+	CodeLogsFailed              = "LOGS_FAILED"
+	CodeConsoleFailed           = "CONSOLE_FAILED"
+	CodeConsoleLogFailed        = "CONSOLE_LOG_FAILED"
+	CodeEventsFailed            = "EVENTS_FAILED"
+	CodeEventFeedFailed         = "EVENT_FEED_FAILED"
+	CodeMetricsFailed           = "METRICS_FAILED"
+	CodeActionFailed            = "ACTION_FAILED"
+	CodeMetadataUpdateFailed    = "METADATA_UPDATE_FAILED"
+	CodeMaintenanceFailed       = "MAINTENANCE_FAILED"
+	CodeMaintenanceStatusFailed = "MAINTENANCE_STATUS_FAILED"
+	CodeOrphanSweepFailed       = "ORPHAN_SWEEP_FAILED"
+	CodeDriftCheckFailed        = "DRIFT_CHECK_FAILED"
+	CodeAdoptionFailed          = "ADOPTION_FAILED"
+	CodeSSHKeyNotFound          = "SSH_KEY_NOT_FOUND"
+	CodeSSHKeyExists            = "SSH_KEY_EXISTS"
+	CodeSSHKeyInUse             = "SSH_KEY_IN_USE"
+	CodeSSHKeysUnavailable      = "SSH_KEYS_UNAVAILABLE"
+	CodeSyncPlanFailed          = "SYNC_PLAN_FAILED"
+	CodeSyncApplyFailed         = "SYNC_APPLY_FAILED"
+	CodeNamespaceListFailed     = "NAMESPACE_LIST_FAILED"
+	CodeInternalError           = "INTERNAL_ERROR"
+
+	// Routing
+	CodeNotFound         = "NOT_FOUND"
+	CodeMethodNotAllowed = "METHOD_NOT_ALLOWED"
+)
+
+// FieldViolation pins a validation failure to the request field that caused
+// it, using the JSON path of the field
+type FieldViolation struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// ErrorResponse is an RFC 7807 problem document. Type, Title, Status, Detail
+// and Instance are the standard members; Code, Errors and Timestamp are
+// extensions carrying the stable error code, field-level validation failures
+// and the server-side time of the error.
+type ErrorResponse struct {
+	Type      string           `json:"type"`
+	Title     string           `json:"title"`
+	Status    int              `json:"status"`
+	Detail    string           `json:"detail,omitempty"`
+	Instance  string           `json:"instance,omitempty"`
+	Code      string           `json:"code"`
+	Errors    []FieldViolation `json:"errors,omitempty"`
+	Timestamp time.Time        `json:"timestamp"`
+}
+
+// NewProblem builds a problem document for the code, deriving the type URI
+// from it. Title is the stable human summary of the error class; detail
+// describes this particular occurrence and may be empty.
+func NewProblem(status int, code, title, detail string) ErrorResponse {
+	return ErrorResponse{
+		Type:      ProblemTypeBase + strings.ToLower(strings.ReplaceAll(code, "_", "-")),
+		Title:     title,
+		Status:    status,
+		Detail:    detail,
+		Code:      code,
+		Timestamp: time.Now(),
+	}
+}
+
+// WriteProblem writes the document to a plain net/http response with the
+// problem+json media type. Handlers and middleware outside Gin use this so
+// both APIs emit the same documents.
+func WriteProblem(w http.ResponseWriter, document ErrorResponse) {
+	w.Header().Set("Content-Type", ContentTypeProblem)
+	w.WriteHeader(document.Status)
+	_ = json.NewEncoder(w).Encode(document)
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestValidateSSHService(t *testing.T) {
+	key := "ssh-ed25519 AAAA... user@host"
+	secret := "team-key"
+
+	// No access Service requested
+	assert.NoError(t, validateSSHService(&models.VMConfig{Os: "fedora"}))
+
+	// Either SSH key field satisfies the requirement
+	assert.NoError(t, validateSSHService(&models.VMConfig{Os: "fedora", SshServiceType: "NodePort", SshPublicKey: &key}))
+	assert.NoError(t, validateSSHService(&models.VMConfig{Os: "fedora", SshServiceType: "LoadBalancer", SshKeyName: &secret}))
+
+	// An SSH Service without a key to log in with is refused
+	err := validateSSHService(&models.VMConfig{Os: "fedora", SshServiceType: "NodePort"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires sshPublicKey or sshKeyName")
+}
+
+func TestBuildVirtualMachineSSHServiceLabels(t *testing.T) {
+	labels := models.BuildDeploymentLabels("deploy-id", "devbox")
+
+	// Without an access Service the instance template carries no labels
+	vm := buildVirtualMachine("devbox", "default", &models.VMConfig{Os: "fedora", Ram: 2, Cpu: 1},
+		labels, map[string]string{}, "", "")
+	assert.Empty(t, vm.Spec.Template.ObjectMeta.Labels)
+
+	// With one, the deployment labels are mirrored so the Service selector
+	// matches the virt-launcher pod
+	vm = buildVirtualMachine("devbox", "default",
+		&models.VMConfig{Os: "fedora", Ram: 2, Cpu: 1, SshServiceType: "NodePort"},
+		labels, map[string]string{}, "", "")
+	assert.Equal(t, labels, vm.Spec.Template.ObjectMeta.Labels)
+}
@@ -0,0 +1,294 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/spec"
+	"github.com/dcm-project/k8s-service-provider/internal/events"
+	"github.com/dcm-project/k8s-service-provider/internal/tenancy"
+)
+
+// AdoptDeployment takes over an existing workload as a managed deployment:
+// the primary object is located by name or label selector, stamped with the
+// managed-by/app-id labels, timestamp and creator annotations, and a spec
+// snapshot synthesized from its live state. When a selector is given, the
+// matching Services and Ingresses are labeled along with it. The workload's
+// pod selector is immutable, so pod template labels are left untouched —
+// pod-level lookups (logs, metrics) resolve the pods through the workload's
+// own selector only after the primary object is found by its new labels.
+func (d *DeploymentService) AdoptDeployment(ctx context.Context, req *models.AdoptDeploymentRequest, id string) (*models.DeploymentResponse, error) {
+	logger := d.logger.Named("deployment_service")
+	logger.Info("Adopting deployment",
+		zap.String("kind", string(req.Kind)),
+		zap.String("namespace", req.Namespace),
+		zap.String("deployment_id", id))
+
+	if !tenancy.Allowed(ctx, req.Namespace) {
+		return nil, models.NewErrNamespaceForbidden(req.Namespace)
+	}
+
+	if err := d.locks.acquire(id, "adopt"); err != nil {
+		return nil, err
+	}
+	defer d.locks.release(id)
+
+	var (
+		name          string
+		deploymentReq *models.DeploymentRequest
+		err           error
+	)
+	switch req.Kind {
+	case models.DeploymentKindContainer:
+		name, deploymentReq, err = d.adoptContainer(ctx, req, id)
+	case models.DeploymentKindStatefulSet:
+		name, deploymentReq, err = d.adoptStatefulSet(ctx, req, id)
+	default:
+		return nil, fmt.Errorf("adoption is not supported for %s deployments", req.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Secondary objects are only discoverable through the caller's selector;
+	// a name-based adoption labels the primary object alone
+	if req.Selector != "" {
+		d.adoptSecondaries(ctx, req.Namespace, req.Selector, id, name, logger)
+	}
+
+	d.publishEvent(events.EventTypeCreated, id, req.Kind, name, req.Namespace, string(models.DeploymentPhasePending))
+	d.saveRecord(ctx, deploymentReq, id)
+
+	logger.Info("Adopted deployment",
+		zap.String("name", name),
+		zap.String("deployment_id", id))
+
+	return d.GetDeploymentByID(ctx, id)
+}
+
+// adoptContainer labels an existing Kubernetes Deployment and records a spec
+// snapshot derived from its live pod template
+func (d *DeploymentService) adoptContainer(ctx context.Context, req *models.AdoptDeploymentRequest, id string) (string, *models.DeploymentRequest, error) {
+	deployment, err := d.adoptTargetDeployment(ctx, req)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := checkNotManaged(deployment.Labels, req.Namespace, req.Kind); err != nil {
+		return "", nil, err
+	}
+
+	containerSpec := models.ContainerSpec{
+		Container: adoptedContainerConfig(deployment.Spec.Template.Spec, deployment.Spec.Replicas),
+	}
+	annotations, err := adoptionAnnotations(ctx, deployment.CreationTimestamp.Time, containerSpec)
+	if err != nil {
+		return "", nil, err
+	}
+	mergeAdoptionMeta(&deployment.ObjectMeta, id, deployment.Name, annotations)
+
+	if _, err := d.client.AppsV1().Deployments(req.Namespace).Update(ctx, deployment, metav1.UpdateOptions{}); err != nil {
+		return "", nil, fmt.Errorf("failed to label deployment %s: %w", deployment.Name, err)
+	}
+
+	return deployment.Name, &models.DeploymentRequest{
+		Kind:     req.Kind,
+		Metadata: models.Metadata{Name: deployment.Name, Namespace: req.Namespace},
+		Spec:     containerSpec,
+	}, nil
+}
+
+// adoptStatefulSet labels an existing StatefulSet and records a spec snapshot
+// derived from its live pod template
+func (d *DeploymentService) adoptStatefulSet(ctx context.Context, req *models.AdoptDeploymentRequest, id string) (string, *models.DeploymentRequest, error) {
+	statefulSet, err := d.adoptTargetStatefulSet(ctx, req)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := checkNotManaged(statefulSet.Labels, req.Namespace, req.Kind); err != nil {
+		return "", nil, err
+	}
+
+	statefulSetSpec := models.StatefulSetSpec{
+		StatefulSet: models.StatefulSetConfig{
+			ContainerConfig: adoptedContainerConfig(statefulSet.Spec.Template.Spec, statefulSet.Spec.Replicas),
+		},
+	}
+	annotations, err := adoptionAnnotations(ctx, statefulSet.CreationTimestamp.Time, statefulSetSpec)
+	if err != nil {
+		return "", nil, err
+	}
+	mergeAdoptionMeta(&statefulSet.ObjectMeta, id, statefulSet.Name, annotations)
+
+	if _, err := d.client.AppsV1().StatefulSets(req.Namespace).Update(ctx, statefulSet, metav1.UpdateOptions{}); err != nil {
+		return "", nil, fmt.Errorf("failed to label statefulset %s: %w", statefulSet.Name, err)
+	}
+
+	return statefulSet.Name, &models.DeploymentRequest{
+		Kind:     req.Kind,
+		Metadata: models.Metadata{Name: statefulSet.Name, Namespace: req.Namespace},
+		Spec:     statefulSetSpec,
+	}, nil
+}
+
+// adoptTargetDeployment resolves the Deployment named by the request, either
+// directly or as the single match of the caller's selector
+func (d *DeploymentService) adoptTargetDeployment(ctx context.Context, req *models.AdoptDeploymentRequest) (*appsv1.Deployment, error) {
+	if req.Object != "" {
+		deployment, err := d.readClient.AppsV1().Deployments(req.Namespace).Get(ctx, req.Object, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, models.NewErrDeploymentNotFound(req.Object, req.Namespace)
+			}
+			return nil, fmt.Errorf("failed to get deployment %s: %w", req.Object, err)
+		}
+		return deployment, nil
+	}
+
+	list, err := d.readClient.AppsV1().Deployments(req.Namespace).List(ctx, metav1.ListOptions{LabelSelector: req.Selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments for selector %q: %w", req.Selector, err)
+	}
+	if len(list.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(req.Selector, req.Namespace)
+	}
+	if len(list.Items) > 1 {
+		return nil, fmt.Errorf("selector %q matches %d deployments in namespace %s, expected exactly one", req.Selector, len(list.Items), req.Namespace)
+	}
+	return &list.Items[0], nil
+}
+
+// adoptTargetStatefulSet resolves the StatefulSet named by the request, either
+// directly or as the single match of the caller's selector
+func (d *DeploymentService) adoptTargetStatefulSet(ctx context.Context, req *models.AdoptDeploymentRequest) (*appsv1.StatefulSet, error) {
+	if req.Object != "" {
+		statefulSet, err := d.readClient.AppsV1().StatefulSets(req.Namespace).Get(ctx, req.Object, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, models.NewErrDeploymentNotFound(req.Object, req.Namespace)
+			}
+			return nil, fmt.Errorf("failed to get statefulset %s: %w", req.Object, err)
+		}
+		return statefulSet, nil
+	}
+
+	list, err := d.readClient.AppsV1().StatefulSets(req.Namespace).List(ctx, metav1.ListOptions{LabelSelector: req.Selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets for selector %q: %w", req.Selector, err)
+	}
+	if len(list.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(req.Selector, req.Namespace)
+	}
+	if len(list.Items) > 1 {
+		return nil, fmt.Errorf("selector %q matches %d statefulsets in namespace %s, expected exactly one", req.Selector, len(list.Items), req.Namespace)
+	}
+	return &list.Items[0], nil
+}
+
+// adoptSecondaries labels the Services and Ingresses matching the caller's
+// selector so they are cleaned up with the deployment. Failures are logged
+// rather than rolled back: the primary object is already adopted.
+func (d *DeploymentService) adoptSecondaries(ctx context.Context, namespace, selector, id, name string, logger *zap.Logger) {
+	labels := models.BuildDeploymentLabels(id, name)
+
+	services, err := d.readClient.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		logger.Warn("Failed to list services for adoption", zap.String("deployment_id", id), zap.Error(err))
+	} else {
+		for i := range services.Items {
+			service := &services.Items[i]
+			mergeLabels(&service.ObjectMeta, labels)
+			if _, err := d.client.CoreV1().Services(namespace).Update(ctx, service, metav1.UpdateOptions{}); err != nil {
+				logger.Warn("Failed to label adopted service",
+					zap.String("service", service.Name),
+					zap.String("deployment_id", id),
+					zap.Error(err))
+			}
+		}
+	}
+
+	ingresses, err := d.readClient.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		logger.Warn("Failed to list ingresses for adoption", zap.String("deployment_id", id), zap.Error(err))
+		return
+	}
+	for i := range ingresses.Items {
+		ingress := &ingresses.Items[i]
+		mergeLabels(&ingress.ObjectMeta, labels)
+		if _, err := d.client.NetworkingV1().Ingresses(namespace).Update(ctx, ingress, metav1.UpdateOptions{}); err != nil {
+			logger.Warn("Failed to label adopted ingress",
+				zap.String("ingress", ingress.Name),
+				zap.String("deployment_id", id),
+				zap.Error(err))
+		}
+	}
+}
+
+// checkNotManaged rejects adoption of objects already carrying the
+// managed-by label, surfacing the existing deployment ID
+func checkNotManaged(labels map[string]string, namespace string, kind models.DeploymentKind) error {
+	if labels[models.LabelManagedBy] == models.LabelValueManagedBy {
+		return models.NewErrDeploymentAlreadyExists(labels[models.LabelAppID], namespace, kind)
+	}
+	return nil
+}
+
+// adoptedContainerConfig synthesizes the recorded container configuration
+// from a live pod template: image, replicas and ports of the first container
+func adoptedContainerConfig(podSpec corev1.PodSpec, replicas *int32) models.ContainerConfig {
+	cfg := models.ContainerConfig{
+		Replicas: ptr.To(int(ptr.Deref(replicas, 1))),
+	}
+	if len(podSpec.Containers) > 0 {
+		container := podSpec.Containers[0]
+		cfg.Image = container.Image
+		for _, port := range container.Ports {
+			cfg.Ports = append(cfg.Ports, models.PortConfig{
+				ContainerPort: int(port.ContainerPort),
+				Protocol:      string(port.Protocol),
+			})
+		}
+	}
+	return cfg
+}
+
+// adoptionAnnotations builds the timestamp, creator and persisted-spec
+// annotations for an adopted object, keeping its original creation time
+func adoptionAnnotations(ctx context.Context, createdAt time.Time, deploymentSpec interface{}) (map[string]string, error) {
+	annotations := stampCreator(ctx, models.BuildTimestampAnnotations(createdAt, time.Now()))
+	if err := spec.Persist(annotations, deploymentSpec); err != nil {
+		return nil, err
+	}
+	return annotations, nil
+}
+
+// mergeAdoptionMeta applies the management labels and adoption annotations to
+// an object without disturbing labels and annotations it already carries
+func mergeAdoptionMeta(meta *metav1.ObjectMeta, id, name string, annotations map[string]string) {
+	mergeLabels(meta, models.BuildDeploymentLabels(id, name))
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	for key, value := range annotations {
+		meta.Annotations[key] = value
+	}
+}
+
+func mergeLabels(meta *metav1.ObjectMeta, labels map[string]string) {
+	if meta.Labels == nil {
+		meta.Labels = make(map[string]string)
+	}
+	for key, value := range labels {
+		meta.Labels[key] = value
+	}
+}
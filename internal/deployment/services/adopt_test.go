@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestAdoptContainer(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "legacy-web", Namespace: "default",
+				Labels: map[string]string{"team": "payments"},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To(int32(3)),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "web",
+							Image: "nginx:1.25",
+							Ports: []corev1.ContainerPort{{ContainerPort: 8080, Protocol: corev1.ProtocolTCP}},
+						}},
+					},
+				},
+			},
+		},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{
+			Name: "legacy-web", Namespace: "default",
+			Labels: map[string]string{"team": "payments"},
+		}},
+	)
+	service := &DeploymentService{client: client, readClient: client, logger: zap.NewNop()}
+	id := "3f8e6c7a-aaaa-bbbb-cccc-444455556666"
+
+	req := &models.AdoptDeploymentRequest{
+		Kind:      models.DeploymentKindContainer,
+		Namespace: "default",
+		Selector:  "team=payments",
+	}
+	name, deploymentReq, err := service.adoptContainer(context.Background(), req, id)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-web", name)
+
+	// The recorded spec is synthesized from the live pod template
+	spec, ok := deploymentReq.Spec.(models.ContainerSpec)
+	require.True(t, ok)
+	assert.Equal(t, "nginx:1.25", spec.Container.Image)
+	assert.Equal(t, ptr.To(3), spec.Container.Replicas)
+	require.Len(t, spec.Container.Ports, 1)
+	assert.Equal(t, 8080, spec.Container.Ports[0].ContainerPort)
+
+	// The primary object carries the management labels, the original labels
+	// and a persisted spec snapshot
+	live, err := client.AppsV1().Deployments("default").Get(context.Background(), "legacy-web", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, models.LabelValueManagedBy, live.Labels[models.LabelManagedBy])
+	assert.Equal(t, id, live.Labels[models.LabelAppID])
+	assert.Equal(t, "payments", live.Labels["team"])
+	assert.NotEmpty(t, live.Annotations[models.AnnotationSpec])
+
+	// Selector-based adoption labels the matching service as well
+	service.adoptSecondaries(context.Background(), "default", "team=payments", id, name, zap.NewNop())
+	svc, err := client.CoreV1().Services("default").Get(context.Background(), "legacy-web", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, id, svc.Labels[models.LabelAppID])
+
+	// Adopting an already managed workload is rejected
+	_, _, err = service.adoptContainer(context.Background(), req, "other-id")
+	assert.True(t, models.IsAlreadyExistsError(err))
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"k8s.io/utils/ptr"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// Guest architectures the provider can schedule VMs on
+const (
+	archAMD64 = "amd64"
+	archARM64 = "arm64"
+	archS390X = "s390x"
+)
+
+// defaultMachineType returns the machine type used when the spec does not
+// pick one: the q35 PC on amd64, the generic virt machine on arm64, and the
+// channel I/O machine on s390x
+func defaultMachineType(architecture string) string {
+	switch architecture {
+	case archARM64:
+		return "virt"
+	case archS390X:
+		return "s390-ccw-virtio"
+	default:
+		return "pc-q35-rhel9.4.0"
+	}
+}
+
+// applyArchitecture fills the guest architecture from the operator default
+// and checks the combination is buildable: Windows guests and their firmware
+// setup exist on amd64 only.
+func (v *VMService) applyArchitecture(vm *models.VMConfig) error {
+	if vm.Architecture == "" {
+		vm.Architecture = v.shape.DefaultArchitecture
+	}
+	if vm.Architecture == "" {
+		vm.Architecture = archAMD64
+	}
+	if isWindowsGuest(vm.Os) && vm.Architecture != archAMD64 {
+		return models.NewErrInvalidVMShape("Windows guests are only supported on amd64")
+	}
+	return nil
+}
+
+// applyVMArchitecture stamps the architecture and machine type onto a built
+// VirtualMachine. amd64 keeps the q35 defaults from the base manifest;
+// arm64 boots through UEFI and has no SMM, and s390x has neither ACPI nor
+// SMM, so their x86 firmware features are dropped.
+func applyVMArchitecture(vm *kubevirtv1.VirtualMachine, vmConfig *models.VMConfig) {
+	architecture := vmConfig.Architecture
+	if architecture == "" {
+		architecture = archAMD64
+	}
+
+	spec := &vm.Spec.Template.Spec
+	spec.Architecture = architecture
+
+	machineType := vmConfig.MachineType
+	if machineType == "" {
+		machineType = defaultMachineType(architecture)
+	}
+	spec.Domain.Machine = &kubevirtv1.Machine{Type: machineType}
+
+	switch architecture {
+	case archARM64:
+		spec.Domain.Features = &kubevirtv1.Features{ACPI: kubevirtv1.FeatureState{}}
+		spec.Domain.Firmware = &kubevirtv1.Firmware{
+			Bootloader: &kubevirtv1.Bootloader{
+				EFI: &kubevirtv1.EFI{SecureBoot: ptr.To(false)},
+			},
+		}
+	case archS390X:
+		spec.Domain.Features = nil
+	}
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestApplyArchitecture(t *testing.T) {
+	service := &VMService{}
+
+	// Without an operator default the architecture falls back to amd64
+	vm := &models.VMConfig{Os: "fedora"}
+	require.NoError(t, service.applyArchitecture(vm))
+	assert.Equal(t, "amd64", vm.Architecture)
+
+	// The operator default fills unset specs but does not override them
+	configured := &VMService{shape: config.VMShapeConfig{DefaultArchitecture: "arm64"}}
+	vm = &models.VMConfig{Os: "fedora"}
+	require.NoError(t, configured.applyArchitecture(vm))
+	assert.Equal(t, "arm64", vm.Architecture)
+
+	vm = &models.VMConfig{Os: "fedora", Architecture: "s390x"}
+	require.NoError(t, configured.applyArchitecture(vm))
+	assert.Equal(t, "s390x", vm.Architecture)
+
+	// Windows firmware setup exists on amd64 only
+	err := service.applyArchitecture(&models.VMConfig{Os: "windows2022", Architecture: "arm64"})
+	require.True(t, models.IsInvalidVMShapeError(err))
+	assert.Contains(t, err.Error(), "only supported on amd64")
+}
+
+func TestBuildVirtualMachineArchitecture(t *testing.T) {
+	// amd64 keeps the q35 defaults
+	vm := buildVirtualMachine("devbox", "default", &models.VMConfig{Os: "fedora", Ram: 2, Cpu: 1},
+		map[string]string{}, map[string]string{}, "", "")
+	assert.Equal(t, "amd64", vm.Spec.Template.Spec.Architecture)
+	assert.Equal(t, "pc-q35-rhel9.4.0", vm.Spec.Template.Spec.Domain.Machine.Type)
+
+	// arm64 boots through UEFI on the virt machine, without SMM
+	vm = buildVirtualMachine("devbox", "default",
+		&models.VMConfig{Os: "fedora", Ram: 2, Cpu: 1, Architecture: "arm64"},
+		map[string]string{}, map[string]string{}, "", "")
+	spec := vm.Spec.Template.Spec
+	assert.Equal(t, "arm64", spec.Architecture)
+	assert.Equal(t, "virt", spec.Domain.Machine.Type)
+	require.NotNil(t, spec.Domain.Firmware)
+	assert.NotNil(t, spec.Domain.Firmware.Bootloader.EFI)
+	assert.Nil(t, spec.Domain.Features.SMM)
+
+	// An explicit machine type wins over the per-architecture default
+	vm = buildVirtualMachine("devbox", "default",
+		&models.VMConfig{Os: "fedora", Ram: 2, Cpu: 1, Architecture: "s390x", MachineType: "s390-ccw-virtio-rhel9.4.0"},
+		map[string]string{}, map[string]string{}, "", "")
+	assert.Equal(t, "s390x", vm.Spec.Template.Spec.Architecture)
+	assert.Equal(t, "s390-ccw-virtio-rhel9.4.0", vm.Spec.Template.Spec.Domain.Machine.Type)
+	assert.Nil(t, vm.Spec.Template.Spec.Domain.Features)
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+// InformerCache serves Deployment and VirtualMachine reads from shared
+// informer stores instead of live LIST calls. The informers watch only
+// resources carrying the managed-by label, so the stores stay small and
+// watch events keep them fresh. Readers fall back to live LIST calls until
+// the stores have synced, so a cold or broken cache never hides resources.
+type InformerCache struct {
+	factory           informers.SharedInformerFactory
+	deployments       appslisters.DeploymentLister
+	deploymentsSynced toolscache.InformerSynced
+	vmInformer        toolscache.SharedIndexInformer
+	logger            *zap.Logger
+}
+
+// NewInformerCache builds the informer cache on top of the read clientset.
+// The VM informer is only created when KubeVirt is available at startup;
+// without it VM reads keep using live LIST calls.
+func NewInformerCache(readClient kubernetes.Interface, virtClient kubecli.KubevirtClient, resync time.Duration, includeVMs bool, logger *zap.Logger) *InformerCache {
+	factory := informers.NewSharedInformerFactoryWithOptions(readClient, resync,
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = models.BuildManagedResourceSelector()
+		}))
+	deploymentInformer := factory.Apps().V1().Deployments()
+
+	ic := &InformerCache{
+		factory:           factory,
+		deployments:       deploymentInformer.Lister(),
+		deploymentsSynced: deploymentInformer.Informer().HasSynced,
+		logger:            logger.Named("informer_cache"),
+	}
+
+	if includeVMs {
+		// KubeVirt ships no generated informers for its clientset, so the VM
+		// informer is assembled from the typed client's List and Watch
+		listWatch := &toolscache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				opts.LabelSelector = models.BuildManagedResourceSelector()
+				return virtClient.VirtualMachine(metav1.NamespaceAll).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				opts.LabelSelector = models.BuildManagedResourceSelector()
+				return virtClient.VirtualMachine(metav1.NamespaceAll).Watch(context.Background(), opts)
+			},
+		}
+		ic.vmInformer = toolscache.NewSharedIndexInformer(listWatch, &kubevirtv1.VirtualMachine{}, resync,
+			toolscache.Indexers{toolscache.NamespaceIndex: toolscache.MetaNamespaceIndexFunc})
+	}
+
+	return ic
+}
+
+// Start runs the informers until the context is cancelled. It returns
+// immediately; readers serve live LIST calls until the stores sync.
+func (ic *InformerCache) Start(ctx context.Context) {
+	ic.factory.Start(ctx.Done())
+	if ic.vmInformer != nil {
+		go ic.vmInformer.Run(ctx.Done())
+	}
+	ic.logger.Info("Informer cache started", zap.Bool("vms", ic.vmInformer != nil))
+}
+
+// Deployments returns the cached Deployments in namespace (all namespaces
+// when empty) matching the label selector, sorted by namespace and name for
+// stable pagination. ok is false until the informer has synced; callers then
+// fall back to a live LIST.
+func (ic *InformerCache) Deployments(namespace, selector string) ([]appsv1.Deployment, bool) {
+	if ic == nil || !ic.deploymentsSynced() {
+		return nil, false
+	}
+
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		ic.logger.Warn("Failed to parse label selector for cached read", zap.String("selector", selector), zap.Error(err))
+		return nil, false
+	}
+
+	var cached []*appsv1.Deployment
+	if namespace == "" {
+		cached, err = ic.deployments.List(parsed)
+	} else {
+		cached, err = ic.deployments.Deployments(namespace).List(parsed)
+	}
+	if err != nil {
+		ic.logger.Warn("Cached deployment read failed", zap.Error(err))
+		return nil, false
+	}
+
+	// Copy out of the store: informer-owned objects must not be mutated
+	items := make([]appsv1.Deployment, 0, len(cached))
+	for _, deployment := range cached {
+		items = append(items, *deployment)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+	return items, true
+}
+
+// VirtualMachines returns the cached VirtualMachines in namespace (all
+// namespaces when empty) matching the label selector, sorted by namespace
+// and name. ok is false when the VM informer is absent or not yet synced.
+func (ic *InformerCache) VirtualMachines(namespace, selector string) ([]kubevirtv1.VirtualMachine, bool) {
+	if ic == nil || ic.vmInformer == nil || !ic.vmInformer.HasSynced() {
+		return nil, false
+	}
+
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		ic.logger.Warn("Failed to parse label selector for cached read", zap.String("selector", selector), zap.Error(err))
+		return nil, false
+	}
+
+	var objects []interface{}
+	if namespace == "" {
+		objects = ic.vmInformer.GetIndexer().List()
+	} else {
+		objects, err = ic.vmInformer.GetIndexer().ByIndex(toolscache.NamespaceIndex, namespace)
+		if err != nil {
+			ic.logger.Warn("Cached virtual machine read failed", zap.Error(err))
+			return nil, false
+		}
+	}
+
+	var items []kubevirtv1.VirtualMachine
+	for _, object := range objects {
+		vm, ok := object.(*kubevirtv1.VirtualMachine)
+		if !ok {
+			continue
+		}
+		if parsed.Matches(labels.Set(vm.Labels)) {
+			items = append(items, *vm)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+	return items, true
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	toolscache "k8s.io/client-go/tools/cache"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func managedDeployment(namespace, name, id string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    models.BuildDeploymentLabels(id, name),
+		},
+	}
+}
+
+func TestNilInformerCacheFallsThrough(t *testing.T) {
+	var cache *InformerCache
+
+	_, ok := cache.Deployments("", models.BuildManagedResourceSelector())
+	assert.False(t, ok)
+
+	_, ok = cache.VirtualMachines("", models.BuildManagedResourceSelector())
+	assert.False(t, ok)
+}
+
+func TestInformerCacheDeployments(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		managedDeployment("team-b", "web", "id-1"),
+		managedDeployment("team-a", "api", "id-2"),
+	)
+
+	cache := NewInformerCache(client, nil, time.Minute, false, zap.NewNop())
+
+	// Before the informer starts, reads report a cache miss
+	_, ok := cache.Deployments("", models.BuildManagedResourceSelector())
+	assert.False(t, ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cache.Start(ctx)
+	require.True(t, toolscache.WaitForCacheSync(ctx.Done(), cache.deploymentsSynced))
+
+	// Cross-namespace reads come back sorted by namespace and name
+	items, ok := cache.Deployments("", models.BuildManagedResourceSelector())
+	require.True(t, ok)
+	require.Len(t, items, 2)
+	assert.Equal(t, "team-a", items[0].Namespace)
+	assert.Equal(t, "team-b", items[1].Namespace)
+
+	// Namespace and label selector filters apply
+	items, ok = cache.Deployments("team-b", models.BuildManagedResourceSelector())
+	require.True(t, ok)
+	require.Len(t, items, 1)
+	assert.Equal(t, "web", items[0].Name)
+
+	items, ok = cache.Deployments("", models.BuildDeploymentSelector("id-2"))
+	require.True(t, ok)
+	require.Len(t, items, 1)
+	assert.Equal(t, "api", items[0].Name)
+
+	// The VM informer was not created, so VM reads keep falling through
+	_, ok = cache.VirtualMachines("", models.BuildManagedResourceSelector())
+	assert.False(t, ok)
+}
+
+func TestInformerCacheVirtualMachines(t *testing.T) {
+	vms := []kubevirtv1.VirtualMachine{
+		{ObjectMeta: metav1.ObjectMeta{
+			Name:      "vm-b",
+			Namespace: "team-b",
+			Labels:    models.BuildDeploymentLabels("vm-id-1", "vm-b"),
+		}},
+		{ObjectMeta: metav1.ObjectMeta{
+			Name:      "vm-a",
+			Namespace: "team-a",
+			Labels:    models.BuildDeploymentLabels("vm-id-2", "vm-a"),
+		}},
+	}
+	listWatch := &toolscache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return &kubevirtv1.VirtualMachineList{Items: vms}, nil
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return watch.NewFake(), nil
+		},
+	}
+	cache := &InformerCache{
+		vmInformer: toolscache.NewSharedIndexInformer(listWatch, &kubevirtv1.VirtualMachine{}, time.Minute,
+			toolscache.Indexers{toolscache.NamespaceIndex: toolscache.MetaNamespaceIndexFunc}),
+		logger: zap.NewNop(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go cache.vmInformer.Run(ctx.Done())
+	require.True(t, toolscache.WaitForCacheSync(ctx.Done(), cache.vmInformer.HasSynced))
+
+	items, ok := cache.VirtualMachines("", models.BuildManagedResourceSelector())
+	require.True(t, ok)
+	require.Len(t, items, 2)
+	assert.Equal(t, "vm-a", items[0].Name)
+	assert.Equal(t, "vm-b", items[1].Name)
+
+	items, ok = cache.VirtualMachines("team-b", models.BuildDeploymentSelector("vm-id-1"))
+	require.True(t, ok)
+	require.Len(t, items, 1)
+	assert.Equal(t, "vm-b", items[0].Name)
+
+	items, ok = cache.VirtualMachines("team-b", models.BuildDeploymentSelector("vm-id-2"))
+	require.True(t, ok)
+	assert.Empty(t, items)
+}
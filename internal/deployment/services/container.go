@@ -3,34 +3,55 @@ package services
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
+	"time"
 
+	"github.com/dcm-project/k8s-service-provider/internal/config"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/spec"
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/ptr"
 )
 
-// ContainerService handles container deployment operations
+// ContainerService handles container deployment operations.
+// Writes go through client; read-heavy operations (get/list/watch/logs)
+// use readClient so they are throttled on a separate budget. When cache is
+// set, get and list reads are served from its informer store once synced.
 type ContainerService struct {
-	client kubernetes.Interface
-	logger *zap.Logger
+	client             kubernetes.Interface
+	readClient         kubernetes.Interface
+	cache              *InformerCache
+	workload           config.WorkloadConfig
+	defaultTolerations []models.TolerationConfig
+	logger             *zap.Logger
 }
 
 // NewContainerService creates a new container service instance
-func NewContainerService(client kubernetes.Interface, logger *zap.Logger) *ContainerService {
+func NewContainerService(client, readClient kubernetes.Interface, workload config.WorkloadConfig, logger *zap.Logger) *ContainerService {
 	return &ContainerService{
-		client: client,
-		logger: logger,
+		client:     client,
+		readClient: readClient,
+		workload:   workload,
+		logger:     logger,
 	}
 }
 
 // CreateContainer creates a new container deployment
 func (c *ContainerService) CreateContainer(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	now := time.Now()
+	return c.createContainer(ctx, req, id, stampCreator(ctx, models.BuildTimestampAnnotations(now, now)))
+}
+
+// createContainer creates the container deployment resources with the provided timestamp annotations
+func (c *ContainerService) createContainer(ctx context.Context, req *models.DeploymentRequest, id string, annotations map[string]string) error {
 	logger := c.logger.Named("container_service").With(zap.String("deployment_id", id))
 	logger.Info("Starting container deployment")
 
@@ -39,72 +60,262 @@ func (c *ContainerService) CreateContainer(ctx context.Context, req *models.Depl
 		return fmt.Errorf("invalid container spec format")
 	}
 
+	if err := validateContainerSpec(&containerSpec); err != nil {
+		return err
+	}
+
+	if err := applyResourcePolicy(c.workload, "spec.container.resources", &containerSpec.Container); err != nil {
+		return err
+	}
+
+	// Operator policy: default tolerations for dedicated tenant node pools
+	containerSpec.Container.Placement = mergeTolerationPolicy(containerSpec.Container.Placement, c.defaultTolerations)
+
+	if err := applySecurityPolicy(c.workload, "spec.container.security", &containerSpec.Container); err != nil {
+		return err
+	}
+
+	if err := c.ensureClusterResources(ctx, containerSpec.Container.Resources); err != nil {
+		return err
+	}
+
+	if err := ensureIngressSupported(ctx, c.readClient, logger, "spec.container.ingress", containerSpec.Container.Ingress, c.workload.StrictSpec); err != nil {
+		return err
+	}
+
 	namespace := req.Metadata.Namespace
 	if namespace == "" {
 		namespace = "default"
 	}
 
+	// Fail fast when the namespace quota has no headroom for this deployment
+	replicas := ptr.Deref(containerSpec.Container.Replicas, 1)
+	if err := checkNamespaceQuota(ctx, c.readClient, namespace, containerQuotaRequests(&containerSpec.Container, replicas)); err != nil {
+		return err
+	}
+
 	// Create namespace if it doesn't exist
 	if err := c.ensureNamespace(ctx, namespace); err != nil {
 		return fmt.Errorf("failed to ensure namespace: %w", err)
 	}
 
+	// Persist ops notes and tags alongside the timestamps
+	models.ApplyOpsAnnotations(annotations, req.Metadata.Notes, req.Metadata.Tags)
+	models.MergeUserAnnotations(annotations, req.Metadata.Annotations)
+
+	// Persist the versioned spec so schema migrations can upgrade it later
+	if err := spec.Persist(annotations, req.Spec); err != nil {
+		return fmt.Errorf("failed to persist spec: %w", err)
+	}
+
+	// Materialize inline registry credentials before the pods reference them
+	if containerSpec.Container.RegistryCredentials != nil {
+		if err := c.ensureRegistrySecret(ctx, namespace, req.Metadata.Name, id, containerSpec.Container.RegistryCredentials); err != nil {
+			return err
+		}
+	}
+
 	// Create deployment
-	if err := c.createDeployment(ctx, req.Metadata.Name, namespace, &containerSpec, req.Metadata.Labels, id); err != nil {
+	created, err := c.createDeployment(ctx, req.Metadata.Name, namespace, &containerSpec, req.Metadata.Labels, annotations, id)
+	if err != nil {
 		return fmt.Errorf("failed to create deployment: %w", err)
 	}
 
+	// Secondary objects are owned by the Deployment so Kubernetes garbage
+	// collection deletes them in cascade with it
+	owner := ownerReference(appsv1.SchemeGroupVersion.String(), "Deployment", created.Name, created.UID)
+
+	// The registry Secret is rendered before the Deployment exists, so
+	// ownership is established right after it does
+	if containerSpec.Container.RegistryCredentials != nil {
+		c.adoptRegistrySecret(ctx, namespace, req.Metadata.Name, id, owner, logger)
+	}
+
 	// Create service if ports are specified
 	if len(containerSpec.Container.Ports) > 0 {
-		if err := c.createService(ctx, req.Metadata.Name, namespace, &containerSpec, req.Metadata.Labels, id); err != nil {
+		if err := c.createService(ctx, req.Metadata.Name, namespace, &containerSpec, req.Metadata.Labels, annotations, id, owner); err != nil {
 			return fmt.Errorf("failed to create service: %w", err)
 		}
 	}
 
+	// Create ingress if requested
+	if containerSpec.Container.Ingress != nil {
+		if err := c.createIngress(ctx, req.Metadata.Name, namespace, &containerSpec, req.Metadata.Labels, annotations, id, owner); err != nil {
+			return fmt.Errorf("failed to create ingress: %w", err)
+		}
+	}
+
 	logger.Info("Successfully created container deployment")
 	return nil
 }
 
+// DryRunContainer validates a container deployment by server-side dry-running
+// the generated objects, returning the rendered manifests without persisting
+// anything. The target namespace must already exist.
+func (c *ContainerService) DryRunContainer(ctx context.Context, req *models.DeploymentRequest, id string) ([]interface{}, error) {
+	logger := c.logger.Named("container_service").With(zap.String("deployment_id", id))
+
+	containerSpec, ok := req.Spec.(models.ContainerSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid container spec format")
+	}
+
+	if err := validateContainerSpec(&containerSpec); err != nil {
+		return nil, err
+	}
+
+	if err := applyResourcePolicy(c.workload, "spec.container.resources", &containerSpec.Container); err != nil {
+		return nil, err
+	}
+
+	// Operator policy: default tolerations for dedicated tenant node pools
+	containerSpec.Container.Placement = mergeTolerationPolicy(containerSpec.Container.Placement, c.defaultTolerations)
+
+	if err := applySecurityPolicy(c.workload, "spec.container.security", &containerSpec.Container); err != nil {
+		return nil, err
+	}
+
+	if err := c.ensureClusterResources(ctx, containerSpec.Container.Resources); err != nil {
+		return nil, err
+	}
+
+	if err := ensureIngressSupported(ctx, c.readClient, logger, "spec.container.ingress", containerSpec.Container.Ingress, c.workload.StrictSpec); err != nil {
+		return nil, err
+	}
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	now := time.Now()
+	annotations := models.BuildTimestampAnnotations(now, now)
+	dryRun := metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}
+
+	deployment := buildContainerDeployment(req.Metadata.Name, &containerSpec, req.Metadata.Labels, annotations, id, c.workload.DisableSATokenAutomount)
+	rendered, err := c.client.AppsV1().Deployments(namespace).Create(ctx, deployment, dryRun)
+	if err != nil {
+		return nil, fmt.Errorf("deployment dry-run failed: %w", err)
+	}
+	manifests := []interface{}{rendered}
+
+	if len(containerSpec.Container.Ports) > 0 {
+		service := buildContainerService(req.Metadata.Name, &containerSpec, req.Metadata.Labels, annotations, id)
+		renderedService, err := c.client.CoreV1().Services(namespace).Create(ctx, service, dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("service dry-run failed: %w", err)
+		}
+		manifests = append(manifests, renderedService)
+	}
+
+	if containerSpec.Container.Ingress != nil {
+		ingress := buildContainerIngress(req.Metadata.Name, &containerSpec, req.Metadata.Labels, annotations, id)
+		renderedIngress, err := c.client.NetworkingV1().Ingresses(namespace).Create(ctx, ingress, dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("ingress dry-run failed: %w", err)
+		}
+		manifests = append(manifests, renderedIngress)
+	}
+
+	logger.Info("Dry-run validated container deployment")
+	return manifests, nil
+}
+
 // GetContainer retrieves container deployment information searching across all namespaces
 func (c *ContainerService) GetContainer(ctx context.Context, id string) (*models.DeploymentResponse, error) {
 	logger := c.logger.Named("container_service").With(zap.String("deployment_id", id))
 
-	// Search across all namespaces using label selector
-	deployments, err := c.client.AppsV1().Deployments("").List(ctx, metav1.ListOptions{
-		LabelSelector: models.BuildDeploymentSelector(id),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get deployment: %w", err)
+	// Search across all namespaces using label selector, served from the
+	// informer cache once it has synced
+	items, ok := c.cache.Deployments("", models.BuildDeploymentSelector(id))
+	if !ok {
+		deployments, err := c.readClient.AppsV1().Deployments("").List(ctx, metav1.ListOptions{
+			LabelSelector: models.BuildDeploymentSelector(id),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get deployment: %w", err)
+		}
+		items = deployments.Items
 	}
 
-	if len(deployments.Items) == 0 {
+	if len(items) == 0 {
 		return nil, models.NewErrDeploymentNotFound(id)
 	}
 
-	deployment := deployments.Items[0]
+	deployment := items[0]
+	createdAt, updatedAt := models.TimestampsFromAnnotations(deployment.Annotations, deployment.CreationTimestamp.Time)
 
 	// Convert Kubernetes deployment to our response model
 	response := &models.DeploymentResponse{
 		ID:   id,
 		Kind: models.DeploymentKindContainer,
 		Metadata: models.Metadata{
-			Name:      deployment.Name,
-			Namespace: deployment.Namespace,
-			Labels:    deployment.Labels,
+			Name:        deployment.Name,
+			Namespace:   deployment.Namespace,
+			Labels:      deployment.Labels,
+			Annotations: models.UserAnnotations(deployment.Annotations),
+			Notes:       deployment.Annotations[models.AnnotationNotes],
+			Tags:        models.TagsFromAnnotations(deployment.Annotations),
 		},
 		Status: models.DeploymentStatus{
 			Phase:         c.getDeploymentPhase(&deployment),
 			ReadyReplicas: int(deployment.Status.ReadyReplicas),
+			Conditions:    deploymentConditions(&deployment),
 		},
-		CreatedAt: deployment.CreationTimestamp.Time,
-		UpdatedAt: deployment.CreationTimestamp.Time,
+		CreatedBy: models.CreatorFromAnnotations(deployment.Annotations),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+
+	// Surface the persisted spec, migrated to the current schema version
+	if persisted, err := spec.FromAnnotations(deployment.Annotations); err != nil {
+		logger.Warn("Failed to read persisted spec", zap.Error(err))
+	} else if persisted != nil {
+		response.Spec = persisted
+	}
+
+	// Report how the Service exposes the deployment, if one exists
+	services, err := c.readClient.CoreV1().Services(deployment.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		logger.Warn("Failed to list services for status", zap.Error(err))
+	} else if len(services.Items) > 0 {
+		response.Status.Service = buildServiceStatus(&services.Items[0])
 	}
 
 	logger.Info("Successfully retrieved container deployment")
 	return response, nil
 }
 
-// UpdateContainer updates an existing container deployment
+// buildServiceStatus summarizes how a Service exposes a deployment: its type,
+// the allocated node ports, and the load balancer address once assigned
+func buildServiceStatus(service *corev1.Service) *models.ServiceStatus {
+	status := &models.ServiceStatus{Type: string(service.Spec.Type)}
+
+	for _, port := range service.Spec.Ports {
+		if port.NodePort != 0 {
+			status.NodePorts = append(status.NodePorts, port.NodePort)
+		}
+	}
+
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			status.LoadBalancerIP = ingress.IP
+			break
+		}
+		if ingress.Hostname != "" && status.LoadBalancerIP == "" {
+			status.LoadBalancerIP = ingress.Hostname
+		}
+	}
+
+	return status
+}
+
+// UpdateContainer updates an existing container deployment. The Deployment is
+// updated in place so Kubernetes rolls pods over without downtime; only a
+// rename falls back to delete-and-recreate, because the name is part of the
+// immutable label selector.
 func (c *ContainerService) UpdateContainer(ctx context.Context, req *models.DeploymentRequest, id string) error {
 	logger := c.logger.Named("container_service").With(zap.String("deployment_id", id))
 	logger.Info("Updating container deployment")
@@ -114,12 +325,180 @@ func (c *ContainerService) UpdateContainer(ctx context.Context, req *models.Depl
 		namespace = "default"
 	}
 
-	// For simplicity, we'll delete and recreate the deployment
+	// Preserve the original creation time and creator across the update
+	createdAt := time.Now()
+	var creator *models.Creator
+	if existing, err := c.GetContainer(ctx, id); err == nil {
+		createdAt = existing.CreatedAt
+		creator = existing.CreatedBy
+	}
+	annotations := preserveCreator(models.BuildTimestampAnnotations(createdAt, time.Now()), creator)
+
+	deployments, err := c.readClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find deployment: %w", err)
+	}
+
+	if len(deployments.Items) > 0 && deployments.Items[0].Labels[models.LabelApp] == req.Metadata.Name {
+		return c.rollContainer(ctx, req, id, namespace, annotations)
+	}
+
+	if len(deployments.Items) > 0 {
+		logger.Info("Deployment name changed, falling back to delete-and-recreate")
+	}
 	if err := c.DeleteContainer(ctx, id, namespace); err != nil {
 		logger.Warn("Failed to delete existing deployment during update", zap.Error(err))
 	}
 
-	return c.CreateContainer(ctx, req, id)
+	return c.createContainer(ctx, req, id, annotations)
+}
+
+// rollContainer applies the updated spec to the existing Deployment so
+// Kubernetes performs a rolling update, then reconciles the Service and
+// Ingress around it
+func (c *ContainerService) rollContainer(ctx context.Context, req *models.DeploymentRequest, id, namespace string, annotations map[string]string) error {
+	logger := c.logger.Named("container_service").With(zap.String("deployment_id", id))
+
+	containerSpec, ok := req.Spec.(models.ContainerSpec)
+	if !ok {
+		return fmt.Errorf("invalid container spec format")
+	}
+
+	if err := validateContainerSpec(&containerSpec); err != nil {
+		return err
+	}
+
+	if err := applyResourcePolicy(c.workload, "spec.container.resources", &containerSpec.Container); err != nil {
+		return err
+	}
+
+	// Operator policy: default tolerations for dedicated tenant node pools
+	containerSpec.Container.Placement = mergeTolerationPolicy(containerSpec.Container.Placement, c.defaultTolerations)
+
+	if err := applySecurityPolicy(c.workload, "spec.container.security", &containerSpec.Container); err != nil {
+		return err
+	}
+
+	if err := c.ensureClusterResources(ctx, containerSpec.Container.Resources); err != nil {
+		return err
+	}
+
+	if err := ensureIngressSupported(ctx, c.readClient, logger, "spec.container.ingress", containerSpec.Container.Ingress, c.workload.StrictSpec); err != nil {
+		return err
+	}
+
+	// Persist ops notes and tags alongside the timestamps
+	models.ApplyOpsAnnotations(annotations, req.Metadata.Notes, req.Metadata.Tags)
+	models.MergeUserAnnotations(annotations, req.Metadata.Annotations)
+
+	// Persist the versioned spec so schema migrations can upgrade it later
+	if err := spec.Persist(annotations, req.Spec); err != nil {
+		return fmt.Errorf("failed to persist spec: %w", err)
+	}
+
+	// Roll rotated registry credentials out with the update
+	if containerSpec.Container.RegistryCredentials != nil {
+		if err := c.ensureRegistrySecret(ctx, namespace, req.Metadata.Name, id, containerSpec.Container.RegistryCredentials); err != nil {
+			return err
+		}
+	}
+
+	deployment := buildContainerDeployment(req.Metadata.Name, &containerSpec, req.Metadata.Labels, annotations, id, c.workload.DisableSATokenAutomount)
+	updated, err := c.client.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update deployment: %w", err)
+	}
+
+	// Reconciled objects carry the Deployment as their owner; updates adopt
+	// objects created before ownership was introduced
+	owner := ownerReference(appsv1.SchemeGroupVersion.String(), "Deployment", updated.Name, updated.UID)
+
+	if err := c.reconcileService(ctx, req.Metadata.Name, namespace, &containerSpec, req.Metadata.Labels, annotations, id, owner); err != nil {
+		return err
+	}
+
+	if err := c.reconcileIngress(ctx, req.Metadata.Name, namespace, &containerSpec, req.Metadata.Labels, annotations, id, owner); err != nil {
+		return err
+	}
+
+	logger.Info("Successfully rolled container deployment")
+	return nil
+}
+
+// reconcileService creates, updates or removes the deployment's Service to
+// match the desired spec. Updates carry the allocated cluster IP over because
+// the field is immutable.
+func (c *ContainerService) reconcileService(ctx context.Context, name, namespace string, spec *models.ContainerSpec, labels, annotations map[string]string, id string, owner metav1.OwnerReference) error {
+	existing, err := c.client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list services: %w", err)
+	}
+
+	if len(spec.Container.Ports) == 0 {
+		for _, service := range existing.Items {
+			if err := c.client.CoreV1().Services(namespace).Delete(ctx, service.Name, metav1.DeleteOptions{}); err != nil {
+				return fmt.Errorf("failed to delete service: %w", err)
+			}
+		}
+		return nil
+	}
+
+	service := buildContainerService(name, spec, labels, annotations, id)
+	service.OwnerReferences = []metav1.OwnerReference{owner}
+	if len(existing.Items) == 0 {
+		if _, err := c.client.CoreV1().Services(namespace).Create(ctx, service, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create service: %w", err)
+		}
+		return nil
+	}
+
+	current := existing.Items[0]
+	service.ResourceVersion = current.ResourceVersion
+	service.Spec.ClusterIP = current.Spec.ClusterIP
+	service.Spec.ClusterIPs = current.Spec.ClusterIPs
+	if _, err := c.client.CoreV1().Services(namespace).Update(ctx, service, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update service: %w", err)
+	}
+	return nil
+}
+
+// reconcileIngress creates, updates or removes the deployment's Ingress to
+// match the desired spec
+func (c *ContainerService) reconcileIngress(ctx context.Context, name, namespace string, spec *models.ContainerSpec, labels, annotations map[string]string, id string, owner metav1.OwnerReference) error {
+	existing, err := c.client.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list ingresses: %w", err)
+	}
+
+	if spec.Container.Ingress == nil {
+		for _, ingress := range existing.Items {
+			if err := c.client.NetworkingV1().Ingresses(namespace).Delete(ctx, ingress.Name, metav1.DeleteOptions{}); err != nil {
+				return fmt.Errorf("failed to delete ingress: %w", err)
+			}
+		}
+		return nil
+	}
+
+	ingress := buildContainerIngress(name, spec, labels, annotations, id)
+	ingress.OwnerReferences = []metav1.OwnerReference{owner}
+	if len(existing.Items) == 0 {
+		if _, err := c.client.NetworkingV1().Ingresses(namespace).Create(ctx, ingress, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create ingress: %w", err)
+		}
+		return nil
+	}
+
+	ingress.ResourceVersion = existing.Items[0].ResourceVersion
+	if _, err := c.client.NetworkingV1().Ingresses(namespace).Update(ctx, ingress, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update ingress: %w", err)
+	}
+	return nil
 }
 
 // DeleteContainer deletes a container deployment
@@ -131,8 +510,12 @@ func (c *ContainerService) DeleteContainer(ctx context.Context, id, namespace st
 		namespace = "default"
 	}
 
-	// Delete deployment
-	err := c.client.AppsV1().Deployments(namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+	// Delete the deployment; garbage collection cascades to the owned
+	// Service and Ingress
+	propagation := metav1.DeletePropagationBackground
+	err := c.client.AppsV1().Deployments(namespace).DeleteCollection(ctx, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	}, metav1.ListOptions{
 		LabelSelector: models.BuildDeploymentSelector(id),
 	})
 	if err != nil {
@@ -140,7 +523,8 @@ func (c *ContainerService) DeleteContainer(ctx context.Context, id, namespace st
 		return fmt.Errorf("failed to delete deployment: %w", err)
 	}
 
-	// Delete services
+	// Sweep any remaining services explicitly, a backstop for objects
+	// created before ownership was introduced
 	services, err := c.client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: models.BuildDeploymentSelector(id),
 	})
@@ -155,25 +539,71 @@ func (c *ContainerService) DeleteContainer(ctx context.Context, id, namespace st
 		}
 	}
 
+	// Sweep any remaining ingresses explicitly, a backstop for objects
+	// created before ownership was introduced
+	ingresses, err := c.client.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		logger.Warn("Failed to list ingresses for deletion", zap.Error(err))
+	} else {
+		for _, ingress := range ingresses.Items {
+			err = c.client.NetworkingV1().Ingresses(namespace).Delete(ctx, ingress.Name, metav1.DeleteOptions{})
+			if err != nil {
+				logger.Warn("Failed to delete ingress", zap.String("ingress", ingress.Name), zap.Error(err))
+			}
+		}
+	}
+
 	logger.Info("Successfully deleted container deployment")
 	return nil
 }
 
+// PatchContainerAnnotations applies a JSON merge patch to the annotations of
+// the deployment's primary Kubernetes object, leaving its spec untouched
+func (c *ContainerService) PatchContainerAnnotations(ctx context.Context, id, namespace string, annotations map[string]interface{}) error {
+	deployments, err := c.readClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find deployment: %w", err)
+	}
+	if len(deployments.Items) == 0 {
+		return models.NewErrDeploymentNotFound(id)
+	}
+
+	patch, err := marshalAnnotationsPatch(annotations)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.AppsV1().Deployments(namespace).Patch(ctx, deployments.Items[0].Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch deployment annotations: %w", err)
+	}
+	return nil
+}
+
 // ListContainers lists all container deployments
 func (c *ContainerService) ListContainers(ctx context.Context, namespace string, limit, offset int) ([]models.DeploymentResponse, error) {
 	logger := c.logger.Named("container_service")
 
 	// Use empty string to search all namespaces if namespace is not specified
-	// Filter only resources managed by this service
-	deployments, err := c.client.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: models.BuildManagedResourceSelector(),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	// Filter only resources managed by this service, served from the informer
+	// cache once it has synced
+	items, ok := c.cache.Deployments(namespace, models.BuildManagedResourceSelector())
+	if !ok {
+		deployments, err := c.readClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: models.BuildManagedResourceSelector(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list deployments: %w", err)
+		}
+		items = deployments.Items
 	}
 
 	var responses []models.DeploymentResponse
-	for i, deployment := range deployments.Items {
+	for i, deployment := range items {
 		if i < offset {
 			continue
 		}
@@ -181,20 +611,25 @@ func (c *ContainerService) ListContainers(ctx context.Context, namespace string,
 			break
 		}
 
+		createdAt, updatedAt := models.TimestampsFromAnnotations(deployment.Annotations, deployment.CreationTimestamp.Time)
 		response := models.DeploymentResponse{
 			ID:   deployment.Labels[models.LabelAppID],
 			Kind: models.DeploymentKindContainer,
 			Metadata: models.Metadata{
-				Name:      deployment.Name,
-				Namespace: deployment.Namespace,
-				Labels:    deployment.Labels,
+				Name:        deployment.Name,
+				Namespace:   deployment.Namespace,
+				Labels:      deployment.Labels,
+				Annotations: models.UserAnnotations(deployment.Annotations),
+				Notes:       deployment.Annotations[models.AnnotationNotes],
+				Tags:        models.TagsFromAnnotations(deployment.Annotations),
 			},
 			Status: models.DeploymentStatus{
 				Phase:         c.getDeploymentPhase(&deployment),
 				ReadyReplicas: int(deployment.Status.ReadyReplicas),
 			},
-			CreatedAt: deployment.CreationTimestamp.Time,
-			UpdatedAt: deployment.CreationTimestamp.Time,
+			CreatedBy: models.CreatorFromAnnotations(deployment.Annotations),
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
 		}
 		responses = append(responses, response)
 	}
@@ -203,6 +638,167 @@ func (c *ContainerService) ListContainers(ctx context.Context, namespace string,
 	return responses, nil
 }
 
+// GetContainerLogs streams pod logs for a container deployment
+func (c *ContainerService) GetContainerLogs(ctx context.Context, id, namespace string, req *models.DeploymentLogsRequest) (io.ReadCloser, error) {
+	logger := c.logger.Named("container_service").With(zap.String("deployment_id", id))
+
+	// Find the pods backing this deployment
+	pods, err := c.readClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	if len(pods.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id, namespace)
+	}
+
+	// Prefer a running pod; fall back to the first one (e.g. crash-looping pods)
+	pod := pods.Items[0]
+	for _, candidate := range pods.Items {
+		if candidate.Status.Phase == corev1.PodRunning {
+			pod = candidate
+			break
+		}
+	}
+
+	logOptions := &corev1.PodLogOptions{
+		Container: req.Container,
+		Follow:    req.Follow,
+	}
+	if req.Tail > 0 {
+		logOptions.TailLines = &req.Tail
+	}
+	if req.Since != "" {
+		duration, err := time.ParseDuration(req.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since duration %q: %w", req.Since, err)
+		}
+		sinceSeconds := int64(duration.Seconds())
+		logOptions.SinceSeconds = &sinceSeconds
+	}
+
+	stream, err := c.readClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream logs for pod %s: %w", pod.Name, err)
+	}
+
+	logger.Info("Streaming container logs",
+		zap.String("pod", pod.Name),
+		zap.Bool("follow", req.Follow))
+	return stream, nil
+}
+
+// GetContainerEvents returns the Kubernetes events related to a container
+// deployment's Deployment, Service and pods
+func (c *ContainerService) GetContainerEvents(ctx context.Context, id, namespace string) ([]models.DeploymentEvent, error) {
+	logger := c.logger.Named("container_service").With(zap.String("deployment_id", id))
+
+	names := make(map[string]bool)
+
+	deployments, err := c.readClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	for _, deployment := range deployments.Items {
+		names[deployment.Name] = true
+	}
+
+	services, err := c.readClient.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+	for _, service := range services.Items {
+		names[service.Name] = true
+	}
+
+	pods, err := c.readClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	for _, pod := range pods.Items {
+		names[pod.Name] = true
+	}
+
+	events, err := listEventsForObjects(ctx, c.readClient, namespace, names)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Retrieved container deployment events", zap.Int("count", len(events)))
+	return events, nil
+}
+
+// WatchContainer streams status updates for a container deployment using a Kubernetes watch
+func (c *ContainerService) WatchContainer(ctx context.Context, id, namespace string) (<-chan models.DeploymentResponse, error) {
+	logger := c.logger.Named("container_service").With(zap.String("deployment_id", id))
+
+	watcher, err := c.readClient.AppsV1().Deployments(namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch deployment: %w", err)
+	}
+
+	updates := make(chan models.DeploymentResponse)
+	go func() {
+		defer close(updates)
+		defer watcher.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					logger.Debug("Deployment watch channel closed")
+					return
+				}
+
+				deployment, ok := event.Object.(*appsv1.Deployment)
+				if !ok {
+					continue
+				}
+
+				createdAt, updatedAt := models.TimestampsFromAnnotations(deployment.Annotations, deployment.CreationTimestamp.Time)
+				response := models.DeploymentResponse{
+					ID:   id,
+					Kind: models.DeploymentKindContainer,
+					Metadata: models.Metadata{
+						Name:        deployment.Name,
+						Namespace:   deployment.Namespace,
+						Labels:      deployment.Labels,
+						Annotations: models.UserAnnotations(deployment.Annotations),
+						Notes:       deployment.Annotations[models.AnnotationNotes],
+						Tags:        models.TagsFromAnnotations(deployment.Annotations),
+					},
+					Status: models.DeploymentStatus{
+						Phase:         c.getDeploymentPhase(deployment),
+						ReadyReplicas: int(deployment.Status.ReadyReplicas),
+					},
+					CreatedBy: models.CreatorFromAnnotations(deployment.Annotations),
+					CreatedAt: createdAt,
+					UpdatedAt: updatedAt,
+				}
+
+				select {
+				case updates <- response:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return updates, nil
+}
 
 // ensureNamespace creates namespace if it doesn't exist
 func (c *ContainerService) ensureNamespace(ctx context.Context, namespace string) error {
@@ -222,141 +818,408 @@ func (c *ContainerService) ensureNamespace(ctx context.Context, namespace string
 }
 
 // createDeployment creates a Kubernetes deployment
-func (c *ContainerService) createDeployment(ctx context.Context, name, namespace string, spec *models.ContainerSpec, labels map[string]string, id string) error {
-	if labels == nil {
-		labels = make(map[string]string)
+func (c *ContainerService) createDeployment(ctx context.Context, name, namespace string, spec *models.ContainerSpec, labels, annotations map[string]string, id string) (*appsv1.Deployment, error) {
+	deployment := buildContainerDeployment(name, spec, labels, annotations, id, c.workload.DisableSATokenAutomount)
+
+	return c.client.AppsV1().Deployments(namespace).Create(ctx, deployment, metav1.CreateOptions{})
+}
+
+// createService creates a Kubernetes service owned by the deployment
+func (c *ContainerService) createService(ctx context.Context, name, namespace string, spec *models.ContainerSpec, labels, annotations map[string]string, id string, owner metav1.OwnerReference) error {
+	service := buildContainerService(name, spec, labels, annotations, id)
+	service.OwnerReferences = []metav1.OwnerReference{owner}
+
+	_, err := c.client.CoreV1().Services(namespace).Create(ctx, service, metav1.CreateOptions{})
+	return err
+}
+
+// createIngress creates a Kubernetes ingress owned by the deployment
+func (c *ContainerService) createIngress(ctx context.Context, name, namespace string, spec *models.ContainerSpec, labels, annotations map[string]string, id string, owner metav1.OwnerReference) error {
+	ingress := buildContainerIngress(name, spec, labels, annotations, id)
+	ingress.OwnerReferences = []metav1.OwnerReference{owner}
+
+	_, err := c.client.NetworkingV1().Ingresses(namespace).Create(ctx, ingress, metav1.CreateOptions{})
+	return err
+}
+
+// getDeploymentPhase determines the deployment phase from Kubernetes deployment status
+func (c *ContainerService) getDeploymentPhase(deployment *appsv1.Deployment) models.DeploymentPhase {
+	if deployment.Status.ReadyReplicas == 0 {
+		return models.DeploymentPhasePending
 	}
-	// Merge user labels with deployment labels
-	deploymentLabels := models.BuildDeploymentLabels(id, name)
-	for k, v := range deploymentLabels {
-		labels[k] = v
+	if deployment.Status.ReadyReplicas == *deployment.Spec.Replicas {
+		return models.DeploymentPhaseRunning
 	}
+	return models.DeploymentPhasePending
+}
 
-	replicas := int32(ptr.Deref(spec.Container.Replicas, 1)) // #nosec G115
+// deploymentConditions translates the Deployment's conditions (Available,
+// Progressing, ReplicaFailure) into the response model, keeping the
+// controller's transition times and reasons
+func deploymentConditions(deployment *appsv1.Deployment) []models.Condition {
+	var conditions []models.Condition
+	for _, condition := range deployment.Status.Conditions {
+		conditions = append(conditions, models.Condition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: condition.LastTransitionTime.Time,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+	return conditions
+}
 
-	deployment := &appsv1.Deployment{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   fmt.Sprintf("%s-%s", name, id[:8]),
-			Labels: labels,
-		},
-		Spec: appsv1.DeploymentSpec{
-			Replicas: &replicas,
-			Selector: &metav1.LabelSelector{
-				MatchLabels: models.BuildDeploymentLabels(id, name),
-			},
-			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: models.BuildDeploymentLabels(id, name),
-				},
-				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{
-						{
-							Name:  name,
-							Image: spec.Container.Image,
-						},
-					},
-				},
-			},
-		},
+// validateContainerSpec performs field-level validation that request binding
+// cannot express, so bad specs fail fast instead of surfacing as opaque
+// Kubernetes API errors
+func validateContainerSpec(spec *models.ContainerSpec) error {
+	if err := validateEnvironment("spec.container.environment", spec.Container.Environment); err != nil {
+		return err
+	}
+	if err := validateEnvFrom("spec.container.envFrom", spec.Container.EnvFrom); err != nil {
+		return err
+	}
+	if err := validateExtendedResources("spec.container.resources.extended", spec.Container.Resources); err != nil {
+		return err
+	}
+	if err := validateIngress(spec); err != nil {
+		return err
+	}
+	if err := validateNetworkAttachments("spec.container.networks", spec.Container.Networks, false); err != nil {
+		return err
+	}
+	if err := validateExtraContainers("spec.container", &spec.Container); err != nil {
+		return err
+	}
+	if err := validatePlacement("spec.container.placement", spec.Container.Placement); err != nil {
+		return err
+	}
+	if err := validateExecOverrides("spec.container", &spec.Container); err != nil {
+		return err
 	}
+	if spec.Container.Service != nil && len(spec.Container.Ports) == 0 {
+		return models.NewErrInvalidSpec("spec.container.service",
+			"service configuration requires at least one port")
+	}
+	return validatePorts("spec.container.ports", spec.Container.Ports)
+}
+
+// validateExecOverrides checks the entrypoint overrides. Kubernetes runs
+// command and args in exec form — no shell ever sees them, so entries cannot
+// be word-split or expanded — which leaves only structural mistakes: an
+// empty executable or a relative working directory.
+func validateExecOverrides(field string, container *models.ContainerConfig) error {
+	if len(container.Command) > 0 && container.Command[0] == "" {
+		return models.NewErrInvalidSpec(field+".command[0]", "executable must not be empty")
+	}
+	if container.WorkingDir != "" && !strings.HasPrefix(container.WorkingDir, "/") {
+		return models.NewErrInvalidSpec(field+".workingDir", "must be an absolute path")
+	}
+	return nil
+}
+
+// validateIngress checks that an ingress section can be routed: a Service is
+// only created when ports are declared, and the path must be absolute
+func validateIngress(spec *models.ContainerSpec) error {
+	ingress := spec.Container.Ingress
+	if ingress == nil {
+		return nil
+	}
+	if len(spec.Container.Ports) == 0 {
+		return models.NewErrInvalidSpec("spec.container.ingress",
+			"ingress requires at least one port so a Service can be created")
+	}
+	if ingress.Path != "" && !strings.HasPrefix(ingress.Path, "/") {
+		return models.NewErrInvalidSpec("spec.container.ingress.path",
+			fmt.Sprintf("path %q must start with /", ingress.Path))
+	}
+	return nil
+}
 
-	// Add ports if specified
-	if len(spec.Container.Ports) > 0 {
-		var containerPorts []corev1.ContainerPort
-		for _, port := range spec.Container.Ports {
-			containerPorts = append(containerPorts, corev1.ContainerPort{
-				ContainerPort: int32(port.ContainerPort), // #nosec G115
-				Protocol:      corev1.ProtocolTCP,
-			})
+// validateExtendedResources checks the names and quantities of extended
+// resource entries. Native resources go through the dedicated cpu/memory
+// fields; only fully-qualified or hugepages resource names are accepted here.
+// The field prefix keeps error messages accurate across kinds.
+func validateExtendedResources(field string, res *models.ResourceConfig) error {
+	if res == nil {
+		return nil
+	}
+	for name, value := range res.Extended {
+		fieldPath := fmt.Sprintf("%s[%s]", field, name)
+		if !isExtendedResourceName(name) {
+			return models.NewErrInvalidSpec(fieldPath, fmt.Sprintf("%q is not an extended resource name", name))
+		}
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return models.NewErrInvalidSpec(fieldPath, fmt.Sprintf("%q is not a valid quantity", value))
 		}
-		deployment.Spec.Template.Spec.Containers[0].Ports = containerPorts
 	}
+	return nil
+}
 
-	// Add resources if specified
-	if spec.Container.Resources != nil {
-		resources := corev1.ResourceRequirements{
-			Requests: corev1.ResourceList{},
+// isExtendedResourceName reports whether name is a valid extended resource
+// name: hugepages-<size>, or a domain-qualified name outside kubernetes.io
+func isExtendedResourceName(name string) bool {
+	if strings.HasPrefix(name, "hugepages-") {
+		return true
+	}
+	if !strings.Contains(name, "/") || strings.HasPrefix(name, "kubernetes.io/") {
+		return false
+	}
+	return len(validation.IsQualifiedName(name)) == 0
+}
+
+// validateEnvironment checks environment variable names against the
+// Kubernetes rules and rejects duplicates. The field prefix is used in error
+// messages so callers can share the helper across kinds.
+func validateEnvironment(field string, env []models.EnvironmentVariable) error {
+	seen := make(map[string]bool, len(env))
+	for i, v := range env {
+		fieldPath := fmt.Sprintf("%s[%d].name", field, i)
+		if msgs := validation.IsEnvVarName(v.Name); len(msgs) > 0 {
+			return models.NewErrInvalidSpec(fieldPath,
+				fmt.Sprintf("invalid environment variable name %q: %s", v.Name, strings.Join(msgs, "; ")))
+		}
+		if seen[v.Name] {
+			return models.NewErrInvalidSpec(fieldPath,
+				fmt.Sprintf("duplicate environment variable name %q", v.Name))
 		}
+		seen[v.Name] = true
 
-		if spec.Container.Resources.CPU != "" {
-			if cpu, err := resource.ParseQuantity(spec.Container.Resources.CPU); err == nil {
-				resources.Requests[corev1.ResourceCPU] = cpu
+		if v.ValueFrom != nil {
+			if err := validateEnvVarSource(fmt.Sprintf("%s[%d].valueFrom", field, i), v.Value, v.ValueFrom); err != nil {
+				return err
 			}
 		}
+	}
+	return nil
+}
 
-		if spec.Container.Resources.Memory != "" {
-			if memory, err := resource.ParseQuantity(spec.Container.Resources.Memory); err == nil {
-				resources.Requests[corev1.ResourceMemory] = memory
-			}
+// validateEnvVarSource checks that a valueFrom reference names exactly one
+// source object and is not combined with a literal value
+func validateEnvVarSource(field, value string, source *models.EnvVarSource) error {
+	if value != "" {
+		return models.NewErrInvalidSpec(field, "value and valueFrom are mutually exclusive")
+	}
+	if (source.SecretKeyRef == nil) == (source.ConfigMapKeyRef == nil) {
+		return models.NewErrInvalidSpec(field, "exactly one of secretKeyRef or configMapKeyRef must be set")
+	}
+	return nil
+}
+
+// validateEnvFrom checks that each envFrom entry names exactly one source object
+func validateEnvFrom(field string, envFrom []models.EnvFromSource) error {
+	for i, source := range envFrom {
+		if (source.SecretRef == nil) == (source.ConfigMapRef == nil) {
+			return models.NewErrInvalidSpec(fmt.Sprintf("%s[%d]", field, i),
+				"exactly one of secretRef or configMapRef must be set")
 		}
+	}
+	return nil
+}
+
+// validatePorts rejects duplicate container ports and collisions on the
+// effective service port (which defaults to the container port)
+func validatePorts(field string, ports []models.PortConfig) error {
+	containerPorts := make(map[int]bool, len(ports))
+	servicePorts := make(map[int]bool, len(ports))
+	for i, port := range ports {
+		if containerPorts[port.ContainerPort] {
+			return models.NewErrInvalidSpec(fmt.Sprintf("%s[%d].containerPort", field, i),
+				fmt.Sprintf("duplicate container port %d", port.ContainerPort))
+		}
+		containerPorts[port.ContainerPort] = true
 
-		deployment.Spec.Template.Spec.Containers[0].Resources = resources
+		if port.ServicePort != 0 && (port.ServicePort < 1 || port.ServicePort > 65535) {
+			return models.NewErrInvalidSpec(fmt.Sprintf("%s[%d].servicePort", field, i),
+				fmt.Sprintf("service port %d is out of range 1-65535", port.ServicePort))
+		}
+
+		servicePort := port.ServicePort
+		if servicePort == 0 {
+			servicePort = port.ContainerPort
+		}
+		if servicePorts[servicePort] {
+			return models.NewErrInvalidSpec(fmt.Sprintf("%s[%d].servicePort", field, i),
+				fmt.Sprintf("service port %d collides with another port entry", servicePort))
+		}
+		servicePorts[servicePort] = true
 	}
+	return nil
+}
 
-	// Add environment variables if specified
-	if len(spec.Container.Environment) > 0 {
-		var envVars []corev1.EnvVar
-		for _, envVar := range spec.Container.Environment {
-			envVars = append(envVars, corev1.EnvVar{
-				Name:  envVar.Name,
-				Value: envVar.Value,
-			})
+// applyResourcePolicy enforces the operator workload resource policy on a
+// container config: malformed quantities and values above the configured
+// ceilings are rejected, and unset limits are filled from the defaults so
+// tenant containers are never unbounded. The field prefix keeps error
+// messages accurate across kinds.
+func applyResourcePolicy(workload config.WorkloadConfig, field string, container *models.ContainerConfig) error {
+	if container.Resources == nil {
+		if workload.DefaultCPULimit == "" && workload.DefaultMemoryLimit == "" {
+			return nil
 		}
-		deployment.Spec.Template.Spec.Containers[0].Env = envVars
+		container.Resources = &models.ResourceConfig{}
 	}
+	res := container.Resources
 
-	_, err := c.client.AppsV1().Deployments(namespace).Create(ctx, deployment, metav1.CreateOptions{})
-	return err
+	checks := []struct {
+		field   string
+		value   string
+		ceiling string
+	}{
+		{field + ".cpu", res.CPU, workload.MaxCPU},
+		{field + ".memory", res.Memory, workload.MaxMemory},
+		{field + ".cpuLimit", res.CPULimit, workload.MaxCPU},
+		{field + ".memoryLimit", res.MemoryLimit, workload.MaxMemory},
+	}
+	for _, check := range checks {
+		if err := checkResourceQuantity(check.field, check.value, check.ceiling); err != nil {
+			return err
+		}
+	}
+
+	// Fill unset limits from the operator defaults
+	if res.CPULimit == "" {
+		res.CPULimit = workload.DefaultCPULimit
+	}
+	if res.MemoryLimit == "" {
+		res.MemoryLimit = workload.DefaultMemoryLimit
+	}
+
+	if err := checkRequestWithinLimit(field+".cpu", res.CPU, res.CPULimit); err != nil {
+		return err
+	}
+	return checkRequestWithinLimit(field+".memory", res.Memory, res.MemoryLimit)
 }
 
-// createService creates a Kubernetes service
-func (c *ContainerService) createService(ctx context.Context, name, namespace string, spec *models.ContainerSpec, labels map[string]string, id string) error {
-	if labels == nil {
-		labels = make(map[string]string)
+// checkResourceQuantity validates a quantity string and compares it against
+// an optional ceiling. Empty values and ceilings are skipped.
+func checkResourceQuantity(field, value, ceiling string) error {
+	if value == "" {
+		return nil
 	}
-	// Merge user labels with deployment labels
-	deploymentLabels := models.BuildDeploymentLabels(id, name)
-	for k, v := range deploymentLabels {
-		labels[k] = v
+
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return models.NewErrInvalidSpec(field, fmt.Sprintf("%q is not a valid quantity", value))
 	}
 
-	var servicePorts []corev1.ServicePort
-	for _, port := range spec.Container.Ports {
-		servicePort := int32(port.ContainerPort) // #nosec G115
-		if port.ServicePort > 0 {
-			servicePort = int32(port.ServicePort) // #nosec G115
-		}
+	if ceiling == "" {
+		return nil
+	}
+	// The ceiling is validated at startup
+	max, err := resource.ParseQuantity(ceiling)
+	if err != nil {
+		return nil
+	}
+	if quantity.Cmp(max) > 0 {
+		return models.NewErrInvalidSpec(field, fmt.Sprintf("%s exceeds the cluster ceiling of %s", value, ceiling))
+	}
+	return nil
+}
 
-		servicePorts = append(servicePorts, corev1.ServicePort{
-			Port:       servicePort,
-			TargetPort: intstr.FromInt(port.ContainerPort),
-			Protocol:   corev1.ProtocolTCP,
-		})
+// checkRequestWithinLimit rejects a resource request above its limit
+func checkRequestWithinLimit(field, request, limit string) error {
+	if request == "" || limit == "" {
+		return nil
 	}
 
-	service := &corev1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   fmt.Sprintf("%s-service-%s", name, id[:8]),
-			Labels: labels,
-		},
-		Spec: corev1.ServiceSpec{
-			Selector: models.BuildDeploymentLabels(id, name),
-			Ports:    servicePorts,
-			Type:     corev1.ServiceTypeNodePort,
-		},
+	requestQuantity, err := resource.ParseQuantity(request)
+	if err != nil {
+		return nil // already validated above
 	}
+	limitQuantity, err := resource.ParseQuantity(limit)
+	if err != nil {
+		return nil
+	}
+	if requestQuantity.Cmp(limitQuantity) > 0 {
+		return models.NewErrInvalidSpec(field, fmt.Sprintf("request %s exceeds the limit of %s", request, limit))
+	}
+	return nil
+}
 
-	_, err := c.client.CoreV1().Services(namespace).Create(ctx, service, metav1.CreateOptions{})
-	return err
+// ensureClusterResources verifies that every requested extended resource is
+// advertised by at least one node, so scheduling failures surface as request
+// errors instead of pods stuck in Pending. The check degrades gracefully when
+// nodes cannot be listed.
+func (c *ContainerService) ensureClusterResources(ctx context.Context, res *models.ResourceConfig) error {
+	return ensureClusterExtendedResources(ctx, c.readClient, c.logger.Named("container_service"), "spec.container.resources.extended", res, c.workload.StrictSpec)
 }
 
-// getDeploymentPhase determines the deployment phase from Kubernetes deployment status
-func (c *ContainerService) getDeploymentPhase(deployment *appsv1.Deployment) models.DeploymentPhase {
-	if deployment.Status.ReadyReplicas == 0 {
-		return models.DeploymentPhasePending
+// ensureClusterExtendedResources implements the node advertisement check
+// shared across the container-backed kinds. In strict mode an unadvertised
+// resource fails the request; in lenient mode it is logged and passed
+// through, leaving the scheduler to report the shortfall.
+func ensureClusterExtendedResources(ctx context.Context, readClient kubernetes.Interface, logger *zap.Logger, field string, res *models.ResourceConfig, strict bool) error {
+	if res == nil || len(res.Extended) == 0 {
+		return nil
 	}
-	if deployment.Status.ReadyReplicas == *deployment.Spec.Replicas {
-		return models.DeploymentPhaseRunning
+
+	nodes, err := readClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.Warn("Failed to list nodes for extended resource validation", zap.Error(err))
+		return nil
 	}
-	return models.DeploymentPhasePending
+
+	for name := range res.Extended {
+		advertised := false
+		for i := range nodes.Items {
+			if _, ok := nodes.Items[i].Status.Allocatable[corev1.ResourceName(name)]; ok {
+				advertised = true
+				break
+			}
+		}
+		if !advertised {
+			if !strict {
+				logger.Warn("Requested extended resource is not advertised by any cluster node",
+					zap.String("resource", name))
+				continue
+			}
+			return models.NewErrFieldNotSupported(
+				fmt.Sprintf("%s[%s]", field, name),
+				fmt.Sprintf("resource %q is not advertised by any cluster node", name))
+		}
+	}
+	return nil
+}
+
+// ensureIngressSupported verifies that the cluster can actually route an
+// ingress section: at least one IngressClass must be installed, and a
+// requested class name must name one of them. In lenient mode shortfalls are
+// logged and the Ingress is created anyway; the check degrades gracefully
+// when classes cannot be listed.
+func ensureIngressSupported(ctx context.Context, readClient kubernetes.Interface, logger *zap.Logger, field string, ingress *models.IngressConfig, strict bool) error {
+	if ingress == nil {
+		return nil
+	}
+
+	classes, err := readClient.NetworkingV1().IngressClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.Warn("Failed to list ingress classes for capability check", zap.Error(err))
+		return nil
+	}
+
+	if len(classes.Items) == 0 {
+		if !strict {
+			logger.Warn("Ingress requested but the cluster has no ingress classes installed")
+			return nil
+		}
+		return models.NewErrFieldNotSupported(field, "the cluster has no ingress classes installed")
+	}
+
+	if ingress.ClassName == "" {
+		return nil
+	}
+	for i := range classes.Items {
+		if classes.Items[i].Name == ingress.ClassName {
+			return nil
+		}
+	}
+	if !strict {
+		logger.Warn("Requested ingress class is not installed on the cluster",
+			zap.String("class", ingress.ClassName))
+		return nil
+	}
+	return models.NewErrFieldNotSupported(field+".className",
+		fmt.Sprintf("ingress class %q is not installed on the cluster", ingress.ClassName))
 }
@@ -0,0 +1,626 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestValidateContainerSpec(t *testing.T) {
+	tests := []struct {
+		name        string
+		container   models.ContainerConfig
+		expectError bool
+		contains    string
+	}{
+		{
+			name: "valid spec",
+			container: models.ContainerConfig{
+				Image: "nginx:latest",
+				Ports: []models.PortConfig{
+					{ContainerPort: 8080, ServicePort: 80},
+					{ContainerPort: 9090},
+				},
+				Environment: []models.EnvironmentVariable{
+					{Name: "DATABASE_URL", Value: "postgres://db:5432/app"},
+					{Name: "LOG_LEVEL", Value: "debug"},
+				},
+			},
+		},
+		{
+			name: "invalid environment variable name",
+			container: models.ContainerConfig{
+				Image: "nginx:latest",
+				Environment: []models.EnvironmentVariable{
+					{Name: "BAD=NAME", Value: "x"},
+				},
+			},
+			expectError: true,
+			contains:    "spec.container.environment[0].name",
+		},
+		{
+			name: "duplicate environment variable name",
+			container: models.ContainerConfig{
+				Image: "nginx:latest",
+				Environment: []models.EnvironmentVariable{
+					{Name: "PORT", Value: "8080"},
+					{Name: "PORT", Value: "9090"},
+				},
+			},
+			expectError: true,
+			contains:    `duplicate environment variable name "PORT"`,
+		},
+		{
+			name: "value and valueFrom are mutually exclusive",
+			container: models.ContainerConfig{
+				Image: "nginx:latest",
+				Environment: []models.EnvironmentVariable{
+					{Name: "DB_PASSWORD", Value: "plaintext", ValueFrom: &models.EnvVarSource{
+						SecretKeyRef: &models.KeySelector{Name: "db-credentials", Key: "password"},
+					}},
+				},
+			},
+			expectError: true,
+			contains:    "mutually exclusive",
+		},
+		{
+			name: "valueFrom requires exactly one ref",
+			container: models.ContainerConfig{
+				Image: "nginx:latest",
+				Environment: []models.EnvironmentVariable{
+					{Name: "DB_PASSWORD", ValueFrom: &models.EnvVarSource{}},
+				},
+			},
+			expectError: true,
+			contains:    "exactly one of secretKeyRef or configMapKeyRef",
+		},
+		{
+			name: "envFrom requires exactly one ref",
+			container: models.ContainerConfig{
+				Image: "nginx:latest",
+				EnvFrom: []models.EnvFromSource{
+					{
+						SecretRef:    &models.SourceSelector{Name: "app-secrets"},
+						ConfigMapRef: &models.SourceSelector{Name: "app-config"},
+					},
+				},
+			},
+			expectError: true,
+			contains:    "exactly one of secretRef or configMapRef",
+		},
+		{
+			name: "ingress without ports",
+			container: models.ContainerConfig{
+				Image:   "nginx:latest",
+				Ingress: &models.IngressConfig{Host: "web.example.com"},
+			},
+			expectError: true,
+			contains:    "ingress requires at least one port",
+		},
+		{
+			name: "ingress with relative path",
+			container: models.ContainerConfig{
+				Image: "nginx:latest",
+				Ports: []models.PortConfig{
+					{ContainerPort: 8080},
+				},
+				Ingress: &models.IngressConfig{Host: "web.example.com", Path: "app"},
+			},
+			expectError: true,
+			contains:    "must start with /",
+		},
+		{
+			name: "service config without ports",
+			container: models.ContainerConfig{
+				Image:   "nginx:latest",
+				Service: &models.ServiceConfig{Type: "ClusterIP"},
+			},
+			expectError: true,
+			contains:    "service configuration requires at least one port",
+		},
+		{
+			name: "duplicate container port",
+			container: models.ContainerConfig{
+				Image: "nginx:latest",
+				Ports: []models.PortConfig{
+					{ContainerPort: 8080},
+					{ContainerPort: 8080, ServicePort: 80},
+				},
+			},
+			expectError: true,
+			contains:    "duplicate container port 8080",
+		},
+		{
+			name: "service port collision with defaulted port",
+			container: models.ContainerConfig{
+				Image: "nginx:latest",
+				Ports: []models.PortConfig{
+					{ContainerPort: 8080},
+					{ContainerPort: 9090, ServicePort: 8080},
+				},
+			},
+			expectError: true,
+			contains:    "service port 8080 collides",
+		},
+		{
+			name: "service port out of range",
+			container: models.ContainerConfig{
+				Image: "nginx:latest",
+				Ports: []models.PortConfig{
+					{ContainerPort: 8080, ServicePort: 70000},
+				},
+			},
+			expectError: true,
+			contains:    "out of range",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateContainerSpec(&models.ContainerSpec{Container: tt.container})
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.True(t, models.IsInvalidSpecError(err))
+				assert.Contains(t, err.Error(), tt.contains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplyResourcePolicy(t *testing.T) {
+	policy := config.WorkloadConfig{
+		DefaultCPULimit:    "500m",
+		DefaultMemoryLimit: "512Mi",
+		MaxCPU:             "2",
+		MaxMemory:          "4Gi",
+	}
+
+	tests := []struct {
+		name        string
+		workload    config.WorkloadConfig
+		resources   *models.ResourceConfig
+		expectError bool
+		contains    string
+		check       func(t *testing.T, res *models.ResourceConfig)
+	}{
+		{
+			name:      "defaults fill unset limits",
+			workload:  policy,
+			resources: &models.ResourceConfig{CPU: "100m", Memory: "128Mi"},
+			check: func(t *testing.T, res *models.ResourceConfig) {
+				assert.Equal(t, "500m", res.CPULimit)
+				assert.Equal(t, "512Mi", res.MemoryLimit)
+			},
+		},
+		{
+			name:      "explicit limits are kept",
+			workload:  policy,
+			resources: &models.ResourceConfig{CPULimit: "1", MemoryLimit: "1Gi"},
+			check: func(t *testing.T, res *models.ResourceConfig) {
+				assert.Equal(t, "1", res.CPULimit)
+				assert.Equal(t, "1Gi", res.MemoryLimit)
+			},
+		},
+		{
+			name:      "nil resources get default limits",
+			workload:  policy,
+			resources: nil,
+		},
+		{
+			name:        "cpu request above ceiling",
+			workload:    policy,
+			resources:   &models.ResourceConfig{CPU: "4"},
+			expectError: true,
+			contains:    "exceeds the cluster ceiling of 2",
+		},
+		{
+			name:        "memory limit above ceiling",
+			workload:    policy,
+			resources:   &models.ResourceConfig{MemoryLimit: "8Gi"},
+			expectError: true,
+			contains:    "exceeds the cluster ceiling of 4Gi",
+		},
+		{
+			name:        "malformed quantity",
+			workload:    policy,
+			resources:   &models.ResourceConfig{CPU: "lots"},
+			expectError: true,
+			contains:    "not a valid quantity",
+		},
+		{
+			name:        "request above limit",
+			workload:    policy,
+			resources:   &models.ResourceConfig{Memory: "1Gi", MemoryLimit: "512Mi"},
+			expectError: true,
+			contains:    "request 1Gi exceeds the limit of 512Mi",
+		},
+		{
+			name:      "no policy leaves spec untouched",
+			workload:  config.WorkloadConfig{},
+			resources: nil,
+			check: func(t *testing.T, res *models.ResourceConfig) {
+				assert.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &models.ContainerSpec{
+				Container: models.ContainerConfig{
+					Image:     "nginx:latest",
+					Resources: tt.resources,
+				},
+			}
+
+			err := applyResourcePolicy(tt.workload, "spec.container.resources", &spec.Container)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.True(t, models.IsInvalidSpecError(err))
+				assert.Contains(t, err.Error(), tt.contains)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, spec.Container.Resources)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateExtendedResources(t *testing.T) {
+	tests := []struct {
+		name        string
+		extended    map[string]string
+		expectError bool
+		contains    string
+	}{
+		{
+			name:     "valid gpu and hugepages",
+			extended: map[string]string{"nvidia.com/gpu": "1", "hugepages-2Mi": "1Gi"},
+		},
+		{
+			name:        "native resource name rejected",
+			extended:    map[string]string{"cpu": "1"},
+			expectError: true,
+			contains:    "not an extended resource name",
+		},
+		{
+			name:        "kubernetes.io domain rejected",
+			extended:    map[string]string{"kubernetes.io/batteries": "1"},
+			expectError: true,
+			contains:    "not an extended resource name",
+		},
+		{
+			name:        "malformed quantity",
+			extended:    map[string]string{"nvidia.com/gpu": "many"},
+			expectError: true,
+			contains:    "not a valid quantity",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateExtendedResources("spec.container.resources.extended", &models.ResourceConfig{Extended: tt.extended})
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.True(t, models.IsInvalidSpecError(err))
+				assert.Contains(t, err.Error(), tt.contains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuildServiceStatus(t *testing.T) {
+	service := &corev1.Service{
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeLoadBalancer,
+			Ports: []corev1.ServicePort{
+				{Port: 80, NodePort: 30080},
+				{Port: 443, NodePort: 30443},
+			},
+		},
+		Status: corev1.ServiceStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{
+					{IP: "203.0.113.10"},
+				},
+			},
+		},
+	}
+
+	status := buildServiceStatus(service)
+	assert.Equal(t, "LoadBalancer", status.Type)
+	assert.Equal(t, []int32{30080, 30443}, status.NodePorts)
+	assert.Equal(t, "203.0.113.10", status.LoadBalancerIP)
+
+	clusterIP := buildServiceStatus(&corev1.Service{
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{{Port: 80}},
+		},
+	})
+	assert.Equal(t, "ClusterIP", clusterIP.Type)
+	assert.Empty(t, clusterIP.NodePorts)
+	assert.Empty(t, clusterIP.LoadBalancerIP)
+}
+
+func TestUpdateContainerRollsInPlace(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	service := NewContainerService(client, client, config.WorkloadConfig{}, zap.NewNop())
+	id := "0e7c6c7a-1111-2222-3333-444455556666"
+
+	req := &models.DeploymentRequest{
+		Kind: models.DeploymentKindContainer,
+		Metadata: models.Metadata{
+			Name:      "web",
+			Namespace: "default",
+		},
+		Spec: models.ContainerSpec{
+			Container: models.ContainerConfig{
+				Image: "nginx:1.25",
+				Ports: []models.PortConfig{{ContainerPort: 8080, ServicePort: 80}},
+			},
+		},
+	}
+	assert.NoError(t, service.CreateContainer(context.Background(), req, id))
+
+	created, err := client.AppsV1().Deployments("default").Get(context.Background(), "web-0e7c6c7a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "nginx:1.25", created.Spec.Template.Spec.Containers[0].Image)
+
+	// An update without a rename rolls the existing Deployment instead of
+	// recreating it
+	req.Spec = models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "nginx:1.26",
+			Ports: []models.PortConfig{{ContainerPort: 8080, ServicePort: 80}},
+		},
+	}
+	assert.NoError(t, service.UpdateContainer(context.Background(), req, id))
+
+	updated, err := client.AppsV1().Deployments("default").Get(context.Background(), "web-0e7c6c7a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "nginx:1.26", updated.Spec.Template.Spec.Containers[0].Image)
+
+	// The Service survived the update and is gone once ports are dropped
+	_, err = client.CoreV1().Services("default").Get(context.Background(), "web-service-0e7c6c7a", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	req.Spec = models.ContainerSpec{
+		Container: models.ContainerConfig{Image: "nginx:1.26"},
+	}
+	assert.NoError(t, service.UpdateContainer(context.Background(), req, id))
+	_, err = client.CoreV1().Services("default").Get(context.Background(), "web-service-0e7c6c7a", metav1.GetOptions{})
+	assert.Error(t, err)
+}
+
+func TestUpdateContainerPreservesCreationTimestamp(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	service := NewContainerService(client, client, config.WorkloadConfig{}, zap.NewNop())
+	id := "0e7c6c7a-1111-2222-3333-444455556666"
+
+	req := &models.DeploymentRequest{
+		Kind: models.DeploymentKindContainer,
+		Metadata: models.Metadata{
+			Name:      "web",
+			Namespace: "default",
+		},
+		Spec: models.ContainerSpec{
+			Container: models.ContainerConfig{Image: "nginx:1.25"},
+		},
+	}
+	assert.NoError(t, service.CreateContainer(context.Background(), req, id))
+
+	created, err := service.GetContainer(context.Background(), id)
+	assert.NoError(t, err)
+
+	req.Spec = models.ContainerSpec{
+		Container: models.ContainerConfig{Image: "nginx:1.26"},
+	}
+	assert.NoError(t, service.UpdateContainer(context.Background(), req, id))
+
+	// CreatedAt survives the update from the stored annotations; UpdatedAt
+	// reflects the update, not the object's CreationTimestamp
+	updated, err := service.GetContainer(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+	assert.False(t, updated.UpdatedAt.Before(updated.CreatedAt))
+}
+
+func TestEnsureClusterResources(t *testing.T) {
+	gpuNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-node"},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse("4"),
+			},
+		},
+	}
+
+	client := fake.NewSimpleClientset(gpuNode)
+	service := NewContainerService(client, client, config.WorkloadConfig{StrictSpec: true}, zap.NewNop())
+
+	// Advertised resources pass
+	err := service.ensureClusterResources(context.Background(), &models.ResourceConfig{
+		Extended: map[string]string{"nvidia.com/gpu": "1"},
+	})
+	assert.NoError(t, err)
+
+	// In strict mode, resources no node advertises are rejected
+	err = service.ensureClusterResources(context.Background(), &models.ResourceConfig{
+		Extended: map[string]string{"amd.com/gpu": "1"},
+	})
+	assert.Error(t, err)
+	assert.True(t, models.IsFieldNotSupportedError(err))
+	assert.Contains(t, err.Error(), "not advertised by any cluster node")
+
+	// In lenient mode, the same request is logged and passed through
+	lenient := NewContainerService(client, client, config.WorkloadConfig{}, zap.NewNop())
+	err = lenient.ensureClusterResources(context.Background(), &models.ResourceConfig{
+		Extended: map[string]string{"amd.com/gpu": "1"},
+	})
+	assert.NoError(t, err)
+}
+
+func TestEnsureIngressSupported(t *testing.T) {
+	nginxClass := &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx"},
+	}
+
+	// No ingress section: nothing to check even on a bare cluster
+	bare := fake.NewSimpleClientset()
+	err := ensureIngressSupported(context.Background(), bare, zap.NewNop(), "spec.container.ingress", nil, true)
+	assert.NoError(t, err)
+
+	// Strict mode rejects an ingress when no classes are installed
+	ingress := &models.IngressConfig{Host: "app.example.com"}
+	err = ensureIngressSupported(context.Background(), bare, zap.NewNop(), "spec.container.ingress", ingress, true)
+	assert.Error(t, err)
+	assert.True(t, models.IsFieldNotSupportedError(err))
+	assert.Contains(t, err.Error(), "no ingress classes installed")
+
+	// Lenient mode passes the same request through
+	err = ensureIngressSupported(context.Background(), bare, zap.NewNop(), "spec.container.ingress", ingress, false)
+	assert.NoError(t, err)
+
+	// With a class installed, an unqualified ingress and a matching class pass
+	client := fake.NewSimpleClientset(nginxClass)
+	err = ensureIngressSupported(context.Background(), client, zap.NewNop(), "spec.container.ingress", ingress, true)
+	assert.NoError(t, err)
+	withClass := &models.IngressConfig{Host: "app.example.com", ClassName: "nginx"}
+	err = ensureIngressSupported(context.Background(), client, zap.NewNop(), "spec.container.ingress", withClass, true)
+	assert.NoError(t, err)
+
+	// A class name the cluster does not serve is rejected in strict mode
+	unknownClass := &models.IngressConfig{Host: "app.example.com", ClassName: "traefik"}
+	err = ensureIngressSupported(context.Background(), client, zap.NewNop(), "spec.container.ingress", unknownClass, true)
+	assert.Error(t, err)
+	assert.True(t, models.IsFieldNotSupportedError(err))
+	assert.Contains(t, err.Error(), `ingress class "traefik" is not installed`)
+}
+
+func TestCreateContainerSetsServiceOwner(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	service := NewContainerService(client, client, config.WorkloadConfig{}, zap.NewNop())
+	id := "1a2b3c4d-1111-2222-3333-444455556666"
+
+	req := &models.DeploymentRequest{
+		Kind: models.DeploymentKindContainer,
+		Metadata: models.Metadata{
+			Name:      "web",
+			Namespace: "default",
+		},
+		Spec: models.ContainerSpec{
+			Container: models.ContainerConfig{
+				Image: "nginx:1.25",
+				Ports: []models.PortConfig{{ContainerPort: 8080, ServicePort: 80}},
+			},
+		},
+	}
+	assert.NoError(t, service.CreateContainer(context.Background(), req, id))
+
+	deployment, err := client.AppsV1().Deployments("default").Get(context.Background(), "web-1a2b3c4d", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	// The Service is owned by the Deployment so garbage collection deletes
+	// it in cascade
+	svc, err := client.CoreV1().Services("default").Get(context.Background(), "web-service-1a2b3c4d", metav1.GetOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, svc.OwnerReferences, 1) {
+		owner := svc.OwnerReferences[0]
+		assert.Equal(t, "Deployment", owner.Kind)
+		assert.Equal(t, deployment.Name, owner.Name)
+		assert.Equal(t, deployment.UID, owner.UID)
+	}
+}
+
+func TestDeploymentConditions(t *testing.T) {
+	now := metav1.Now()
+	deployment := &appsv1.Deployment{
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:               appsv1.DeploymentAvailable,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: now,
+					Reason:             "MinimumReplicasAvailable",
+					Message:            "Deployment has minimum availability.",
+				},
+				{
+					Type:    appsv1.DeploymentReplicaFailure,
+					Status:  corev1.ConditionTrue,
+					Reason:  "FailedCreate",
+					Message: "pods \"web-\" is forbidden",
+				},
+			},
+		},
+	}
+
+	conditions := deploymentConditions(deployment)
+	assert.Len(t, conditions, 2)
+	assert.Equal(t, "Available", conditions[0].Type)
+	assert.Equal(t, "True", conditions[0].Status)
+	assert.Equal(t, now.Time, conditions[0].LastTransitionTime)
+	assert.Equal(t, "MinimumReplicasAvailable", conditions[0].Reason)
+	assert.Equal(t, "ReplicaFailure", conditions[1].Type)
+
+	assert.Empty(t, deploymentConditions(&appsv1.Deployment{}))
+}
+
+func TestValidateExecOverrides(t *testing.T) {
+	assert.NoError(t, validateExecOverrides("spec.container", &models.ContainerConfig{Image: "nginx:latest"}))
+	assert.NoError(t, validateExecOverrides("spec.container", &models.ContainerConfig{
+		Image:      "app:1.0",
+		Command:    []string{"/app/server", "--listen", ":8080"},
+		Args:       []string{"--verbose"},
+		WorkingDir: "/app",
+	}))
+
+	err := validateExecOverrides("spec.container", &models.ContainerConfig{
+		Image:   "app:1.0",
+		Command: []string{"", "--listen"},
+	})
+	require.True(t, models.IsInvalidSpecError(err))
+	assert.Contains(t, err.Error(), "executable must not be empty")
+
+	err = validateExecOverrides("spec.container", &models.ContainerConfig{
+		Image:      "app:1.0",
+		WorkingDir: "app",
+	})
+	require.True(t, models.IsInvalidSpecError(err))
+	assert.Contains(t, err.Error(), "absolute path")
+}
+
+func TestBuildContainerDeploymentExecOverrides(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image:      "app:1.0",
+			Command:    []string{"/app/server"},
+			Args:       []string{"--listen", ":8080"},
+			WorkingDir: "/app",
+		},
+	}
+
+	deployment := buildContainerDeployment("app", spec, nil, goldenAnnotations(), goldenDeploymentID, false)
+	container := deployment.Spec.Template.Spec.Containers[0]
+
+	assert.Equal(t, []string{"/app/server"}, container.Command)
+	assert.Equal(t, []string{"--listen", ":8080"}, container.Args)
+	assert.Equal(t, "/app", container.WorkingDir)
+}
@@ -0,0 +1,470 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/spec"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// manualTriggerAnnotation marks jobs created outside the schedule by the
+// trigger action, matching the convention used by kubectl create job --from
+const manualTriggerAnnotation = "cronjob.kubernetes.io/instantiate"
+
+// CronJobService handles scheduled job deployment operations.
+// Writes go through client; read-heavy operations (get/list/watch) use
+// readClient so they are throttled on a separate budget.
+type CronJobService struct {
+	client     kubernetes.Interface
+	readClient kubernetes.Interface
+	logger     *zap.Logger
+}
+
+// NewCronJobService creates a new cronjob service instance
+func NewCronJobService(client, readClient kubernetes.Interface, logger *zap.Logger) *CronJobService {
+	return &CronJobService{
+		client:     client,
+		readClient: readClient,
+		logger:     logger,
+	}
+}
+
+// CreateCronJob creates a new cronjob deployment
+func (c *CronJobService) CreateCronJob(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	now := time.Now()
+	return c.createCronJob(ctx, req, id, stampCreator(ctx, models.BuildTimestampAnnotations(now, now)))
+}
+
+// createCronJob creates the cronjob resources with the provided timestamp annotations
+func (c *CronJobService) createCronJob(ctx context.Context, req *models.DeploymentRequest, id string, annotations map[string]string) error {
+	logger := c.logger.Named("cronjob_service").With(zap.String("deployment_id", id))
+	logger.Info("Starting cronjob deployment")
+
+	cronSpec, ok := req.Spec.(models.CronJobSpec)
+	if !ok {
+		return fmt.Errorf("invalid cronjob spec format")
+	}
+
+	if err := validateEnvironment("spec.cronjob.environment", cronSpec.CronJob.Environment); err != nil {
+		return err
+	}
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Create namespace if it doesn't exist
+	if err := c.ensureNamespace(ctx, namespace); err != nil {
+		return fmt.Errorf("failed to ensure namespace: %w", err)
+	}
+
+	// Persist ops notes and tags alongside the timestamps
+	models.ApplyOpsAnnotations(annotations, req.Metadata.Notes, req.Metadata.Tags)
+	models.MergeUserAnnotations(annotations, req.Metadata.Annotations)
+
+	// Persist the versioned spec so schema migrations can upgrade it later
+	if err := spec.Persist(annotations, req.Spec); err != nil {
+		return fmt.Errorf("failed to persist spec: %w", err)
+	}
+
+	cronJob := buildCronJob(req.Metadata.Name, &cronSpec, req.Metadata.Labels, annotations, id)
+
+	if _, err := c.client.BatchV1().CronJobs(namespace).Create(ctx, cronJob, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create cronjob: %w", err)
+	}
+
+	logger.Info("Successfully created cronjob deployment")
+	return nil
+}
+
+// DryRunCronJob validates a cronjob deployment by server-side dry-running the
+// generated CronJob, returning the rendered manifest without persisting
+// anything. The target namespace must already exist.
+func (c *CronJobService) DryRunCronJob(ctx context.Context, req *models.DeploymentRequest, id string) ([]interface{}, error) {
+	logger := c.logger.Named("cronjob_service").With(zap.String("deployment_id", id))
+
+	cronSpec, ok := req.Spec.(models.CronJobSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid cronjob spec format")
+	}
+
+	if err := validateEnvironment("spec.cronjob.environment", cronSpec.CronJob.Environment); err != nil {
+		return nil, err
+	}
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	now := time.Now()
+	cronJob := buildCronJob(req.Metadata.Name, &cronSpec, req.Metadata.Labels, models.BuildTimestampAnnotations(now, now), id)
+
+	rendered, err := c.client.BatchV1().CronJobs(namespace).Create(ctx, cronJob, metav1.CreateOptions{
+		DryRun: []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cronjob dry-run failed: %w", err)
+	}
+
+	logger.Info("Dry-run validated cronjob deployment")
+	return []interface{}{rendered}, nil
+}
+
+// GetCronJob retrieves cronjob deployment information searching across all namespaces
+func (c *CronJobService) GetCronJob(ctx context.Context, id string) (*models.DeploymentResponse, error) {
+	logger := c.logger.Named("cronjob_service").With(zap.String("deployment_id", id))
+
+	// Search across all namespaces using label selector
+	cronJobs, err := c.readClient.BatchV1().CronJobs("").List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cronjob: %w", err)
+	}
+
+	if len(cronJobs.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+
+	cronJob := cronJobs.Items[0]
+	response := c.buildResponse(id, &cronJob)
+
+	// Surface the persisted spec, migrated to the current schema version
+	if persisted, err := spec.FromAnnotations(cronJob.Annotations); err != nil {
+		logger.Warn("Failed to read persisted spec", zap.Error(err))
+	} else if persisted != nil {
+		response.Spec = persisted
+	}
+
+	logger.Info("Successfully retrieved cronjob deployment")
+	return response, nil
+}
+
+// UpdateCronJob updates an existing cronjob deployment
+func (c *CronJobService) UpdateCronJob(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	logger := c.logger.Named("cronjob_service").With(zap.String("deployment_id", id))
+	logger.Info("Updating cronjob deployment")
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Preserve the original creation time and creator across the delete-and-recreate cycle
+	createdAt := time.Now()
+	var creator *models.Creator
+	if existing, err := c.GetCronJob(ctx, id); err == nil {
+		createdAt = existing.CreatedAt
+		creator = existing.CreatedBy
+	}
+
+	// Delete and recreate like the other kinds; running jobs spawned by the
+	// old schedule finish on their own
+	if err := c.DeleteCronJob(ctx, id, namespace); err != nil {
+		logger.Warn("Failed to delete existing cronjob during update", zap.Error(err))
+	}
+
+	return c.createCronJob(ctx, req, id, preserveCreator(models.BuildTimestampAnnotations(createdAt, time.Now()), creator))
+}
+
+// DeleteCronJob deletes a cronjob deployment and the jobs it spawned
+func (c *CronJobService) DeleteCronJob(ctx context.Context, id, namespace string) error {
+	logger := c.logger.Named("cronjob_service").With(zap.String("deployment_id", id))
+	logger.Info("Deleting cronjob deployment")
+
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Background propagation so spawned jobs and their pods are cleaned up as well
+	propagation := metav1.DeletePropagationBackground
+	err := c.client.BatchV1().CronJobs(namespace).DeleteCollection(ctx, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	}, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		logger.Error("Failed to delete cronjob", zap.Error(err))
+		return fmt.Errorf("failed to delete cronjob: %w", err)
+	}
+
+	logger.Info("Successfully deleted cronjob deployment")
+	return nil
+}
+
+// PatchCronJobAnnotations applies a JSON merge patch to the annotations of
+// the CronJob object, leaving its spec untouched
+func (c *CronJobService) PatchCronJobAnnotations(ctx context.Context, id, namespace string, annotations map[string]interface{}) error {
+	cronJobs, err := c.readClient.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find cronjob: %w", err)
+	}
+	if len(cronJobs.Items) == 0 {
+		return models.NewErrDeploymentNotFound(id)
+	}
+
+	patch, err := marshalAnnotationsPatch(annotations)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.BatchV1().CronJobs(namespace).Patch(ctx, cronJobs.Items[0].Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch cronjob annotations: %w", err)
+	}
+	return nil
+}
+
+// ListCronJobs lists all cronjob deployments
+func (c *CronJobService) ListCronJobs(ctx context.Context, namespace string, limit, offset int) ([]models.DeploymentResponse, error) {
+	logger := c.logger.Named("cronjob_service")
+
+	// Use empty string to search all namespaces if namespace is not specified
+	// Filter only resources managed by this service
+	cronJobs, err := c.readClient.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildManagedResourceSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cronjobs: %w", err)
+	}
+
+	var responses []models.DeploymentResponse
+	for i, cronJob := range cronJobs.Items {
+		if i < offset {
+			continue
+		}
+		if len(responses) >= limit {
+			break
+		}
+
+		responses = append(responses, *c.buildResponse(cronJob.Labels[models.LabelAppID], &cronJob))
+	}
+
+	logger.Info("Successfully listed cronjob deployments", zap.Int("count", len(responses)))
+	return responses, nil
+}
+
+// GetCronJobEvents returns the Kubernetes events related to a cronjob
+// deployment's CronJob, spawned jobs and pods
+func (c *CronJobService) GetCronJobEvents(ctx context.Context, id, namespace string) ([]models.DeploymentEvent, error) {
+	logger := c.logger.Named("cronjob_service").With(zap.String("deployment_id", id))
+
+	names := make(map[string]bool)
+
+	cronJobs, err := c.readClient.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cronjobs: %w", err)
+	}
+	for _, cronJob := range cronJobs.Items {
+		names[cronJob.Name] = true
+	}
+
+	pods, err := c.readClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	for _, pod := range pods.Items {
+		names[pod.Name] = true
+	}
+
+	events, err := listEventsForObjects(ctx, c.readClient, namespace, names)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Retrieved cronjob deployment events", zap.Int("count", len(events)))
+	return events, nil
+}
+
+// WatchCronJob streams status updates for a cronjob deployment using a Kubernetes watch
+func (c *CronJobService) WatchCronJob(ctx context.Context, id, namespace string) (<-chan models.DeploymentResponse, error) {
+	logger := c.logger.Named("cronjob_service").With(zap.String("deployment_id", id))
+
+	watcher, err := c.readClient.BatchV1().CronJobs(namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch cronjob: %w", err)
+	}
+
+	updates := make(chan models.DeploymentResponse)
+	go func() {
+		defer close(updates)
+		defer watcher.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					logger.Debug("CronJob watch channel closed")
+					return
+				}
+
+				cronJob, ok := event.Object.(*batchv1.CronJob)
+				if !ok {
+					continue
+				}
+
+				select {
+				case updates <- *c.buildResponse(id, cronJob):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// PerformCronJobAction performs a schedule action (trigger, suspend, resume)
+// on a cronjob deployment and returns the phase observed after the action
+func (c *CronJobService) PerformCronJobAction(ctx context.Context, id, namespace, action string) (models.DeploymentPhase, error) {
+	logger := c.logger.Named("cronjob_service").With(
+		zap.String("deployment_id", id),
+		zap.String("action", action))
+
+	cronJobs, err := c.client.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get cronjob: %w", err)
+	}
+	if len(cronJobs.Items) == 0 {
+		return "", models.NewErrDeploymentNotFound(id, namespace)
+	}
+	cronJob := cronJobs.Items[0]
+
+	switch action {
+	case models.ActionTrigger:
+		err = c.triggerRun(ctx, &cronJob, id)
+	case models.ActionSuspend:
+		err = c.setSuspend(ctx, &cronJob, true)
+	case models.ActionResume:
+		err = c.setSuspend(ctx, &cronJob, false)
+	default:
+		return "", fmt.Errorf("unsupported action: %s", action)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to %s cronjob %s: %w", action, cronJob.Name, err)
+	}
+
+	// Re-read the cronjob to report the phase observed after the action
+	phase := models.DeploymentPhaseUnknown
+	if updated, err := c.client.BatchV1().CronJobs(namespace).Get(ctx, cronJob.Name, metav1.GetOptions{}); err == nil {
+		phase = c.getCronJobPhase(updated)
+	}
+
+	logger.Info("Performed cronjob schedule action",
+		zap.String("cronjob", cronJob.Name),
+		zap.String("phase", string(phase)))
+	return phase, nil
+}
+
+// triggerRun creates a one-off job from the cronjob's job template, the same
+// way kubectl create job --from=cronjob does
+func (c *CronJobService) triggerRun(ctx context.Context, cronJob *batchv1.CronJob, id string) error {
+	annotations := map[string]string{manualTriggerAnnotation: "manual"}
+	for k, v := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-manual-", cronJob.Name),
+			Namespace:    cronJob.Namespace,
+			Labels:       models.BuildDeploymentLabels(id, cronJob.Labels[models.LabelApp]),
+			Annotations:  annotations,
+		},
+		Spec: cronJob.Spec.JobTemplate.Spec,
+	}
+
+	_, err := c.client.BatchV1().Jobs(cronJob.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	return err
+}
+
+// setSuspend patches the cronjob's suspend flag in place so the deployment
+// keeps its history and last-schedule bookkeeping
+func (c *CronJobService) setSuspend(ctx context.Context, cronJob *batchv1.CronJob, suspend bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend))
+	_, err := c.client.BatchV1().CronJobs(cronJob.Namespace).Patch(ctx, cronJob.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// buildResponse converts a Kubernetes CronJob into the deployment response model
+func (c *CronJobService) buildResponse(id string, cronJob *batchv1.CronJob) *models.DeploymentResponse {
+	createdAt, updatedAt := models.TimestampsFromAnnotations(cronJob.Annotations, cronJob.CreationTimestamp.Time)
+
+	status := models.DeploymentStatus{
+		Phase:         c.getCronJobPhase(cronJob),
+		ReadyReplicas: len(cronJob.Status.Active),
+	}
+	if cronJob.Status.LastScheduleTime != nil {
+		status.Message = fmt.Sprintf("last scheduled at %s", cronJob.Status.LastScheduleTime.Format(time.RFC3339))
+	}
+
+	return &models.DeploymentResponse{
+		ID:   id,
+		Kind: models.DeploymentKindCronJob,
+		Metadata: models.Metadata{
+			Name:        cronJob.Name,
+			Namespace:   cronJob.Namespace,
+			Labels:      cronJob.Labels,
+			Annotations: models.UserAnnotations(cronJob.Annotations),
+			Notes:       cronJob.Annotations[models.AnnotationNotes],
+			Tags:        models.TagsFromAnnotations(cronJob.Annotations),
+		},
+		Status:    status,
+		CreatedBy: models.CreatorFromAnnotations(cronJob.Annotations),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// ensureNamespace creates namespace if it doesn't exist
+func (c *CronJobService) ensureNamespace(ctx context.Context, namespace string) error {
+	_, err := c.client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+		}
+		_, err = c.client.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to create namespace %s: %w", namespace, err)
+		}
+	}
+	return nil
+}
+
+// getCronJobPhase determines the deployment phase from Kubernetes cronjob
+// status: suspended schedules are paused, schedules with active jobs are
+// running, and idle schedules are pending until the next run
+func (c *CronJobService) getCronJobPhase(cronJob *batchv1.CronJob) models.DeploymentPhase {
+	if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend {
+		return models.DeploymentPhasePaused
+	}
+	if len(cronJob.Status.Active) > 0 {
+		return models.DeploymentPhaseRunning
+	}
+	return models.DeploymentPhasePending
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// AttachDeploymentDisk hotplugs a blank persistent disk onto a running VM
+// deployment by ID. Only VM deployments carry disks; other kinds return
+// ErrActionNotSupported.
+func (d *DeploymentService) AttachDeploymentDisk(ctx context.Context, id string, disk *models.VMDiskConfig) (*models.DeploymentActionResponse, error) {
+	logger := d.logger.Named("deployment_service").With(
+		zap.String("deployment_id", id),
+		zap.String("disk", disk.Name))
+
+	logger.Info("Attaching disk to deployment")
+
+	// Serialize with other mutations so a hotplug cannot race a delete-and-recreate
+	if err := d.locks.acquire(id, models.ActionAttachDisk); err != nil {
+		return nil, err
+	}
+	defer d.locks.release(id)
+
+	deployment, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.Kind != models.DeploymentKindVM {
+		return nil, models.NewErrActionNotSupported(id, deployment.Kind, models.ActionAttachDisk)
+	}
+
+	phase, err := d.vmService.AttachVMDisk(ctx, id, deployment.Metadata.Namespace, disk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.DeploymentActionResponse{
+		ID:        id,
+		Action:    models.ActionAttachDisk,
+		Phase:     phase,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// DetachDeploymentDisk unplugs a previously hotplugged disk from a VM
+// deployment by ID. The backing DataVolume is kept, so the disk can be
+// re-attached with its data intact; it is garbage-collected with the VM.
+func (d *DeploymentService) DetachDeploymentDisk(ctx context.Context, id, diskName string) (*models.DeploymentActionResponse, error) {
+	logger := d.logger.Named("deployment_service").With(
+		zap.String("deployment_id", id),
+		zap.String("disk", diskName))
+
+	logger.Info("Detaching disk from deployment")
+
+	if err := d.locks.acquire(id, models.ActionDetachDisk); err != nil {
+		return nil, err
+	}
+	defer d.locks.release(id)
+
+	deployment, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.Kind != models.DeploymentKindVM {
+		return nil, models.NewErrActionNotSupported(id, deployment.Kind, models.ActionDetachDisk)
+	}
+
+	phase, err := d.vmService.DetachVMDisk(ctx, id, deployment.Metadata.Namespace, diskName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.DeploymentActionResponse{
+		ID:        id,
+		Action:    models.ActionDetachDisk,
+		Phase:     phase,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// AttachVMDisk provisions a blank DataVolume for the disk and hotplugs it
+// into the VM through the KubeVirt volume hotplug subresource, which persists
+// the volume in the VM spec and attaches it to the running VMI without a
+// restart. The DataVolume is owned by the VirtualMachine so garbage
+// collection removes it in cascade with the VM.
+func (v *VMService) AttachVMDisk(ctx context.Context, id, namespace string, disk *models.VMDiskConfig) (models.DeploymentPhase, error) {
+	logger := v.logger.Named("vm_service").With(
+		zap.String("deployment_id", id),
+		zap.String("disk", disk.Name))
+
+	if err := v.ensureAvailable(ctx); err != nil {
+		return "", err
+	}
+
+	if err := validateHotplugDisk(disk); err != nil {
+		return "", err
+	}
+
+	vm, err := v.findVM(ctx, id, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	volumeName := fmt.Sprintf("%s-%s", vm.Name, disk.Name)
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.Name == volumeName {
+			return "", models.NewErrInvalidVMShape(fmt.Sprintf("disk %q is already attached", disk.Name))
+		}
+	}
+
+	// The hotplug source must exist before AddVolume references it
+	template := buildDataVolumeTemplate(volumeName, disk, &cdiv1.DataVolumeSource{
+		Blank: &cdiv1.DataVolumeBlankImage{},
+	})
+	dataVolume := &cdiv1.DataVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volumeName,
+			Namespace: namespace,
+			Labels:    models.BuildDeploymentLabels(id, vm.Name),
+			OwnerReferences: []metav1.OwnerReference{
+				ownerReference(kubevirtv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+					kubevirtv1.VirtualMachineGroupVersionKind.Kind, vm.Name, vm.UID),
+			},
+		},
+		Spec: template.Spec,
+	}
+	if _, err := v.kubevirtClient.CdiClient().CdiV1beta1().DataVolumes(namespace).Create(ctx, dataVolume, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("failed to create DataVolume for disk %q: %w", disk.Name, err)
+	}
+
+	options := &kubevirtv1.AddVolumeOptions{
+		Name: volumeName,
+		Disk: &kubevirtv1.Disk{
+			DiskDevice: kubevirtv1.DiskDevice{
+				// KubeVirt only supports scsi for hotplugged volumes
+				Disk: &kubevirtv1.DiskTarget{Bus: kubevirtv1.DiskBusSCSI},
+			},
+		},
+		VolumeSource: &kubevirtv1.HotplugVolumeSource{
+			DataVolume: &kubevirtv1.DataVolumeSource{
+				Name:         volumeName,
+				Hotpluggable: true,
+			},
+		},
+	}
+	if err := v.kubevirtClient.VirtualMachine(namespace).AddVolume(ctx, vm.Name, options); err != nil {
+		return "", fmt.Errorf("failed to hotplug disk %q: %w", disk.Name, err)
+	}
+
+	logger.Info("Hotplugged disk", zap.String("vm", vm.Name))
+	return v.observeVMPhase(ctx, namespace, vm.Name), nil
+}
+
+// DetachVMDisk unplugs a hotplugged disk from the VM through the KubeVirt
+// volume hotplug subresource. The backing DataVolume is left in place so the
+// data survives a later re-attach.
+func (v *VMService) DetachVMDisk(ctx context.Context, id, namespace, diskName string) (models.DeploymentPhase, error) {
+	logger := v.logger.Named("vm_service").With(
+		zap.String("deployment_id", id),
+		zap.String("disk", diskName))
+
+	if err := v.ensureAvailable(ctx); err != nil {
+		return "", err
+	}
+
+	vm, err := v.findVM(ctx, id, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	volumeName := fmt.Sprintf("%s-%s", vm.Name, diskName)
+	options := &kubevirtv1.RemoveVolumeOptions{Name: volumeName}
+	if err := v.kubevirtClient.VirtualMachine(namespace).RemoveVolume(ctx, vm.Name, options); err != nil {
+		return "", fmt.Errorf("failed to unplug disk %q: %w", diskName, err)
+	}
+
+	logger.Info("Unplugged disk", zap.String("vm", vm.Name))
+	return v.observeVMPhase(ctx, namespace, vm.Name), nil
+}
+
+// findVM resolves the VirtualMachine backing a deployment ID within a namespace
+func (v *VMService) findVM(ctx context.Context, id, namespace string) (*kubevirtv1.VirtualMachine, error) {
+	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get virtual machine: %w", err)
+	}
+	if len(vms.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id, namespace)
+	}
+	return &vms.Items[0], nil
+}
+
+// observeVMPhase re-reads the VM to report the phase observed after an action
+func (v *VMService) observeVMPhase(ctx context.Context, namespace, vmName string) models.DeploymentPhase {
+	phase := models.DeploymentPhaseUnknown
+	if vm, err := v.kubevirtClient.VirtualMachine(namespace).Get(ctx, vmName, metav1.GetOptions{}); err == nil {
+		phase = v.getVMPhase(vm)
+	}
+	return phase
+}
+
+// validateHotplugDisk checks a disk submitted to the hotplug endpoint. Unlike
+// create-time data disks it must be named, since the name keys the later
+// unplug, and it cannot pick a bus: KubeVirt hotplugs scsi only.
+func validateHotplugDisk(disk *models.VMDiskConfig) error {
+	if disk.Name == "" {
+		return models.NewErrInvalidVMShape("hotplugged disks must be named")
+	}
+	if disk.SourceImage != "" {
+		return models.NewErrInvalidVMShape(fmt.Sprintf("data disk %q: sourceImage is only supported on the root disk", disk.Name))
+	}
+	if disk.Bus != "" && disk.Bus != string(kubevirtv1.DiskBusSCSI) {
+		return models.NewErrInvalidVMShape(fmt.Sprintf("data disk %q: hotplugged disks must use the scsi bus", disk.Name))
+	}
+	return validateDiskSize(fmt.Sprintf("data disk %q", disk.Name), disk.Size)
+}
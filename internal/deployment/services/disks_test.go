@@ -0,0 +1,81 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestValidateHotplugDisk(t *testing.T) {
+	tests := []struct {
+		name    string
+		disk    models.VMDiskConfig
+		wantErr string
+	}{
+		{
+			name: "valid hotplug disk",
+			disk: models.VMDiskConfig{Name: "scratch", Size: "10Gi"},
+		},
+		{
+			name: "explicit scsi bus accepted",
+			disk: models.VMDiskConfig{Name: "scratch", Size: "10Gi", Bus: "scsi"},
+		},
+		{
+			name:    "name is required",
+			disk:    models.VMDiskConfig{Size: "10Gi"},
+			wantErr: "must be named",
+		},
+		{
+			name:    "only scsi can be hotplugged",
+			disk:    models.VMDiskConfig{Name: "scratch", Size: "10Gi", Bus: "virtio"},
+			wantErr: "must use the scsi bus",
+		},
+		{
+			name:    "source images are root-disk only",
+			disk:    models.VMDiskConfig{Name: "scratch", Size: "10Gi", SourceImage: "quay.io/x/y"},
+			wantErr: "only supported on the root disk",
+		},
+		{
+			name:    "size must be a valid quantity",
+			disk:    models.VMDiskConfig{Name: "scratch", Size: "lots"},
+			wantErr: "not a valid quantity",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHotplugDisk(&tt.disk)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.True(t, models.IsInvalidVMShapeError(err))
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildVirtualMachineDataDiskBus(t *testing.T) {
+	vmConfig := &models.VMConfig{
+		Os:  "fedora",
+		Ram: 2,
+		Cpu: 1,
+		DataDisks: []models.VMDiskConfig{
+			{Name: "logs", Size: "10Gi"},
+			{Name: "scratch", Size: "5Gi", Bus: "scsi"},
+		},
+	}
+
+	vm := buildVirtualMachine("devbox", "default", vmConfig, map[string]string{}, map[string]string{}, "", "")
+	disks := vm.Spec.Template.Spec.Domain.Devices.Disks
+
+	// Root + cloud-init + the two data disks; bus defaults to virtio
+	require.Len(t, disks, 4)
+	assert.Equal(t, kubevirtv1.DiskBusVirtio, disks[2].Disk.Bus)
+	assert.Equal(t, kubevirtv1.DiskBusSCSI, disks[3].Disk.Bus)
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// DeploymentDrift compares the spec recorded at the last write against the
+// live Kubernetes objects and reports the fields that no longer match:
+// replicas changed by an autoscaler, an image mutated manually, or a VM
+// resized out-of-band.
+func (d *DeploymentService) DeploymentDrift(ctx context.Context, id string) (*models.DriftResponse, error) {
+	deployment, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.DriftResponse{
+		ID:     id,
+		Kind:   deployment.Kind,
+		Fields: []models.DriftedField{},
+	}
+	if deployment.Spec == nil {
+		response.Message = "no recorded spec to compare against"
+		return response, nil
+	}
+
+	raw, err := json.Marshal(deployment.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode recorded spec: %w", err)
+	}
+	recorded, err := models.ParseSpec(deployment.Kind, raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse recorded spec: %w", err)
+	}
+
+	namespace := deployment.Metadata.Namespace
+	switch deployment.Kind {
+	case models.DeploymentKindContainer:
+		spec := recorded.(models.ContainerSpec)
+		response.Fields, err = d.containerService.DriftFields(ctx, id, namespace, &spec)
+	case models.DeploymentKindStatefulSet:
+		spec := recorded.(models.StatefulSetSpec)
+		response.Fields, err = d.statefulSetService.DriftFields(ctx, id, namespace, &spec)
+	case models.DeploymentKindVM:
+		spec := recorded.(models.VMSpec)
+		response.Fields, err = d.vmService.DriftFields(ctx, id, namespace, &spec)
+	default:
+		response.Message = fmt.Sprintf("drift detection is not supported for %s deployments", deployment.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if response.Fields == nil {
+		response.Fields = []models.DriftedField{}
+	}
+
+	response.Drifted = len(response.Fields) > 0
+	return response, nil
+}
+
+// DriftFields compares the recorded container spec against the live
+// Deployment and reports the fields that no longer match
+func (c *ContainerService) DriftFields(ctx context.Context, id, namespace string, recorded *models.ContainerSpec) ([]models.DriftedField, error) {
+	deployments, err := c.readClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment: %w", err)
+	}
+	if len(deployments.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+
+	live := deployments.Items[0]
+	return containerDriftFields("spec.container", &recorded.Container, live.Spec.Replicas, &live.Spec.Template.Spec), nil
+}
+
+// DriftFields compares the recorded stateful workload spec against the live
+// StatefulSet and reports the fields that no longer match
+func (s *StatefulSetService) DriftFields(ctx context.Context, id, namespace string, recorded *models.StatefulSetSpec) ([]models.DriftedField, error) {
+	statefulSets, err := s.readClient.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statefulset: %w", err)
+	}
+	if len(statefulSets.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+
+	live := statefulSets.Items[0]
+	return containerDriftFields("spec.statefulset", &recorded.StatefulSet.ContainerConfig, live.Spec.Replicas, &live.Spec.Template.Spec), nil
+}
+
+// DriftFields compares the recorded VM shape against the live VirtualMachine
+// and reports CPU or RAM resized out-of-band
+func (v *VMService) DriftFields(ctx context.Context, id, namespace string, recorded *models.VMSpec) ([]models.DriftedField, error) {
+	if err := v.ensureAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	// A recorded flavor reference is expanded to the concrete shape it named
+	// so the comparison sees the same numbers the create did
+	if err := v.applyFlavor(&recorded.VM); err != nil {
+		return nil, err
+	}
+
+	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get virtual machine: %w", err)
+	}
+	if len(vms.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+
+	live := vms.Items[0]
+	if live.Spec.Template == nil {
+		return nil, nil
+	}
+
+	var fields []models.DriftedField
+	domain := live.Spec.Template.Spec.Domain
+	if domain.CPU != nil && vmGuestVCPUs(domain.CPU) != recorded.VM.Cpu {
+		fields = append(fields, models.DriftedField{
+			Field:    "spec.vm.cpu",
+			Recorded: strconv.Itoa(recorded.VM.Cpu),
+			Live:     strconv.Itoa(vmGuestVCPUs(domain.CPU)),
+		})
+	}
+	if domain.Memory != nil && domain.Memory.Guest != nil {
+		want := resource.MustParse(fmt.Sprintf("%dGi", recorded.VM.Ram))
+		if want.Cmp(*domain.Memory.Guest) != 0 {
+			fields = append(fields, models.DriftedField{
+				Field:    "spec.vm.ram",
+				Recorded: want.String(),
+				Live:     domain.Memory.Guest.String(),
+			})
+		}
+	}
+	return fields, nil
+}
+
+// vmGuestVCPUs counts the vCPUs a CPU topology presents to the guest. Zeroed
+// dimensions default to 1, matching KubeVirt's interpretation.
+func vmGuestVCPUs(cpu *kubevirtv1.CPU) int {
+	count := 1
+	for _, dimension := range []uint32{cpu.Sockets, cpu.Cores, cpu.Threads} {
+		if dimension > 0 {
+			count *= int(dimension)
+		}
+	}
+	return count
+}
+
+// containerDriftFields reports the differences between a recorded container
+// configuration and the live pod template it rendered: replica count, image,
+// and resource requests. Field paths are reported relative to prefix so
+// container and statefulset deployments name their own spec sections.
+func containerDriftFields(prefix string, recorded *models.ContainerConfig, liveReplicas *int32, podSpec *corev1.PodSpec) []models.DriftedField {
+	var fields []models.DriftedField
+
+	wantReplicas := ptr.Deref(recorded.Replicas, 1)
+	haveReplicas := int(ptr.Deref(liveReplicas, 1))
+	if wantReplicas != haveReplicas {
+		fields = append(fields, models.DriftedField{
+			Field:    prefix + ".replicas",
+			Recorded: strconv.Itoa(wantReplicas),
+			Live:     strconv.Itoa(haveReplicas),
+		})
+	}
+
+	if len(podSpec.Containers) == 0 {
+		return fields
+	}
+	container := podSpec.Containers[0]
+
+	if container.Image != recorded.Image {
+		fields = append(fields, models.DriftedField{
+			Field:    prefix + ".image",
+			Recorded: recorded.Image,
+			Live:     container.Image,
+		})
+	}
+
+	if recorded.Resources != nil {
+		if live, drifted := quantityDrift(recorded.Resources.CPU, container.Resources.Requests[corev1.ResourceCPU]); drifted {
+			fields = append(fields, models.DriftedField{
+				Field:    prefix + ".resources.cpu",
+				Recorded: recorded.Resources.CPU,
+				Live:     live,
+			})
+		}
+		if live, drifted := quantityDrift(recorded.Resources.Memory, container.Resources.Requests[corev1.ResourceMemory]); drifted {
+			fields = append(fields, models.DriftedField{
+				Field:    prefix + ".resources.memory",
+				Recorded: recorded.Resources.Memory,
+				Live:     live,
+			})
+		}
+	}
+	return fields
+}
+
+// quantityDrift compares a recorded quantity string against the live request.
+// An empty or unparseable recorded value never counts as drift.
+func quantityDrift(recorded string, live resource.Quantity) (string, bool) {
+	if recorded == "" {
+		return "", false
+	}
+	want, err := resource.ParseQuantity(recorded)
+	if err != nil {
+		return "", false
+	}
+	if want.Cmp(live) == 0 {
+		return "", false
+	}
+	return live.String(), true
+}
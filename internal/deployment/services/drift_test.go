@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestContainerDriftFields(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	service := NewContainerService(client, client, config.WorkloadConfig{}, zap.NewNop())
+	id := "3f8e6c7a-1111-2222-3333-444455556666"
+
+	spec := models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image:    "nginx:1.25",
+			Replicas: ptr.To(2),
+		},
+	}
+	req := &models.DeploymentRequest{
+		Kind:     models.DeploymentKindContainer,
+		Metadata: models.Metadata{Name: "web", Namespace: "default"},
+		Spec:     spec,
+	}
+	require.NoError(t, service.CreateContainer(context.Background(), req, id))
+
+	// A freshly created deployment has no drift
+	fields, err := service.DriftFields(context.Background(), id, "default", &spec)
+	require.NoError(t, err)
+	assert.Empty(t, fields)
+
+	// Scale and retag the live Deployment out-of-band
+	live, err := client.AppsV1().Deployments("default").Get(context.Background(), "web-3f8e6c7a", metav1.GetOptions{})
+	require.NoError(t, err)
+	live.Spec.Replicas = ptr.To(int32(5))
+	live.Spec.Template.Spec.Containers[0].Image = "nginx:1.26"
+	_, err = client.AppsV1().Deployments("default").Update(context.Background(), live, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	fields, err = service.DriftFields(context.Background(), id, "default", &spec)
+	require.NoError(t, err)
+	require.Len(t, fields, 2)
+	assert.Equal(t, models.DriftedField{Field: "spec.container.replicas", Recorded: "2", Live: "5"}, fields[0])
+	assert.Equal(t, models.DriftedField{Field: "spec.container.image", Recorded: "nginx:1.25", Live: "nginx:1.26"}, fields[1])
+}
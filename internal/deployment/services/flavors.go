@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/flavors"
+)
+
+// SetFlavorCatalog replaces the built-in VM flavor catalog with
+// operator-provided definitions
+func (d *DeploymentService) SetFlavorCatalog(catalog *flavors.Catalog) {
+	d.flavorCatalog = catalog
+	d.vmService.SetFlavorCatalog(catalog)
+}
+
+// SetFlavorCatalog replaces the built-in VM flavor catalog
+func (v *VMService) SetFlavorCatalog(catalog *flavors.Catalog) {
+	v.flavorCatalog = catalog
+}
+
+// VMFlavors returns the flavor catalog VM specs can reference by name
+func (d *DeploymentService) VMFlavors(ctx context.Context) []flavors.Flavor {
+	if d.flavorCatalog == nil {
+		return flavors.Builtin().List()
+	}
+	return d.flavorCatalog.List()
+}
+
+// applyFlavor expands a catalog flavor reference into the concrete VM shape
+// before the shape limits are checked. A flavor cannot be combined with
+// explicit ram/cpu, and without one both must be given — the request binding
+// no longer enforces them so flavors can stand in.
+func (v *VMService) applyFlavor(vm *models.VMConfig) error {
+	if vm.Flavor == "" {
+		if vm.Ram == 0 || vm.Cpu == 0 {
+			return models.NewErrInvalidVMShape("ram and cpu are required when no flavor is specified")
+		}
+		return nil
+	}
+
+	if vm.Ram != 0 || vm.Cpu != 0 {
+		return models.NewErrInvalidVMShape("flavor cannot be combined with explicit ram or cpu")
+	}
+
+	catalog := v.flavorCatalog
+	if catalog == nil {
+		catalog = flavors.Builtin()
+	}
+	flavor, ok := catalog.Get(vm.Flavor)
+	if !ok {
+		return models.NewErrInvalidVMShape(fmt.Sprintf(
+			"unknown flavor %q: available flavors are %s", vm.Flavor, strings.Join(catalog.Names(), ", ")))
+	}
+
+	vm.Cpu = flavor.CPU
+	vm.Ram = flavor.RAM
+	if flavor.Disk != "" && vm.RootDisk == nil {
+		vm.RootDisk = &models.VMDiskConfig{Size: flavor.Disk}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestApplyFlavor(t *testing.T) {
+	service := &VMService{}
+
+	// A flavor reference expands to the catalog shape, including the root disk
+	vm := &models.VMConfig{Os: "fedora", Flavor: "medium"}
+	require.NoError(t, service.applyFlavor(vm))
+	assert.Equal(t, 2, vm.Cpu)
+	assert.Equal(t, 4, vm.Ram)
+	require.NotNil(t, vm.RootDisk)
+	assert.Equal(t, "20Gi", vm.RootDisk.Size)
+
+	// An explicit root disk wins over the flavor's
+	vm = &models.VMConfig{Os: "fedora", Flavor: "medium", RootDisk: &models.VMDiskConfig{Size: "50Gi"}}
+	require.NoError(t, service.applyFlavor(vm))
+	assert.Equal(t, "50Gi", vm.RootDisk.Size)
+
+	// Flavors cannot be combined with raw numbers
+	err := service.applyFlavor(&models.VMConfig{Os: "fedora", Flavor: "small", Cpu: 2})
+	assert.True(t, models.IsInvalidVMShapeError(err))
+
+	// Unknown flavors list the catalog
+	err = service.applyFlavor(&models.VMConfig{Os: "fedora", Flavor: "xlarge"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "large, medium, small")
+
+	// Without a flavor the raw numbers remain required
+	err = service.applyFlavor(&models.VMConfig{Os: "fedora", Cpu: 2})
+	assert.True(t, models.IsInvalidVMShapeError(err))
+
+	// Raw numbers alone pass through untouched
+	vm = &models.VMConfig{Os: "fedora", Cpu: 2, Ram: 4}
+	require.NoError(t, service.applyFlavor(vm))
+	assert.Nil(t, vm.RootDisk)
+}
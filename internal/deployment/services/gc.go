@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// SweepOrphans inspects the secondary objects carrying the managed-by label
+// and reports those whose deployment no longer has a primary object:
+// Services and Ingresses left behind after their Deployment failed to
+// delete, and secrets without a VirtualMachine. With remove set, the orphans
+// found are deleted as well.
+func (d *DeploymentService) SweepOrphans(ctx context.Context, remove bool) (*models.OrphanSweepResponse, error) {
+	logger := d.logger.Named("orphan_sweeper")
+
+	live, vmsKnown, err := d.liveDeploymentIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.OrphanSweepResponse{Orphans: []models.OrphanedResource{}}
+
+	// collect records one orphaned object, deleting it first when the sweep
+	// removes. Deletion failures are reported on the orphan and do not stop
+	// the sweep.
+	collect := func(resource, name, namespace, id string, removeFn func() error) {
+		orphan := models.OrphanedResource{
+			Resource:     resource,
+			Name:         name,
+			Namespace:    namespace,
+			DeploymentID: id,
+		}
+		if remove {
+			if err := removeFn(); err != nil {
+				logger.Warn("Failed to delete orphaned resource",
+					zap.String("resource", resource),
+					zap.String("name", name),
+					zap.String("namespace", namespace),
+					zap.Error(err))
+			} else {
+				orphan.Removed = true
+			}
+		}
+		response.Orphans = append(response.Orphans, orphan)
+	}
+
+	services, err := d.readClient.CoreV1().Services(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildManagedResourceSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list managed services: %w", err)
+	}
+	for i := range services.Items {
+		service := services.Items[i]
+		if live[service.Labels[models.LabelAppID]] {
+			continue
+		}
+		collect("service", service.Name, service.Namespace, service.Labels[models.LabelAppID], func() error {
+			return d.client.CoreV1().Services(service.Namespace).Delete(ctx, service.Name, metav1.DeleteOptions{})
+		})
+	}
+
+	ingresses, err := d.readClient.NetworkingV1().Ingresses(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildManagedResourceSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list managed ingresses: %w", err)
+	}
+	for i := range ingresses.Items {
+		ingress := ingresses.Items[i]
+		if live[ingress.Labels[models.LabelAppID]] {
+			continue
+		}
+		collect("ingress", ingress.Name, ingress.Namespace, ingress.Labels[models.LabelAppID], func() error {
+			return d.client.NetworkingV1().Ingresses(ingress.Namespace).Delete(ctx, ingress.Name, metav1.DeleteOptions{})
+		})
+	}
+
+	// VM secrets are only judged when VirtualMachines could be listed;
+	// otherwise every VM secret would read as orphaned while the KubeVirt
+	// backend is merely unavailable
+	if vmsKnown {
+		secrets, err := d.readClient.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			LabelSelector: models.BuildManagedResourceSelector(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list managed secrets: %w", err)
+		}
+		for i := range secrets.Items {
+			secret := secrets.Items[i]
+			if live[secret.Labels[models.LabelAppID]] {
+				continue
+			}
+			collect("secret", secret.Name, secret.Namespace, secret.Labels[models.LabelAppID], func() error {
+				return d.client.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+			})
+		}
+	}
+
+	logger.Info("Swept managed resources for orphans",
+		zap.Int("orphans", len(response.Orphans)),
+		zap.Bool("removed", remove))
+	return response, nil
+}
+
+// liveDeploymentIDs collects the deployment IDs that still have a primary
+// object: a Deployment, StatefulSet, Job, CronJob, VirtualMachine or a
+// manifest record. The second result reports whether VirtualMachines could
+// be listed; without them VM secrets cannot be judged and are skipped.
+func (d *DeploymentService) liveDeploymentIDs(ctx context.Context) (map[string]bool, bool, error) {
+	live := make(map[string]bool)
+	addID := func(labels map[string]string) {
+		if id := labels[models.LabelAppID]; id != "" {
+			live[id] = true
+		}
+	}
+	managed := metav1.ListOptions{LabelSelector: models.BuildManagedResourceSelector()}
+
+	deployments, err := d.readClient.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, managed)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list managed deployments: %w", err)
+	}
+	for i := range deployments.Items {
+		addID(deployments.Items[i].Labels)
+	}
+
+	statefulSets, err := d.readClient.AppsV1().StatefulSets(metav1.NamespaceAll).List(ctx, managed)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list managed statefulsets: %w", err)
+	}
+	for i := range statefulSets.Items {
+		addID(statefulSets.Items[i].Labels)
+	}
+
+	jobs, err := d.readClient.BatchV1().Jobs(metav1.NamespaceAll).List(ctx, managed)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list managed jobs: %w", err)
+	}
+	for i := range jobs.Items {
+		addID(jobs.Items[i].Labels)
+	}
+
+	cronJobs, err := d.readClient.BatchV1().CronJobs(metav1.NamespaceAll).List(ctx, managed)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list managed cronjobs: %w", err)
+	}
+	for i := range cronJobs.Items {
+		addID(cronJobs.Items[i].Labels)
+	}
+
+	// Manifest deployments have no single primary object; their record
+	// ConfigMaps stand in for one
+	manifestRecords, err := d.readClient.CoreV1().ConfigMaps(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: managedManifestRecordSelector(),
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list manifest records: %w", err)
+	}
+	for i := range manifestRecords.Items {
+		addID(manifestRecords.Items[i].Labels)
+	}
+
+	vmsKnown := false
+	if d.vmService != nil {
+		if ids, err := d.vmService.listVMIDs(ctx); err == nil {
+			for id := range ids {
+				live[id] = true
+			}
+			vmsKnown = true
+		}
+	}
+	return live, vmsKnown, nil
+}
+
+// StartOrphanSweeper runs periodic orphan sweeps in the background until the
+// context is cancelled. With remove set, each sweep deletes the orphans it
+// finds; otherwise they are only logged.
+func (d *DeploymentService) StartOrphanSweeper(ctx context.Context, interval time.Duration, remove bool) {
+	logger := d.logger.Named("orphan_sweeper")
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				response, err := d.SweepOrphans(ctx, remove)
+				if err != nil {
+					logger.Error("Orphan sweep failed", zap.Error(err))
+					continue
+				}
+				for _, orphan := range response.Orphans {
+					logger.Warn("Found orphaned resource",
+						zap.String("resource", orphan.Resource),
+						zap.String("name", orphan.Name),
+						zap.String("namespace", orphan.Namespace),
+						zap.String("deployment_id", orphan.DeploymentID),
+						zap.Bool("removed", orphan.Removed))
+				}
+			}
+		}
+	}()
+}
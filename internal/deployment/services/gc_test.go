@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestSweepOrphans(t *testing.T) {
+	liveID := "11111111-1111-1111-1111-111111111111"
+	goneID := "22222222-2222-2222-2222-222222222222"
+
+	client := fake.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name: "web", Namespace: "default",
+			Labels: models.BuildDeploymentLabels(liveID, "web"),
+		}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{
+			Name: "web-service", Namespace: "default",
+			Labels: models.BuildDeploymentLabels(liveID, "web"),
+		}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{
+			Name: "old-service", Namespace: "default",
+			Labels: models.BuildDeploymentLabels(goneID, "old"),
+		}},
+		&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{
+			Name: "old-ingress", Namespace: "default",
+			Labels: models.BuildDeploymentLabels(goneID, "old"),
+		}},
+	)
+	service := &DeploymentService{
+		client:     client,
+		readClient: client,
+		logger:     zap.NewNop(),
+	}
+
+	// A report-only sweep finds the leftovers but deletes nothing
+	response, err := service.SweepOrphans(context.Background(), false)
+	require.NoError(t, err)
+	require.Len(t, response.Orphans, 2)
+	for _, orphan := range response.Orphans {
+		assert.Equal(t, goneID, orphan.DeploymentID)
+		assert.False(t, orphan.Removed)
+	}
+	_, err = client.CoreV1().Services("default").Get(context.Background(), "old-service", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	// A removing sweep deletes the orphans and keeps the live service
+	response, err = service.SweepOrphans(context.Background(), true)
+	require.NoError(t, err)
+	require.Len(t, response.Orphans, 2)
+	for _, orphan := range response.Orphans {
+		assert.True(t, orphan.Removed)
+	}
+	_, err = client.CoreV1().Services("default").Get(context.Background(), "old-service", metav1.GetOptions{})
+	assert.Error(t, err)
+	_, err = client.NetworkingV1().Ingresses("default").Get(context.Background(), "old-ingress", metav1.GetOptions{})
+	assert.Error(t, err)
+	_, err = client.CoreV1().Services("default").Get(context.Background(), "web-service", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
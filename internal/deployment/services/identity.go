@@ -0,0 +1,27 @@
+package services
+
+import (
+	"context"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+)
+
+// stampCreator merges the caller identity from the request context into
+// create-time annotations so managed resources record who created them
+func stampCreator(ctx context.Context, annotations map[string]string) map[string]string {
+	creator, ok := identity.FromContext(ctx)
+	if !ok {
+		return annotations
+	}
+	return preserveCreator(annotations, creator)
+}
+
+// preserveCreator merges a known creator identity into annotations; updates
+// use it so the delete-and-recreate cycle keeps the original creator
+func preserveCreator(annotations map[string]string, creator *models.Creator) map[string]string {
+	for key, value := range models.BuildCreatorAnnotations(creator) {
+		annotations[key] = value
+	}
+	return annotations
+}
@@ -0,0 +1,513 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/spec"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// jobLogsExcerptLines bounds how many trailing log lines are captured into a
+// finished job's result excerpt
+const jobLogsExcerptLines = int64(20)
+
+// JobService handles one-off job deployment operations.
+// Writes go through client; read-heavy operations (get/list/watch/logs)
+// use readClient so they are throttled on a separate budget.
+type JobService struct {
+	client     kubernetes.Interface
+	readClient kubernetes.Interface
+	logger     *zap.Logger
+}
+
+// NewJobService creates a new job service instance
+func NewJobService(client, readClient kubernetes.Interface, logger *zap.Logger) *JobService {
+	return &JobService{
+		client:     client,
+		readClient: readClient,
+		logger:     logger,
+	}
+}
+
+// CreateJob creates a new job deployment
+func (j *JobService) CreateJob(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	now := time.Now()
+	return j.createJob(ctx, req, id, stampCreator(ctx, models.BuildTimestampAnnotations(now, now)))
+}
+
+// createJob creates the job resources with the provided timestamp annotations
+func (j *JobService) createJob(ctx context.Context, req *models.DeploymentRequest, id string, annotations map[string]string) error {
+	logger := j.logger.Named("job_service").With(zap.String("deployment_id", id))
+	logger.Info("Starting job deployment")
+
+	jobSpec, ok := req.Spec.(models.JobSpec)
+	if !ok {
+		return fmt.Errorf("invalid job spec format")
+	}
+
+	if err := validateEnvironment("spec.job.environment", jobSpec.Job.Environment); err != nil {
+		return err
+	}
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Create namespace if it doesn't exist
+	if err := j.ensureNamespace(ctx, namespace); err != nil {
+		return fmt.Errorf("failed to ensure namespace: %w", err)
+	}
+
+	// Persist ops notes and tags alongside the timestamps
+	models.ApplyOpsAnnotations(annotations, req.Metadata.Notes, req.Metadata.Tags)
+	models.MergeUserAnnotations(annotations, req.Metadata.Annotations)
+
+	// Persist the versioned spec so schema migrations can upgrade it later
+	if err := spec.Persist(annotations, req.Spec); err != nil {
+		return fmt.Errorf("failed to persist spec: %w", err)
+	}
+
+	job := buildJob(req.Metadata.Name, &jobSpec, req.Metadata.Labels, annotations, id)
+
+	if _, err := j.client.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create job: %w", err)
+	}
+
+	logger.Info("Successfully created job deployment")
+	return nil
+}
+
+// DryRunJob validates a job deployment by server-side dry-running the
+// generated Job, returning the rendered manifest without persisting anything.
+// The target namespace must already exist.
+func (j *JobService) DryRunJob(ctx context.Context, req *models.DeploymentRequest, id string) ([]interface{}, error) {
+	logger := j.logger.Named("job_service").With(zap.String("deployment_id", id))
+
+	jobSpec, ok := req.Spec.(models.JobSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid job spec format")
+	}
+
+	if err := validateEnvironment("spec.job.environment", jobSpec.Job.Environment); err != nil {
+		return nil, err
+	}
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	now := time.Now()
+	job := buildJob(req.Metadata.Name, &jobSpec, req.Metadata.Labels, models.BuildTimestampAnnotations(now, now), id)
+
+	rendered, err := j.client.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{
+		DryRun: []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("job dry-run failed: %w", err)
+	}
+
+	logger.Info("Dry-run validated job deployment")
+	return []interface{}{rendered}, nil
+}
+
+// GetJob retrieves job deployment information searching across all namespaces
+func (j *JobService) GetJob(ctx context.Context, id string) (*models.DeploymentResponse, error) {
+	logger := j.logger.Named("job_service").With(zap.String("deployment_id", id))
+
+	// Search across all namespaces using label selector
+	jobs, err := j.readClient.BatchV1().Jobs("").List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	if len(jobs.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+
+	job := jobs.Items[0]
+	response := j.buildResponse(ctx, id, &job)
+
+	// Surface the persisted spec, migrated to the current schema version
+	if persisted, err := spec.FromAnnotations(job.Annotations); err != nil {
+		logger.Warn("Failed to read persisted spec", zap.Error(err))
+	} else if persisted != nil {
+		response.Spec = persisted
+	}
+
+	logger.Info("Successfully retrieved job deployment")
+	return response, nil
+}
+
+// UpdateJob updates an existing job deployment
+func (j *JobService) UpdateJob(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	logger := j.logger.Named("job_service").With(zap.String("deployment_id", id))
+	logger.Info("Updating job deployment")
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Preserve the original creation time and creator across the delete-and-recreate cycle
+	createdAt := time.Now()
+	var creator *models.Creator
+	if existing, err := j.GetJob(ctx, id); err == nil {
+		createdAt = existing.CreatedAt
+		creator = existing.CreatedBy
+	}
+
+	// Job specs are largely immutable, so delete and recreate like the other kinds
+	if err := j.DeleteJob(ctx, id, namespace); err != nil {
+		logger.Warn("Failed to delete existing job during update", zap.Error(err))
+	}
+
+	return j.createJob(ctx, req, id, preserveCreator(models.BuildTimestampAnnotations(createdAt, time.Now()), creator))
+}
+
+// DeleteJob deletes a job deployment and its pods
+func (j *JobService) DeleteJob(ctx context.Context, id, namespace string) error {
+	logger := j.logger.Named("job_service").With(zap.String("deployment_id", id))
+	logger.Info("Deleting job deployment")
+
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Background propagation so the job's pods are cleaned up as well
+	propagation := metav1.DeletePropagationBackground
+	err := j.client.BatchV1().Jobs(namespace).DeleteCollection(ctx, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	}, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		logger.Error("Failed to delete job", zap.Error(err))
+		return fmt.Errorf("failed to delete job: %w", err)
+	}
+
+	logger.Info("Successfully deleted job deployment")
+	return nil
+}
+
+// PatchJobAnnotations applies a JSON merge patch to the annotations of the
+// Job object, leaving its spec untouched
+func (j *JobService) PatchJobAnnotations(ctx context.Context, id, namespace string, annotations map[string]interface{}) error {
+	jobs, err := j.readClient.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find job: %w", err)
+	}
+	if len(jobs.Items) == 0 {
+		return models.NewErrDeploymentNotFound(id)
+	}
+
+	patch, err := marshalAnnotationsPatch(annotations)
+	if err != nil {
+		return err
+	}
+
+	_, err = j.client.BatchV1().Jobs(namespace).Patch(ctx, jobs.Items[0].Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch job annotations: %w", err)
+	}
+	return nil
+}
+
+// ListJobs lists all job deployments
+func (j *JobService) ListJobs(ctx context.Context, namespace string, limit, offset int) ([]models.DeploymentResponse, error) {
+	logger := j.logger.Named("job_service")
+
+	// Use empty string to search all namespaces if namespace is not specified
+	// Filter only resources managed by this service
+	jobs, err := j.readClient.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildManagedResourceSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	var responses []models.DeploymentResponse
+	for i, job := range jobs.Items {
+		if i < offset {
+			continue
+		}
+		if len(responses) >= limit {
+			break
+		}
+
+		responses = append(responses, *j.buildResponse(ctx, job.Labels[models.LabelAppID], &job))
+	}
+
+	logger.Info("Successfully listed job deployments", zap.Int("count", len(responses)))
+	return responses, nil
+}
+
+// GetJobLogs streams pod logs for a job deployment
+func (j *JobService) GetJobLogs(ctx context.Context, id, namespace string, req *models.DeploymentLogsRequest) (io.ReadCloser, error) {
+	logger := j.logger.Named("job_service").With(zap.String("deployment_id", id))
+
+	// Find the pods backing this job
+	pods, err := j.readClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	if len(pods.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id, namespace)
+	}
+
+	// Prefer a running pod; finished jobs fall back to the first (completed) one
+	pod := pods.Items[0]
+	for _, candidate := range pods.Items {
+		if candidate.Status.Phase == corev1.PodRunning {
+			pod = candidate
+			break
+		}
+	}
+
+	logOptions := &corev1.PodLogOptions{
+		Container: req.Container,
+		Follow:    req.Follow,
+	}
+	if req.Tail > 0 {
+		logOptions.TailLines = &req.Tail
+	}
+	if req.Since != "" {
+		duration, err := time.ParseDuration(req.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since duration %q: %w", req.Since, err)
+		}
+		sinceSeconds := int64(duration.Seconds())
+		logOptions.SinceSeconds = &sinceSeconds
+	}
+
+	stream, err := j.readClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream logs for pod %s: %w", pod.Name, err)
+	}
+
+	logger.Info("Streaming job logs",
+		zap.String("pod", pod.Name),
+		zap.Bool("follow", req.Follow))
+	return stream, nil
+}
+
+// GetJobEvents returns the Kubernetes events related to a job deployment's
+// Job and pods
+func (j *JobService) GetJobEvents(ctx context.Context, id, namespace string) ([]models.DeploymentEvent, error) {
+	logger := j.logger.Named("job_service").With(zap.String("deployment_id", id))
+
+	names := make(map[string]bool)
+
+	jobs, err := j.readClient.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	for _, job := range jobs.Items {
+		names[job.Name] = true
+	}
+
+	pods, err := j.readClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	for _, pod := range pods.Items {
+		names[pod.Name] = true
+	}
+
+	events, err := listEventsForObjects(ctx, j.readClient, namespace, names)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Retrieved job deployment events", zap.Int("count", len(events)))
+	return events, nil
+}
+
+// WatchJob streams status updates for a job deployment using a Kubernetes watch
+func (j *JobService) WatchJob(ctx context.Context, id, namespace string) (<-chan models.DeploymentResponse, error) {
+	logger := j.logger.Named("job_service").With(zap.String("deployment_id", id))
+
+	watcher, err := j.readClient.BatchV1().Jobs(namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch job: %w", err)
+	}
+
+	updates := make(chan models.DeploymentResponse)
+	go func() {
+		defer close(updates)
+		defer watcher.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					logger.Debug("Job watch channel closed")
+					return
+				}
+
+				job, ok := event.Object.(*batchv1.Job)
+				if !ok {
+					continue
+				}
+
+				select {
+				case updates <- *j.buildResponse(ctx, id, job):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// buildResponse converts a Kubernetes Job into the deployment response model,
+// attaching the result once the job has finished
+func (j *JobService) buildResponse(ctx context.Context, id string, job *batchv1.Job) *models.DeploymentResponse {
+	createdAt, updatedAt := models.TimestampsFromAnnotations(job.Annotations, job.CreationTimestamp.Time)
+
+	phase := j.getJobPhase(job)
+	status := models.DeploymentStatus{
+		Phase:         phase,
+		ReadyReplicas: int(job.Status.Succeeded),
+	}
+
+	if phase == models.DeploymentPhaseSucceeded || phase == models.DeploymentPhaseFailed {
+		status.Result = j.jobResult(ctx, job)
+	}
+
+	return &models.DeploymentResponse{
+		ID:   id,
+		Kind: models.DeploymentKindJob,
+		Metadata: models.Metadata{
+			Name:        job.Name,
+			Namespace:   job.Namespace,
+			Labels:      job.Labels,
+			Annotations: models.UserAnnotations(job.Annotations),
+			Notes:       job.Annotations[models.AnnotationNotes],
+			Tags:        models.TagsFromAnnotations(job.Annotations),
+		},
+		Status:    status,
+		CreatedBy: models.CreatorFromAnnotations(job.Annotations),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// jobResult inspects a finished job's pods for the exit status and a trailing
+// logs excerpt, so callers can tell how a one-off task ended without keeping
+// the pods around themselves
+func (j *JobService) jobResult(ctx context.Context, job *batchv1.Job) *models.JobResult {
+	logger := j.logger.Named("job_service").With(zap.String("job", job.Name))
+
+	result := &models.JobResult{}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			result.Reason = condition.Reason
+		}
+	}
+
+	pods, err := j.readClient.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(job.Labels[models.LabelAppID]),
+	})
+	if err != nil || len(pods.Items) == 0 {
+		// The job outcome is still useful without pod-level detail
+		return result
+	}
+
+	pod := pods.Items[len(pods.Items)-1]
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if terminated := containerStatus.State.Terminated; terminated != nil {
+			exitCode := terminated.ExitCode
+			result.ExitCode = &exitCode
+			if result.Reason == "" {
+				result.Reason = terminated.Reason
+			}
+			break
+		}
+	}
+
+	tail := jobLogsExcerptLines
+	stream, err := j.readClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		TailLines: &tail,
+	}).Stream(ctx)
+	if err != nil {
+		logger.Debug("Failed to read job logs excerpt", zap.Error(err))
+		return result
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			logger.Debug("Failed to close job logs stream", zap.Error(err))
+		}
+	}()
+
+	excerpt, err := io.ReadAll(stream)
+	if err != nil {
+		logger.Debug("Failed to read job logs excerpt", zap.Error(err))
+		return result
+	}
+	result.LogsExcerpt = strings.TrimRight(string(excerpt), "\n")
+
+	return result
+}
+
+// ensureNamespace creates namespace if it doesn't exist
+func (j *JobService) ensureNamespace(ctx context.Context, namespace string) error {
+	_, err := j.client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+		}
+		_, err = j.client.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to create namespace %s: %w", namespace, err)
+		}
+	}
+	return nil
+}
+
+// getJobPhase determines the deployment phase from Kubernetes job status
+func (j *JobService) getJobPhase(job *batchv1.Job) models.DeploymentPhase {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobComplete && condition.Status == corev1.ConditionTrue {
+			return models.DeploymentPhaseSucceeded
+		}
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return models.DeploymentPhaseFailed
+		}
+	}
+
+	if job.Status.Active > 0 {
+		return models.DeploymentPhaseRunning
+	}
+	return models.DeploymentPhasePending
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// listEventsForObjects lists the namespace's Kubernetes events and keeps those
+// whose involved object is one of the named resources, mapped into the stable
+// response model and sorted oldest-first by last occurrence
+func listEventsForObjects(ctx context.Context, client kubernetes.Interface, namespace string, names map[string]bool) ([]models.DeploymentEvent, error) {
+	eventList, err := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	var events []models.DeploymentEvent
+	for _, event := range eventList.Items {
+		if !names[event.InvolvedObject.Name] {
+			continue
+		}
+
+		firstSeen := event.FirstTimestamp.Time
+		lastSeen := event.LastTimestamp.Time
+		if firstSeen.IsZero() {
+			firstSeen = event.EventTime.Time
+		}
+		if lastSeen.IsZero() {
+			lastSeen = firstSeen
+		}
+
+		count := event.Count
+		if count == 0 {
+			count = 1
+		}
+
+		events = append(events, models.DeploymentEvent{
+			Type:      event.Type,
+			Reason:    event.Reason,
+			Message:   event.Message,
+			Object:    fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+			Count:     count,
+			FirstSeen: firstSeen,
+			LastSeen:  lastSeen,
+		})
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastSeen.Before(events[j].LastSeen)
+	})
+
+	return events, nil
+}
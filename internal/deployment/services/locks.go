@@ -0,0 +1,49 @@
+package services
+
+import (
+	"sync"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// operationLocks serializes mutating operations per deployment ID so a
+// concurrent PUT and DELETE on the same deployment cannot interleave their
+// delete-and-recreate cycles. Conflicting operations fail fast with
+// ErrOperationInProgress instead of queueing.
+//
+// The locks are in-memory and cover a single provider replica; multi-replica
+// setups additionally need a store-backed lease, which this type is designed
+// to sit in front of.
+type operationLocks struct {
+	mu   sync.Mutex
+	held map[string]string // deployment ID -> operation holding the lock
+}
+
+// newOperationLocks creates an empty lock registry
+func newOperationLocks() *operationLocks {
+	return &operationLocks{
+		held: make(map[string]string),
+	}
+}
+
+// acquire takes the lock for a deployment ID on behalf of the named operation.
+// It returns ErrOperationInProgress if another operation already holds it.
+func (l *operationLocks) acquire(id, operation string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if holder, ok := l.held[id]; ok {
+		return models.NewErrOperationInProgress(id, holder)
+	}
+
+	l.held[id] = operation
+	return nil
+}
+
+// release frees the lock for a deployment ID
+func (l *operationLocks) release(id string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.held, id)
+}
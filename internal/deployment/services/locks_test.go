@@ -0,0 +1,39 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationLocks(t *testing.T) {
+	locks := newOperationLocks()
+
+	t.Run("acquire and release", func(t *testing.T) {
+		assert.NoError(t, locks.acquire("id-1", "update"))
+		locks.release("id-1")
+		assert.NoError(t, locks.acquire("id-1", "delete"))
+		locks.release("id-1")
+	})
+
+	t.Run("conflicting operation is rejected", func(t *testing.T) {
+		assert.NoError(t, locks.acquire("id-2", "update"))
+
+		err := locks.acquire("id-2", "delete")
+		assert.Error(t, err)
+		assert.True(t, models.IsOperationInProgressError(err))
+		assert.Contains(t, err.Error(), "update operation is already in progress")
+
+		locks.release("id-2")
+		assert.NoError(t, locks.acquire("id-2", "delete"))
+		locks.release("id-2")
+	})
+
+	t.Run("different IDs do not conflict", func(t *testing.T) {
+		assert.NoError(t, locks.acquire("id-3", "update"))
+		assert.NoError(t, locks.acquire("id-4", "update"))
+		locks.release("id-3")
+		locks.release("id-4")
+	})
+}
@@ -0,0 +1,590 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// manifestRecordKey is the ConfigMap data key holding the applied object list
+const manifestRecordKey = "objects"
+
+// manifestWatchInterval is the polling cadence for manifest deployment
+// watches; raw manifests span arbitrary resource types, so there is no
+// single resource to put a Kubernetes watch on
+const manifestWatchInterval = 5 * time.Second
+
+// manifestObjectRef identifies one object applied by a manifest deployment,
+// persisted in the tracking ConfigMap so the objects can be found again
+type manifestObjectRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
+// ManifestService handles raw manifest deployment operations. Objects are
+// applied through the dynamic client with the managed-by labels injected,
+// and a tracking ConfigMap records what was applied so the deployment stays
+// addressable by ID like the typed kinds.
+type ManifestService struct {
+	client        kubernetes.Interface
+	readClient    kubernetes.Interface
+	dynamicClient dynamic.Interface
+	mapper        meta.RESTMapper
+	logger        *zap.Logger
+}
+
+// NewManifestService creates a new manifest service instance
+func NewManifestService(client, readClient kubernetes.Interface, dynamicClient dynamic.Interface, mapper meta.RESTMapper, logger *zap.Logger) *ManifestService {
+	return &ManifestService{
+		client:        client,
+		readClient:    readClient,
+		dynamicClient: dynamicClient,
+		mapper:        mapper,
+		logger:        logger,
+	}
+}
+
+// CreateManifest creates a new raw manifest deployment
+func (m *ManifestService) CreateManifest(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	now := time.Now()
+	return m.createManifest(ctx, req, id, stampCreator(ctx, models.BuildTimestampAnnotations(now, now)))
+}
+
+// createManifest applies the manifest objects with the provided timestamp
+// annotations. A failed apply rolls back the objects created so far, so a
+// create never leaves a partial deployment behind.
+func (m *ManifestService) createManifest(ctx context.Context, req *models.DeploymentRequest, id string, annotations map[string]string) error {
+	logger := m.logger.Named("manifest_service").With(zap.String("deployment_id", id))
+	logger.Info("Starting manifest deployment")
+
+	manifestSpec, ok := req.Spec.(models.ManifestSpec)
+	if !ok {
+		return fmt.Errorf("invalid manifest spec format")
+	}
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	if err := validateManifestSpec(namespace, &manifestSpec); err != nil {
+		return err
+	}
+
+	// Create namespace if it doesn't exist
+	if err := m.ensureNamespace(ctx, namespace); err != nil {
+		return fmt.Errorf("failed to ensure namespace: %w", err)
+	}
+
+	// Persist ops notes and tags alongside the timestamps
+	models.ApplyOpsAnnotations(annotations, req.Metadata.Notes, req.Metadata.Tags)
+	models.MergeUserAnnotations(annotations, req.Metadata.Annotations)
+
+	objects := prepareManifestObjects(req.Metadata.Name, &manifestSpec, req.Metadata.Labels, annotations, id)
+
+	refs := make([]manifestObjectRef, 0, len(objects))
+	for _, obj := range objects {
+		resource, ref, err := m.resourceFor(obj, namespace)
+		if err != nil {
+			m.deleteRefs(ctx, refs, logger)
+			return err
+		}
+		if _, err := resource.Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+			m.deleteRefs(ctx, refs, logger)
+			return fmt.Errorf("failed to create %s %s: %w", ref.Kind, ref.Name, err)
+		}
+		refs = append(refs, ref)
+	}
+
+	record := buildManifestRecord(req.Metadata.Name, refs, req.Metadata.Labels, annotations, id)
+	if _, err := m.client.CoreV1().ConfigMaps(namespace).Create(ctx, record, metav1.CreateOptions{}); err != nil {
+		m.deleteRefs(ctx, refs, logger)
+		return fmt.Errorf("failed to create manifest record: %w", err)
+	}
+
+	logger.Info("Successfully created manifest deployment", zap.Int("objects", len(refs)))
+	return nil
+}
+
+// DryRunManifest validates a manifest deployment by server-side dry-running
+// every object, returning the rendered manifests without persisting anything.
+// The target namespace must already exist.
+func (m *ManifestService) DryRunManifest(ctx context.Context, req *models.DeploymentRequest, id string) ([]interface{}, error) {
+	logger := m.logger.Named("manifest_service").With(zap.String("deployment_id", id))
+
+	manifestSpec, ok := req.Spec.(models.ManifestSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid manifest spec format")
+	}
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	if err := validateManifestSpec(namespace, &manifestSpec); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	objects := prepareManifestObjects(req.Metadata.Name, &manifestSpec, req.Metadata.Labels, models.BuildTimestampAnnotations(now, now), id)
+
+	manifests := make([]interface{}, 0, len(objects))
+	for _, obj := range objects {
+		resource, ref, err := m.resourceFor(obj, namespace)
+		if err != nil {
+			return nil, err
+		}
+		rendered, err := resource.Create(ctx, obj, metav1.CreateOptions{
+			DryRun: []string{metav1.DryRunAll},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("dry-run failed for %s %s: %w", ref.Kind, ref.Name, err)
+		}
+		manifests = append(manifests, rendered)
+	}
+
+	logger.Info("Dry-run validated manifest deployment", zap.Int("objects", len(manifests)))
+	return manifests, nil
+}
+
+// GetManifest retrieves manifest deployment information searching across all namespaces
+func (m *ManifestService) GetManifest(ctx context.Context, id string) (*models.DeploymentResponse, error) {
+	logger := m.logger.Named("manifest_service").With(zap.String("deployment_id", id))
+
+	// Search across all namespaces using label selector
+	records, err := m.readClient.CoreV1().ConfigMaps("").List(ctx, metav1.ListOptions{
+		LabelSelector: manifestRecordSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manifest record: %w", err)
+	}
+
+	if len(records.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+
+	record := records.Items[0]
+	response := m.buildResponse(ctx, id, &record)
+
+	logger.Info("Successfully retrieved manifest deployment")
+	return response, nil
+}
+
+// UpdateManifest updates an existing manifest deployment
+func (m *ManifestService) UpdateManifest(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	logger := m.logger.Named("manifest_service").With(zap.String("deployment_id", id))
+	logger.Info("Updating manifest deployment")
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Preserve the original creation time and creator across the delete-and-recreate cycle
+	createdAt := time.Now()
+	var creator *models.Creator
+	if existing, err := m.GetManifest(ctx, id); err == nil {
+		createdAt = existing.CreatedAt
+		creator = existing.CreatedBy
+	}
+
+	// Delete and recreate like the other kinds; objects dropped from the new
+	// spec are removed along with the rest
+	if err := m.DeleteManifest(ctx, id, namespace); err != nil {
+		logger.Warn("Failed to delete existing manifest deployment during update", zap.Error(err))
+	}
+
+	return m.createManifest(ctx, req, id, preserveCreator(models.BuildTimestampAnnotations(createdAt, time.Now()), creator))
+}
+
+// DeleteManifest deletes every object applied by a manifest deployment along
+// with its tracking ConfigMap
+func (m *ManifestService) DeleteManifest(ctx context.Context, id, namespace string) error {
+	logger := m.logger.Named("manifest_service").With(zap.String("deployment_id", id))
+	logger.Info("Deleting manifest deployment")
+
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	record, err := m.recordConfigMap(ctx, id, namespace)
+	if err != nil {
+		return err
+	}
+
+	refs, err := recordRefs(record)
+	if err != nil {
+		return err
+	}
+	m.deleteRefs(ctx, refs, logger)
+
+	err = m.client.CoreV1().ConfigMaps(namespace).Delete(ctx, record.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		logger.Error("Failed to delete manifest record", zap.Error(err))
+		return fmt.Errorf("failed to delete manifest record: %w", err)
+	}
+
+	logger.Info("Successfully deleted manifest deployment")
+	return nil
+}
+
+// PatchManifestAnnotations applies a JSON merge patch to the annotations of
+// the tracking ConfigMap, which carries the ops metadata for the deployment
+func (m *ManifestService) PatchManifestAnnotations(ctx context.Context, id, namespace string, annotations map[string]interface{}) error {
+	record, err := m.recordConfigMap(ctx, id, namespace)
+	if err != nil {
+		return err
+	}
+
+	patch, err := marshalAnnotationsPatch(annotations)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.client.CoreV1().ConfigMaps(namespace).Patch(ctx, record.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch manifest record annotations: %w", err)
+	}
+	return nil
+}
+
+// ListManifests lists all manifest deployments
+func (m *ManifestService) ListManifests(ctx context.Context, namespace string, limit, offset int) ([]models.DeploymentResponse, error) {
+	logger := m.logger.Named("manifest_service")
+
+	// Use empty string to search all namespaces if namespace is not specified.
+	// The record label keeps other managed ConfigMaps out of the listing.
+	records, err := m.readClient.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: managedManifestRecordSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list manifest records: %w", err)
+	}
+
+	var responses []models.DeploymentResponse
+	for i, record := range records.Items {
+		if i < offset {
+			continue
+		}
+		if len(responses) >= limit {
+			break
+		}
+
+		responses = append(responses, *m.buildResponse(ctx, record.Labels[models.LabelAppID], &record))
+	}
+
+	logger.Info("Successfully listed manifest deployments", zap.Int("count", len(responses)))
+	return responses, nil
+}
+
+// GetManifestEvents returns the Kubernetes events related to the objects
+// applied by a manifest deployment in its namespace
+func (m *ManifestService) GetManifestEvents(ctx context.Context, id, namespace string) ([]models.DeploymentEvent, error) {
+	logger := m.logger.Named("manifest_service").With(zap.String("deployment_id", id))
+
+	record, err := m.recordConfigMap(ctx, id, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := recordRefs(record)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{record.Name: true}
+	for _, ref := range refs {
+		if ref.Namespace == namespace {
+			names[ref.Name] = true
+		}
+	}
+
+	events, err := listEventsForObjects(ctx, m.readClient, namespace, names)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Retrieved manifest deployment events", zap.Int("count", len(events)))
+	return events, nil
+}
+
+// WatchManifest streams status updates for a manifest deployment. The applied
+// objects span arbitrary resource types, so the status is polled rather than
+// driven by a Kubernetes watch; updates are emitted on phase changes.
+func (m *ManifestService) WatchManifest(ctx context.Context, id, namespace string) (<-chan models.DeploymentResponse, error) {
+	logger := m.logger.Named("manifest_service").With(zap.String("deployment_id", id))
+
+	if _, err := m.recordConfigMap(ctx, id, namespace); err != nil {
+		return nil, err
+	}
+
+	updates := make(chan models.DeploymentResponse)
+	go func() {
+		defer close(updates)
+
+		ticker := time.NewTicker(manifestWatchInterval)
+		defer ticker.Stop()
+
+		var lastPhase models.DeploymentPhase
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				record, err := m.recordConfigMap(ctx, id, namespace)
+				if err != nil {
+					logger.Debug("Manifest record gone, stopping watch")
+					return
+				}
+
+				response := m.buildResponse(ctx, id, record)
+				if response.Status.Phase == lastPhase {
+					continue
+				}
+				lastPhase = response.Status.Phase
+
+				select {
+				case updates <- *response:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// resourceFor resolves the dynamic client resource for an object via the
+// discovery mapper, pinning namespaced objects to the deployment namespace
+func (m *ManifestService) resourceFor(obj *unstructured.Unstructured, namespace string) (dynamic.ResourceInterface, manifestObjectRef, error) {
+	gvk := obj.GroupVersionKind()
+	ref := manifestObjectRef{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       gvk.Kind,
+		Name:       obj.GetName(),
+	}
+
+	mapping, err := m.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, ref, models.NewErrInvalidSpec("spec.manifests",
+			fmt.Sprintf("unknown kind %s in %s: the cluster does not serve this resource", gvk.Kind, obj.GetAPIVersion()))
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		obj.SetNamespace(namespace)
+		ref.Namespace = namespace
+		return m.dynamicClient.Resource(mapping.Resource).Namespace(namespace), ref, nil
+	}
+	return m.dynamicClient.Resource(mapping.Resource), ref, nil
+}
+
+// resourceForRef resolves the dynamic client resource for a recorded object
+func (m *ManifestService) resourceForRef(ref manifestObjectRef) (dynamic.ResourceInterface, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid apiVersion %q in manifest record: %w", ref.APIVersion, err)
+	}
+
+	mapping, err := m.mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: ref.Kind}, gv.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s %s: %w", ref.APIVersion, ref.Kind, err)
+	}
+
+	if ref.Namespace != "" {
+		return m.dynamicClient.Resource(mapping.Resource).Namespace(ref.Namespace), nil
+	}
+	return m.dynamicClient.Resource(mapping.Resource), nil
+}
+
+// deleteRefs deletes the recorded objects best-effort; objects already gone
+// are skipped and other failures are logged rather than aborting the cleanup
+func (m *ManifestService) deleteRefs(ctx context.Context, refs []manifestObjectRef, logger *zap.Logger) {
+	for _, ref := range refs {
+		resource, err := m.resourceForRef(ref)
+		if err != nil {
+			logger.Warn("Failed to resolve recorded object", zap.String("kind", ref.Kind), zap.String("name", ref.Name), zap.Error(err))
+			continue
+		}
+		if err := resource.Delete(ctx, ref.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			logger.Warn("Failed to delete applied object", zap.String("kind", ref.Kind), zap.String("name", ref.Name), zap.Error(err))
+		}
+	}
+}
+
+// recordConfigMap finds the tracking ConfigMap for a deployment in a namespace
+func (m *ManifestService) recordConfigMap(ctx context.Context, id, namespace string) (*corev1.ConfigMap, error) {
+	records, err := m.readClient.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: manifestRecordSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find manifest record: %w", err)
+	}
+	if len(records.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id, namespace)
+	}
+	return &records.Items[0], nil
+}
+
+// buildResponse converts a tracking ConfigMap into the deployment response
+// model. The phase reflects whether the applied objects still exist: running
+// when all are present, pending otherwise.
+func (m *ManifestService) buildResponse(ctx context.Context, id string, record *corev1.ConfigMap) *models.DeploymentResponse {
+	createdAt, updatedAt := models.TimestampsFromAnnotations(record.Annotations, record.CreationTimestamp.Time)
+
+	refs, err := recordRefs(record)
+	present := 0
+	if err == nil {
+		for _, ref := range refs {
+			resource, err := m.resourceForRef(ref)
+			if err != nil {
+				continue
+			}
+			if _, err := resource.Get(ctx, ref.Name, metav1.GetOptions{}); err == nil {
+				present++
+			}
+		}
+	}
+
+	phase := models.DeploymentPhasePending
+	if len(refs) > 0 && present == len(refs) {
+		phase = models.DeploymentPhaseRunning
+	}
+
+	return &models.DeploymentResponse{
+		ID:   id,
+		Kind: models.DeploymentKindManifest,
+		Metadata: models.Metadata{
+			Name:        record.Labels[models.LabelApp],
+			Namespace:   record.Namespace,
+			Labels:      record.Labels,
+			Annotations: models.UserAnnotations(record.Annotations),
+			Notes:       record.Annotations[models.AnnotationNotes],
+			Tags:        models.TagsFromAnnotations(record.Annotations),
+		},
+		Status: models.DeploymentStatus{
+			Phase:   phase,
+			Message: fmt.Sprintf("%d of %d objects present", present, len(refs)),
+		},
+		CreatedBy: models.CreatorFromAnnotations(record.Annotations),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// ensureNamespace creates namespace if it doesn't exist
+func (m *ManifestService) ensureNamespace(ctx context.Context, namespace string) error {
+	_, err := m.client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+		}
+		_, err = m.client.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to create namespace %s: %w", namespace, err)
+		}
+	}
+	return nil
+}
+
+// recordRefs parses the applied object list out of a tracking ConfigMap
+func recordRefs(record *corev1.ConfigMap) ([]manifestObjectRef, error) {
+	var refs []manifestObjectRef
+	if err := json.Unmarshal([]byte(record.Data[manifestRecordKey]), &refs); err != nil {
+		return nil, fmt.Errorf("corrupt manifest record %s: %w", record.Name, err)
+	}
+	return refs, nil
+}
+
+// prepareManifestObjects deep-copies the spec objects and injects the managed
+// labels and annotations into their metadata, leaving the rest untouched
+func prepareManifestObjects(name string, spec *models.ManifestSpec, labels, annotations map[string]string, id string) []*unstructured.Unstructured {
+	objects := make([]*unstructured.Unstructured, 0, len(spec.Manifests))
+	for _, manifest := range spec.Manifests {
+		obj := &unstructured.Unstructured{Object: runtime.DeepCopyJSON(manifest)}
+
+		merged := make(map[string]string)
+		for k, v := range obj.GetLabels() {
+			merged[k] = v
+		}
+		for k, v := range labels {
+			merged[k] = v
+		}
+		for k, v := range models.BuildDeploymentLabels(id, name) {
+			merged[k] = v
+		}
+		obj.SetLabels(merged)
+
+		objAnnotations := obj.GetAnnotations()
+		if objAnnotations == nil {
+			objAnnotations = make(map[string]string)
+		}
+		for k, v := range annotations {
+			objAnnotations[k] = v
+		}
+		obj.SetAnnotations(objAnnotations)
+
+		objects = append(objects, obj)
+	}
+	return objects
+}
+
+// validateManifestSpec rejects objects missing the identifying fields and
+// objects targeting a namespace other than the deployment's
+func validateManifestSpec(namespace string, spec *models.ManifestSpec) error {
+	seen := make(map[string]bool)
+	for i, manifest := range spec.Manifests {
+		field := fmt.Sprintf("spec.manifests[%d]", i)
+		obj := &unstructured.Unstructured{Object: manifest}
+
+		if obj.GetAPIVersion() == "" {
+			return models.NewErrInvalidSpec(field, "apiVersion is required")
+		}
+		if obj.GetKind() == "" {
+			return models.NewErrInvalidSpec(field, "kind is required")
+		}
+		if obj.GetName() == "" {
+			return models.NewErrInvalidSpec(field, "metadata.name is required")
+		}
+		if ns := obj.GetNamespace(); ns != "" && ns != namespace {
+			return models.NewErrInvalidSpec(field,
+				fmt.Sprintf("namespace %q does not match the deployment namespace %q", ns, namespace))
+		}
+
+		key := fmt.Sprintf("%s/%s/%s", obj.GetAPIVersion(), obj.GetKind(), obj.GetName())
+		if seen[key] {
+			return models.NewErrInvalidSpec(field, fmt.Sprintf("duplicate object %s %q", obj.GetKind(), obj.GetName()))
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// manifestRecordSelector selects the tracking ConfigMap of one manifest deployment
+func manifestRecordSelector(id string) string {
+	return fmt.Sprintf("%s,%s=true", models.BuildDeploymentSelector(id), models.LabelManifestRecord)
+}
+
+// managedManifestRecordSelector selects the tracking ConfigMaps of all manifest deployments
+func managedManifestRecordSelector() string {
+	return fmt.Sprintf("%s,%s=true", models.BuildManagedResourceSelector(), models.LabelManifestRecord)
+}
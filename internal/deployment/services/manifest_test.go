@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestValidateManifestSpec(t *testing.T) {
+	tests := []struct {
+		name        string
+		manifests   []map[string]interface{}
+		expectError bool
+		contains    string
+	}{
+		{
+			name: "valid objects",
+			manifests: []map[string]interface{}{
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "app-config"}},
+				{"apiVersion": "v1", "kind": "Secret", "metadata": map[string]interface{}{"name": "app-secret", "namespace": "default"}},
+			},
+		},
+		{
+			name: "missing apiVersion",
+			manifests: []map[string]interface{}{
+				{"kind": "ConfigMap", "metadata": map[string]interface{}{"name": "app-config"}},
+			},
+			expectError: true,
+			contains:    "apiVersion is required",
+		},
+		{
+			name: "missing kind",
+			manifests: []map[string]interface{}{
+				{"apiVersion": "v1", "metadata": map[string]interface{}{"name": "app-config"}},
+			},
+			expectError: true,
+			contains:    "kind is required",
+		},
+		{
+			name: "missing name",
+			manifests: []map[string]interface{}{
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{}},
+			},
+			expectError: true,
+			contains:    "metadata.name is required",
+		},
+		{
+			name: "foreign namespace",
+			manifests: []map[string]interface{}{
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "app-config", "namespace": "other"}},
+			},
+			expectError: true,
+			contains:    `namespace "other" does not match`,
+		},
+		{
+			name: "duplicate object",
+			manifests: []map[string]interface{}{
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "app-config"}},
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "app-config"}},
+			},
+			expectError: true,
+			contains:    `duplicate object ConfigMap "app-config"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateManifestSpec("default", &models.ManifestSpec{Manifests: tt.manifests})
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.True(t, models.IsInvalidSpecError(err))
+				assert.Contains(t, err.Error(), tt.contains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManifestServiceLifecycle(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(kscheme.Scheme)
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+
+	service := NewManifestService(client, client, dynamicClient, mapper, zap.NewNop())
+	id := "0e7c6c7a-1111-2222-3333-444455556666"
+
+	req := &models.DeploymentRequest{
+		Kind: models.DeploymentKindManifest,
+		Metadata: models.Metadata{
+			Name:      "raw",
+			Namespace: "default",
+		},
+		Spec: models.ManifestSpec{
+			Manifests: []map[string]interface{}{
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "app-config"}},
+			},
+		},
+	}
+
+	require.NoError(t, service.CreateManifest(context.Background(), req, id))
+
+	// The applied object carries the deployment labels
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	applied, err := dynamicClient.Resource(gvr).Namespace("default").Get(context.Background(), "app-config", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, id, applied.GetLabels()[models.LabelAppID])
+	assert.Equal(t, models.LabelValueManagedBy, applied.GetLabels()[models.LabelManagedBy])
+
+	// The deployment is addressable by ID and reports all objects present
+	response, err := service.GetManifest(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, models.DeploymentKindManifest, response.Kind)
+	assert.Equal(t, "raw", response.Metadata.Name)
+	assert.Equal(t, models.DeploymentPhaseRunning, response.Status.Phase)
+
+	// Delete removes the applied objects and the record
+	require.NoError(t, service.DeleteManifest(context.Background(), id, "default"))
+	_, err = dynamicClient.Resource(gvr).Namespace("default").Get(context.Background(), "app-config", metav1.GetOptions{})
+	assert.Error(t, err)
+	_, err = service.GetManifest(context.Background(), id)
+	assert.True(t, models.IsNotFoundError(err))
+}
@@ -0,0 +1,977 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/netid"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+// Manifest builders translate deployment specs into Kubernetes objects.
+// They are pure functions of their inputs so spec-to-manifest regressions
+// can be caught by golden-file tests without a cluster.
+
+// Bound service account token projection for container deployments
+const (
+	saTokenVolumeName           = "bound-sa-token"
+	saTokenMountPath            = "/var/run/secrets/tokens"
+	saTokenPath                 = "token"
+	defaultSATokenExpirySeconds = int64(3600)
+)
+
+// buildContainerDeployment builds the Kubernetes Deployment for a container
+// deployment. disableSATokenAutomount applies the operator policy that keeps
+// the legacy service account token out of tenant pods.
+func buildContainerDeployment(name string, spec *models.ContainerSpec, labels, annotations map[string]string, id string, disableSATokenAutomount bool) *appsv1.Deployment {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	// Merge user labels with deployment labels
+	deploymentLabels := models.BuildDeploymentLabels(id, name)
+	for k, v := range deploymentLabels {
+		labels[k] = v
+	}
+
+	replicas := int32(ptr.Deref(spec.Container.Replicas, 1)) // #nosec G115
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%s", name, id[:8]),
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: models.BuildDeploymentLabels(id, name),
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: models.BuildDeploymentLabels(id, name),
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:       name,
+							Image:      spec.Container.Image,
+							Command:    spec.Container.Command,
+							Args:       spec.Container.Args,
+							WorkingDir: spec.Container.WorkingDir,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Secondary Multus interfaces are requested through the pod annotation
+	if len(spec.Container.Networks) > 0 {
+		deployment.Spec.Template.Annotations = map[string]string{
+			multusNetworksAnnotation: buildNetworksAnnotation(spec.Container.Networks),
+		}
+	}
+
+	// Caller annotations also land on the pod template, where integrations
+	// like Prometheus scraping or sidecar injectors read them
+	for key, value := range models.UserAnnotations(annotations) {
+		if deployment.Spec.Template.Annotations == nil {
+			deployment.Spec.Template.Annotations = make(map[string]string)
+		}
+		deployment.Spec.Template.Annotations[key] = value
+	}
+
+	// Pull Secrets for private registries: existing Secrets referenced by
+	// name, plus the one materialized from inline credentials when given
+	for _, pullSecret := range spec.Container.ImagePullSecrets {
+		deployment.Spec.Template.Spec.ImagePullSecrets = append(deployment.Spec.Template.Spec.ImagePullSecrets,
+			corev1.LocalObjectReference{Name: pullSecret})
+	}
+	if spec.Container.RegistryCredentials != nil {
+		deployment.Spec.Template.Spec.ImagePullSecrets = append(deployment.Spec.Template.Spec.ImagePullSecrets,
+			corev1.LocalObjectReference{Name: pullSecretName(name, id)})
+	}
+
+	// Add ports if specified
+	if len(spec.Container.Ports) > 0 {
+		var containerPorts []corev1.ContainerPort
+		for _, port := range spec.Container.Ports {
+			containerPorts = append(containerPorts, corev1.ContainerPort{
+				ContainerPort: int32(port.ContainerPort), // #nosec G115
+				Protocol:      corev1.ProtocolTCP,
+			})
+		}
+		deployment.Spec.Template.Spec.Containers[0].Ports = containerPorts
+	}
+
+	// Add resources if specified
+	if spec.Container.Resources != nil {
+		deployment.Spec.Template.Spec.Containers[0].Resources = buildResourceRequirements(spec.Container.Resources)
+	}
+
+	// Add environment variables if specified
+	if len(spec.Container.Environment) > 0 {
+		deployment.Spec.Template.Spec.Containers[0].Env = buildEnvVars(spec.Container.Environment)
+	}
+
+	// Inject Secret/ConfigMap environments if specified
+	if len(spec.Container.EnvFrom) > 0 {
+		deployment.Spec.Template.Spec.Containers[0].EnvFrom = buildEnvFromSources(spec.Container.EnvFrom)
+	}
+
+	// Init containers, sidecars and the shared emptyDir volumes their
+	// mounts name
+	applyExtraContainers(&deployment.Spec.Template.Spec, &spec.Container)
+
+	// Node placement: selector, co-scheduling rules and tolerations
+	applyPodPlacement(&deployment.Spec.Template.Spec, spec.Container.Placement)
+
+	// Security hardening for every container in the pod
+	applySecurityContext(&deployment.Spec.Template.Spec, spec.Container.Security)
+
+	// Keep the legacy automounted token out of tenant pods; API access is
+	// opted into per request via a bound projected token below
+	podSpec := &deployment.Spec.Template.Spec
+	if disableSATokenAutomount || spec.Container.ServiceAccountToken != nil {
+		podSpec.AutomountServiceAccountToken = ptr.To(false)
+	}
+
+	if tokenConfig := spec.Container.ServiceAccountToken; tokenConfig != nil {
+		expiry := ptr.Deref(tokenConfig.ExpirationSeconds, defaultSATokenExpirySeconds)
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: saTokenVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{
+							ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+								Audience:          tokenConfig.Audience,
+								ExpirationSeconds: &expiry,
+								Path:              saTokenPath,
+							},
+						},
+					},
+				},
+			},
+		})
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      saTokenVolumeName,
+			MountPath: saTokenMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	return deployment
+}
+
+// buildContainerService builds the Kubernetes Service exposing a container deployment
+func buildContainerService(name string, spec *models.ContainerSpec, labels, annotations map[string]string, id string) *corev1.Service {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	// Merge user labels with deployment labels
+	deploymentLabels := models.BuildDeploymentLabels(id, name)
+	for k, v := range deploymentLabels {
+		labels[k] = v
+	}
+
+	var servicePorts []corev1.ServicePort
+	for _, port := range spec.Container.Ports {
+		servicePort := int32(port.ContainerPort) // #nosec G115
+		if port.ServicePort > 0 {
+			servicePort = int32(port.ServicePort) // #nosec G115
+		}
+
+		servicePorts = append(servicePorts, corev1.ServicePort{
+			Port:       servicePort,
+			TargetPort: intstr.FromInt(port.ContainerPort),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+
+	serviceType := corev1.ServiceTypeNodePort
+	if serviceConfig := spec.Container.Service; serviceConfig != nil {
+		if serviceConfig.Type != "" {
+			serviceType = corev1.ServiceType(serviceConfig.Type)
+		}
+		// Merge user service annotations under the provider annotations so
+		// timestamps and creator stamps cannot be overridden
+		if len(serviceConfig.Annotations) > 0 {
+			merged := make(map[string]string, len(annotations)+len(serviceConfig.Annotations))
+			for k, v := range serviceConfig.Annotations {
+				merged[k] = v
+			}
+			for k, v := range annotations {
+				merged[k] = v
+			}
+			annotations = merged
+		}
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-service-%s", name, id[:8]),
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: models.BuildDeploymentLabels(id, name),
+			Ports:    servicePorts,
+			Type:     serviceType,
+		},
+	}
+}
+
+// buildContainerIngress builds the Ingress routing external traffic for a
+// container deployment to its Service on the first declared port
+func buildContainerIngress(name string, spec *models.ContainerSpec, labels, annotations map[string]string, id string) *networkingv1.Ingress {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	// Merge user labels with deployment labels
+	deploymentLabels := models.BuildDeploymentLabels(id, name)
+	for k, v := range deploymentLabels {
+		labels[k] = v
+	}
+
+	ingressConfig := spec.Container.Ingress
+
+	path := ingressConfig.Path
+	if path == "" {
+		path = "/"
+	}
+
+	port := spec.Container.Ports[0]
+	servicePort := int32(port.ContainerPort) // #nosec G115
+	if port.ServicePort > 0 {
+		servicePort = int32(port.ServicePort) // #nosec G115
+	}
+
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-ingress-%s", name, id[:8]),
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: ingressConfig.Host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     path,
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: fmt.Sprintf("%s-service-%s", name, id[:8]),
+											Port: networkingv1.ServiceBackendPort{Number: servicePort},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if ingressConfig.ClassName != "" {
+		ingress.Spec.IngressClassName = ptr.To(ingressConfig.ClassName)
+	}
+
+	if ingressConfig.TLSSecret != "" {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{
+			{
+				Hosts:      []string{ingressConfig.Host},
+				SecretName: ingressConfig.TLSSecret,
+			},
+		}
+	}
+
+	return ingress
+}
+
+// buildJob builds the Kubernetes Job for a one-off job deployment
+func buildJob(name string, spec *models.JobSpec, labels, annotations map[string]string, id string) *batchv1.Job {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	// Merge user labels with deployment labels
+	deploymentLabels := models.BuildDeploymentLabels(id, name)
+	for k, v := range deploymentLabels {
+		labels[k] = v
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%s", name, id[:8]),
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: batchv1.JobSpec{
+			Completions:           spec.Job.Completions,
+			Parallelism:           spec.Job.Parallelism,
+			ActiveDeadlineSeconds: spec.Job.ActiveDeadlineSeconds,
+			BackoffLimit:          spec.Job.BackoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: models.BuildDeploymentLabels(id, name),
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:    name,
+							Image:   spec.Job.Image,
+							Command: spec.Job.Command,
+							Args:    spec.Job.Args,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Add resources if specified
+	if spec.Job.Resources != nil {
+		resources := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{},
+		}
+
+		if spec.Job.Resources.CPU != "" {
+			if cpu, err := resource.ParseQuantity(spec.Job.Resources.CPU); err == nil {
+				resources.Requests[corev1.ResourceCPU] = cpu
+			}
+		}
+
+		if spec.Job.Resources.Memory != "" {
+			if memory, err := resource.ParseQuantity(spec.Job.Resources.Memory); err == nil {
+				resources.Requests[corev1.ResourceMemory] = memory
+			}
+		}
+
+		addResourceLimits(&resources, spec.Job.Resources)
+		addExtendedResources(&resources, spec.Job.Resources)
+
+		job.Spec.Template.Spec.Containers[0].Resources = resources
+	}
+
+	// Add environment variables if specified
+	if len(spec.Job.Environment) > 0 {
+		job.Spec.Template.Spec.Containers[0].Env = buildEnvVars(spec.Job.Environment)
+	}
+
+	return job
+}
+
+// buildCronJob builds the Kubernetes CronJob for a cronjob deployment. The
+// job template is rendered through buildJob so scheduled runs get the same
+// pod shape, labels and resource handling as one-off jobs.
+func buildCronJob(name string, spec *models.CronJobSpec, labels, annotations map[string]string, id string) *batchv1.CronJob {
+	job := buildJob(name, &models.JobSpec{Job: spec.CronJob.JobConfig}, labels, annotations, id)
+
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        job.Name,
+			Labels:      job.Labels,
+			Annotations: annotations,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   spec.CronJob.Schedule,
+			StartingDeadlineSeconds:    spec.CronJob.StartingDeadlineSeconds,
+			Suspend:                    spec.CronJob.Suspend,
+			SuccessfulJobsHistoryLimit: spec.CronJob.SuccessfulJobsHistoryLimit,
+			FailedJobsHistoryLimit:     spec.CronJob.FailedJobsHistoryLimit,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: models.BuildDeploymentLabels(id, name),
+				},
+				Spec: job.Spec,
+			},
+		},
+	}
+
+	if spec.CronJob.ConcurrencyPolicy != "" {
+		cronJob.Spec.ConcurrencyPolicy = batchv1.ConcurrencyPolicy(spec.CronJob.ConcurrencyPolicy)
+	}
+
+	return cronJob
+}
+
+// buildStatefulSet builds the Kubernetes StatefulSet for a stateful workload.
+// The pod template is rendered through buildContainerDeployment so replicas
+// get the same pod shape, labels and resource handling as container
+// deployments; volume claim templates give each replica its own storage and
+// the default OrderedReady policy rolls replicas out one at a time.
+func buildStatefulSet(name string, spec *models.StatefulSetSpec, labels, annotations map[string]string, id string, disableSATokenAutomount bool) *appsv1.StatefulSet {
+	deployment := buildContainerDeployment(name, &models.ContainerSpec{Container: spec.StatefulSet.ContainerConfig}, labels, annotations, id, disableSATokenAutomount)
+
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        deployment.Name,
+			Labels:      deployment.Labels,
+			Annotations: annotations,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    deployment.Spec.Replicas,
+			Selector:    deployment.Spec.Selector,
+			ServiceName: statefulSetHeadlessServiceName(name, id),
+			Template:    deployment.Spec.Template,
+		},
+	}
+
+	for _, claim := range spec.StatefulSet.VolumeClaims {
+		accessMode := corev1.ReadWriteOnce
+		if claim.AccessMode != "" {
+			accessMode = corev1.PersistentVolumeAccessMode(claim.AccessMode)
+		}
+
+		pvc := corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   claim.Name,
+				Labels: models.BuildDeploymentLabels(id, name),
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse(claim.Size),
+					},
+				},
+			},
+		}
+		if claim.StorageClass != "" {
+			pvc.Spec.StorageClassName = ptr.To(claim.StorageClass)
+		}
+		statefulSet.Spec.VolumeClaimTemplates = append(statefulSet.Spec.VolumeClaimTemplates, pvc)
+
+		statefulSet.Spec.Template.Spec.Containers[0].VolumeMounts = append(
+			statefulSet.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      claim.Name,
+				MountPath: claim.MountPath,
+			})
+	}
+
+	return statefulSet
+}
+
+// buildStatefulSetHeadlessService builds the headless Service giving each
+// StatefulSet replica a stable DNS identity
+func buildStatefulSetHeadlessService(name string, spec *models.StatefulSetSpec, labels, annotations map[string]string, id string) *corev1.Service {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	// Merge user labels with deployment labels
+	deploymentLabels := models.BuildDeploymentLabels(id, name)
+	for k, v := range deploymentLabels {
+		labels[k] = v
+	}
+
+	var servicePorts []corev1.ServicePort
+	for _, port := range spec.StatefulSet.Ports {
+		servicePort := int32(port.ContainerPort) // #nosec G115
+		if port.ServicePort > 0 {
+			servicePort = int32(port.ServicePort) // #nosec G115
+		}
+
+		servicePorts = append(servicePorts, corev1.ServicePort{
+			Port:       servicePort,
+			TargetPort: intstr.FromInt(port.ContainerPort),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        statefulSetHeadlessServiceName(name, id),
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  models.BuildDeploymentLabels(id, name),
+			Ports:     servicePorts,
+		},
+	}
+}
+
+// statefulSetHeadlessServiceName returns the name of the governing headless
+// Service of a StatefulSet deployment
+func statefulSetHeadlessServiceName(name, id string) string {
+	return fmt.Sprintf("%s-headless-%s", name, id[:8])
+}
+
+// buildManifestRecord builds the ConfigMap tracking the objects applied by a
+// raw manifest deployment. The record carries the deployment's labels and ops
+// annotations and lists the applied objects in its data.
+func buildManifestRecord(name string, refs []manifestObjectRef, labels, annotations map[string]string, id string) *corev1.ConfigMap {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	// Merge user labels with deployment labels
+	deploymentLabels := models.BuildDeploymentLabels(id, name)
+	for k, v := range deploymentLabels {
+		labels[k] = v
+	}
+	labels[models.LabelManifestRecord] = "true"
+
+	// The refs serialize cleanly by construction, so the error is impossible
+	objects, _ := json.Marshal(refs)
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-manifests-%s", name, id[:8]),
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Data: map[string]string{
+			manifestRecordKey: string(objects),
+		},
+	}
+}
+
+// buildVirtualMachine builds the KubeVirt VirtualMachine for a VM deployment.
+// sshSecretName is empty when no SSH access credentials should be attached.
+// userData carries the resolved cloud-init content; it is ignored when the
+// spec references a cloud-init secret instead.
+func buildVirtualMachine(name, namespace string, vmConfig *models.VMConfig, labels, annotations map[string]string, sshSecretName, userData string) *kubevirtv1.VirtualMachine {
+	memory := resource.MustParse(fmt.Sprintf("%dGi", vmConfig.Ram))
+	maxGuest := resource.MustParse(fmt.Sprintf("%dGi", vmConfig.Ram*2))
+
+	cloudInit := &kubevirtv1.CloudInitNoCloudSource{}
+	if vmConfig.CloudInitSecret != nil {
+		cloudInit.UserDataSecretRef = &corev1.LocalObjectReference{Name: *vmConfig.CloudInitSecret}
+	} else {
+		cloudInit.UserData = userData
+	}
+
+	virtualMachine := &kubevirtv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-", name),
+			Namespace:    namespace,
+			Labels:       labels,
+			Annotations:  annotations,
+		},
+		Spec: kubevirtv1.VirtualMachineSpec{
+			RunStrategy: &[]kubevirtv1.VirtualMachineRunStrategy{kubevirtv1.RunStrategyRerunOnFailure}[0],
+			Template: &kubevirtv1.VirtualMachineInstanceTemplateSpec{
+				Spec: kubevirtv1.VirtualMachineInstanceSpec{
+					Architecture: "amd64",
+					Domain: kubevirtv1.DomainSpec{
+						CPU: &kubevirtv1.CPU{
+							// One core per socket so clusters with the
+							// LiveUpdate rollout strategy can hotplug vCPUs
+							// during a resize; MaxSockets and MaxGuest leave
+							// the headroom for it
+							Sockets:    uint32(vmConfig.Cpu), // #nosec G115
+							Cores:      1,
+							Threads:    1,
+							MaxSockets: uint32(vmConfig.Cpu) * 2, // #nosec G115
+						},
+						Memory: &kubevirtv1.Memory{
+							Guest:    &memory,
+							MaxGuest: &maxGuest,
+						},
+						Devices: kubevirtv1.Devices{
+							Disks: []kubevirtv1.Disk{
+								{
+									Name:      fmt.Sprintf("%s-disk", name),
+									BootOrder: &[]uint{1}[0],
+									DiskDevice: kubevirtv1.DiskDevice{
+										Disk: &kubevirtv1.DiskTarget{
+											Bus: kubevirtv1.DiskBusVirtio,
+										},
+									},
+								},
+								{
+									Name:      "cloudinitdisk",
+									BootOrder: &[]uint{2}[0],
+									DiskDevice: kubevirtv1.DiskDevice{
+										Disk: &kubevirtv1.DiskTarget{
+											Bus: kubevirtv1.DiskBusVirtio,
+										},
+									},
+								},
+							},
+							Interfaces: []kubevirtv1.Interface{
+								{
+									Name: "myvmnic",
+									InterfaceBindingMethod: kubevirtv1.InterfaceBindingMethod{
+										Bridge: &kubevirtv1.InterfaceBridge{},
+									},
+								},
+							},
+							Rng: &kubevirtv1.Rng{},
+						},
+						Features: &kubevirtv1.Features{
+							ACPI: kubevirtv1.FeatureState{},
+							SMM: &kubevirtv1.FeatureState{
+								Enabled: &[]bool{true}[0],
+							},
+						},
+						Machine: &kubevirtv1.Machine{
+							Type: "pc-q35-rhel9.4.0",
+						},
+					},
+					Networks: []kubevirtv1.Network{
+						{
+							Name: "myvmnic",
+							NetworkSource: kubevirtv1.NetworkSource{
+								Pod: &kubevirtv1.PodNetwork{},
+							},
+						},
+					},
+					TerminationGracePeriodSeconds: &[]int64{180}[0],
+					Volumes: []kubevirtv1.Volume{
+						{
+							Name: fmt.Sprintf("%s-disk", name),
+							VolumeSource: kubevirtv1.VolumeSource{
+								ContainerDisk: &kubevirtv1.ContainerDiskSource{
+									Image: getOSImage(vmConfig.Os),
+								},
+							},
+						},
+						{
+							Name: "cloudinitdisk",
+							VolumeSource: kubevirtv1.VolumeSource{
+								CloudInitNoCloud: cloudInit,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Pick the guest architecture and its machine type; non-amd64 guests drop
+	// the x86 firmware features from the base manifest
+	applyVMArchitecture(virtualMachine, vmConfig)
+
+	// Windows guests swap the Linux cloud-init volume for virtio-win drivers,
+	// EFI firmware and Hyper-V enlightenments before the disks are finalized
+	if isWindowsGuest(vmConfig.Os) {
+		applyWindowsGuest(virtualMachine, vmConfig)
+	}
+
+	// The SSH access Service selects the virt-launcher pod through the
+	// deployment labels, which KubeVirt only propagates from the instance
+	// template
+	if vmConfig.SshServiceType != "" {
+		virtualMachine.Spec.Template.ObjectMeta.Labels = labels
+	}
+
+	// Caller annotations also land on the instance template, where
+	// integrations read them off the virt-launcher pod
+	if user := models.UserAnnotations(annotations); user != nil {
+		virtualMachine.Spec.Template.ObjectMeta.Annotations = user
+	}
+
+	// Secondary Multus interfaces ride alongside the default pod network NIC
+	applyVMNetworks(virtualMachine, vmConfig.Networks)
+
+	// Performance tuning: pinned vCPUs, hugepages, guest NUMA mapping
+	applyVMPerformance(virtualMachine, vmConfig.Performance)
+
+	// Node placement: selector, co-scheduling rules and tolerations
+	applyVMPlacement(virtualMachine, vmConfig.Placement)
+
+	// Pull containerdisks (the OS image, and the virtio driver disk on
+	// Windows guests) with the named registry Secret
+	if vmConfig.ImagePullSecret != "" {
+		for i := range virtualMachine.Spec.Template.Spec.Volumes {
+			if disk := virtualMachine.Spec.Template.Spec.Volumes[i].ContainerDisk; disk != nil {
+				disk.ImagePullSecret = vmConfig.ImagePullSecret
+			}
+		}
+	}
+
+	// A persistent root disk swaps the ephemeral containerDisk for a CDI
+	// DataVolume imported from the OS image
+	if vmConfig.RootDisk != nil {
+		templateName := fmt.Sprintf("%s-root", name)
+		image := vmConfig.RootDisk.SourceImage
+		if image == "" {
+			image = getOSImage(vmConfig.Os)
+		}
+		registrySource := &cdiv1.DataVolumeSourceRegistry{
+			URL: ptr.To("docker://" + image),
+		}
+		if vmConfig.ImagePullSecret != "" {
+			registrySource.SecretRef = ptr.To(vmConfig.ImagePullSecret)
+		}
+		virtualMachine.Spec.DataVolumeTemplates = append(virtualMachine.Spec.DataVolumeTemplates,
+			buildDataVolumeTemplate(templateName, vmConfig.RootDisk, &cdiv1.DataVolumeSource{
+				Registry: registrySource,
+			}))
+		virtualMachine.Spec.Template.Spec.Volumes[0].VolumeSource = kubevirtv1.VolumeSource{
+			DataVolume: &kubevirtv1.DataVolumeSource{Name: templateName},
+		}
+	}
+
+	// Blank persistent data disks, one DataVolume each
+	for i, dataDisk := range vmConfig.DataDisks {
+		diskName := dataDisk.Name
+		if diskName == "" {
+			diskName = fmt.Sprintf("data%d", i+1)
+		}
+		volumeName := fmt.Sprintf("%s-%s", name, diskName)
+
+		virtualMachine.Spec.DataVolumeTemplates = append(virtualMachine.Spec.DataVolumeTemplates,
+			buildDataVolumeTemplate(volumeName, &dataDisk, &cdiv1.DataVolumeSource{
+				Blank: &cdiv1.DataVolumeBlankImage{},
+			}))
+		bus := kubevirtv1.DiskBusVirtio
+		if dataDisk.Bus != "" {
+			bus = kubevirtv1.DiskBus(dataDisk.Bus)
+		}
+		virtualMachine.Spec.Template.Spec.Domain.Devices.Disks = append(
+			virtualMachine.Spec.Template.Spec.Domain.Devices.Disks, kubevirtv1.Disk{
+				Name: volumeName,
+				DiskDevice: kubevirtv1.DiskDevice{
+					Disk: &kubevirtv1.DiskTarget{
+						Bus: bus,
+					},
+				},
+			})
+		virtualMachine.Spec.Template.Spec.Volumes = append(
+			virtualMachine.Spec.Template.Spec.Volumes, kubevirtv1.Volume{
+				Name: volumeName,
+				VolumeSource: kubevirtv1.VolumeSource{
+					DataVolume: &kubevirtv1.DataVolumeSource{Name: volumeName},
+				},
+			})
+	}
+
+	// Add SSH AccessCredentials if configured
+	if sshSecretName != "" {
+		virtualMachine.Spec.Template.Spec.AccessCredentials = []kubevirtv1.AccessCredential{
+			{
+				SSHPublicKey: &kubevirtv1.SSHPublicKeyAccessCredential{
+					Source: kubevirtv1.SSHPublicKeyAccessCredentialSource{
+						Secret: &kubevirtv1.AccessCredentialSecretSource{
+							SecretName: sshSecretName,
+						},
+					},
+					PropagationMethod: kubevirtv1.SSHPublicKeyAccessCredentialPropagationMethod{
+						NoCloud: &kubevirtv1.NoCloudSSHPublicKeyAccessCredentialPropagation{},
+					},
+				},
+			},
+		}
+	}
+
+	return virtualMachine
+}
+
+// applyNetworkIdentity stamps a reserved MAC address and hostname onto a
+// built VirtualMachine so the guest keeps its network identity across
+// recreates and migrations
+func applyNetworkIdentity(vm *kubevirtv1.VirtualMachine, reservation *netid.Reservation) {
+	spec := &vm.Spec.Template.Spec
+	spec.Hostname = reservation.Hostname
+	if len(spec.Domain.Devices.Interfaces) > 0 {
+		spec.Domain.Devices.Interfaces[0].MacAddress = reservation.MAC
+	}
+}
+
+// buildDataVolumeTemplate builds a DataVolume template embedded in a
+// VirtualMachine. The resulting DataVolume is owned by the VM, so KubeVirt
+// garbage-collects it when the VM deployment is deleted. Disk sizes are
+// validated before manifests are built, so parsing here cannot fail.
+func buildDataVolumeTemplate(name string, disk *models.VMDiskConfig, source *cdiv1.DataVolumeSource) kubevirtv1.DataVolumeTemplateSpec {
+	storage := &cdiv1.StorageSpec{
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse(disk.Size),
+			},
+		},
+	}
+	if disk.StorageClass != "" {
+		storage.StorageClassName = ptr.To(disk.StorageClass)
+	}
+
+	return kubevirtv1.DataVolumeTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			Source:  source,
+			Storage: storage,
+		},
+	}
+}
+
+// getOSImage returns the container image for the specified OS
+func getOSImage(os string) string {
+	images := map[string]string{
+		"fedora": "quay.io/containerdisks/fedora:latest",
+		"ubuntu": "quay.io/containerdisks/ubuntu:latest",
+		"centos": "quay.io/containerdisks/centos:latest",
+		"rhel":   "quay.io/containerdisks/rhel:latest",
+	}
+
+	if image, exists := images[os]; exists {
+		return image
+	}
+	// Default to fedora if OS not found
+	return "quay.io/containerdisks/fedora:latest"
+}
+
+// buildDefaultCloudInitUserData generates the default cloud-init document for
+// a VM without a custom cloud-init source. With password auth disabled the
+// guest account is locked and only SSH keys grant access.
+func buildDefaultCloudInitUserData(username, hostname, password string, disablePasswordAuth bool) string {
+	if disablePasswordAuth {
+		return fmt.Sprintf(`#cloud-config
+user: %s
+lock_passwd: true
+ssh_pwauth: false
+hostname: %s
+`, username, hostname)
+	}
+
+	return fmt.Sprintf(`#cloud-config
+user: %s
+password: %s
+chpasswd: { expire: False }
+hostname: %s
+`, username, password, hostname)
+}
+
+// buildResourceRequirements translates a resource config into container
+// requests and limits. Quantities are validated before manifests are built;
+// malformed values are skipped.
+func buildResourceRequirements(cfg *models.ResourceConfig) corev1.ResourceRequirements {
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{},
+	}
+
+	if cfg.CPU != "" {
+		if cpu, err := resource.ParseQuantity(cfg.CPU); err == nil {
+			resources.Requests[corev1.ResourceCPU] = cpu
+		}
+	}
+
+	if cfg.Memory != "" {
+		if memory, err := resource.ParseQuantity(cfg.Memory); err == nil {
+			resources.Requests[corev1.ResourceMemory] = memory
+		}
+	}
+
+	addResourceLimits(&resources, cfg)
+	addExtendedResources(&resources, cfg)
+	return resources
+}
+
+// addResourceLimits fills the Limits list of a ResourceRequirements from the
+// limit fields of a resource config. Quantities are validated before
+// manifests are built; malformed values are skipped like the request fields.
+func addResourceLimits(resources *corev1.ResourceRequirements, cfg *models.ResourceConfig) {
+	if cfg.CPULimit == "" && cfg.MemoryLimit == "" {
+		return
+	}
+
+	resources.Limits = corev1.ResourceList{}
+
+	if cfg.CPULimit != "" {
+		if cpu, err := resource.ParseQuantity(cfg.CPULimit); err == nil {
+			resources.Limits[corev1.ResourceCPU] = cpu
+		}
+	}
+
+	if cfg.MemoryLimit != "" {
+		if memory, err := resource.ParseQuantity(cfg.MemoryLimit); err == nil {
+			resources.Limits[corev1.ResourceMemory] = memory
+		}
+	}
+}
+
+// addExtendedResources adds extended resources (GPUs, hugepages) to both the
+// requests and limits of a ResourceRequirements — Kubernetes requires
+// extended resource requests to equal their limits
+func addExtendedResources(resources *corev1.ResourceRequirements, cfg *models.ResourceConfig) {
+	if len(cfg.Extended) == 0 {
+		return
+	}
+
+	if resources.Limits == nil {
+		resources.Limits = corev1.ResourceList{}
+	}
+
+	for name, value := range cfg.Extended {
+		if quantity, err := resource.ParseQuantity(value); err == nil {
+			resources.Requests[corev1.ResourceName(name)] = quantity
+			resources.Limits[corev1.ResourceName(name)] = quantity
+		}
+	}
+}
+
+// buildEnvVars converts environment variable specs to corev1 env vars,
+// resolving Secret and ConfigMap key references
+func buildEnvVars(environment []models.EnvironmentVariable) []corev1.EnvVar {
+	var envVars []corev1.EnvVar
+	for _, envVar := range environment {
+		converted := corev1.EnvVar{
+			Name:  envVar.Name,
+			Value: envVar.Value,
+		}
+
+		if source := envVar.ValueFrom; source != nil {
+			converted.ValueFrom = &corev1.EnvVarSource{}
+			if ref := source.SecretKeyRef; ref != nil {
+				converted.ValueFrom.SecretKeyRef = &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+					Key:                  ref.Key,
+					Optional:             ref.Optional,
+				}
+			}
+			if ref := source.ConfigMapKeyRef; ref != nil {
+				converted.ValueFrom.ConfigMapKeyRef = &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+					Key:                  ref.Key,
+					Optional:             ref.Optional,
+				}
+			}
+		}
+
+		envVars = append(envVars, converted)
+	}
+	return envVars
+}
+
+// buildEnvFromSources converts envFrom specs to corev1 sources injecting whole
+// Secrets and ConfigMaps as environment variables
+func buildEnvFromSources(envFrom []models.EnvFromSource) []corev1.EnvFromSource {
+	var sources []corev1.EnvFromSource
+	for _, source := range envFrom {
+		converted := corev1.EnvFromSource{Prefix: source.Prefix}
+		if ref := source.SecretRef; ref != nil {
+			converted.SecretRef = &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Optional:             ref.Optional,
+			}
+		}
+		if ref := source.ConfigMapRef; ref != nil {
+			converted.ConfigMapRef = &corev1.ConfigMapEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Optional:             ref.Optional,
+			}
+		}
+		sources = append(sources, converted)
+	}
+	return sources
+}
@@ -0,0 +1,503 @@
+package services
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/netid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+const (
+	goldenDeploymentID = "0e7c6c7a-1111-2222-3333-444455556666"
+	goldenCreatedAt    = "2024-01-02T03:04:05Z"
+	goldenUpdatedAt    = "2024-01-02T03:04:05Z"
+)
+
+// goldenAnnotations returns deterministic timestamp annotations for golden comparisons
+func goldenAnnotations() map[string]string {
+	return map[string]string{
+		models.AnnotationCreatedAt: goldenCreatedAt,
+		models.AnnotationUpdatedAt: goldenUpdatedAt,
+	}
+}
+
+// goldenUserData returns a deterministic default cloud-init document for
+// golden comparisons
+func goldenUserData(username, hostname string) string {
+	return buildDefaultCloudInitUserData(username, hostname, "golden-password", false)
+}
+
+// assertGolden compares the JSON serialization of obj against the golden file,
+// rewriting the file when the -update flag is set
+func assertGolden(t *testing.T, goldenFile string, obj interface{}) {
+	t.Helper()
+
+	actual, err := json.MarshalIndent(obj, "", "  ")
+	require.NoError(t, err)
+	actual = append(actual, '\n')
+
+	goldenPath := filepath.Join("testdata", goldenFile)
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll("testdata", 0o755))
+		require.NoError(t, os.WriteFile(goldenPath, actual, 0o644))
+		return
+	}
+
+	expected, err := os.ReadFile(goldenPath)
+	require.NoError(t, err, "golden file missing, run go test with -update to create it")
+	assert.Equal(t, string(expected), string(actual))
+}
+
+func TestBuildContainerDeploymentGolden(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image:    "nginx:1.25",
+			Replicas: func() *int { r := 3; return &r }(),
+			Ports: []models.PortConfig{
+				{ContainerPort: 8080, ServicePort: 80},
+			},
+			Resources: &models.ResourceConfig{
+				CPU:         "100m",
+				Memory:      "128Mi",
+				CPULimit:    "500m",
+				MemoryLimit: "256Mi",
+			},
+			Environment: []models.EnvironmentVariable{
+				{Name: "ENV", Value: "production"},
+			},
+		},
+	}
+
+	deployment := buildContainerDeployment("web", spec, map[string]string{"team": "platform"}, goldenAnnotations(), goldenDeploymentID, false)
+	assertGolden(t, "container-deployment.json", deployment)
+}
+
+func TestBuildContainerDeploymentDefaultsGolden(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "busybox:latest",
+		},
+	}
+
+	deployment := buildContainerDeployment("worker", spec, nil, goldenAnnotations(), goldenDeploymentID, false)
+	assertGolden(t, "container-deployment-defaults.json", deployment)
+}
+
+func TestBuildContainerDeploymentEnvRefsGolden(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "nginx:1.25",
+			Environment: []models.EnvironmentVariable{
+				{Name: "LOG_LEVEL", Value: "debug"},
+				{Name: "DB_PASSWORD", ValueFrom: &models.EnvVarSource{
+					SecretKeyRef: &models.KeySelector{Name: "db-credentials", Key: "password"},
+				}},
+				{Name: "FEATURE_FLAGS", ValueFrom: &models.EnvVarSource{
+					ConfigMapKeyRef: &models.KeySelector{
+						Name:     "app-config",
+						Key:      "flags",
+						Optional: func() *bool { o := true; return &o }(),
+					},
+				}},
+			},
+			EnvFrom: []models.EnvFromSource{
+				{SecretRef: &models.SourceSelector{Name: "app-secrets"}},
+				{Prefix: "CFG_", ConfigMapRef: &models.SourceSelector{Name: "app-config"}},
+			},
+		},
+	}
+
+	deployment := buildContainerDeployment("web", spec, nil, goldenAnnotations(), goldenDeploymentID, false)
+	assertGolden(t, "container-deployment-env-refs.json", deployment)
+}
+
+func TestBuildContainerDeploymentExtendedResourcesGolden(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "pytorch/pytorch:latest",
+			Resources: &models.ResourceConfig{
+				CPU:         "2",
+				Memory:      "8Gi",
+				MemoryLimit: "16Gi",
+				Extended: map[string]string{
+					"nvidia.com/gpu": "1",
+					"hugepages-2Mi":  "1Gi",
+				},
+			},
+		},
+	}
+
+	deployment := buildContainerDeployment("trainer", spec, nil, goldenAnnotations(), goldenDeploymentID, false)
+	assertGolden(t, "container-deployment-extended-resources.json", deployment)
+}
+
+func TestBuildContainerDeploymentSATokenGolden(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "nginx:1.25",
+			ServiceAccountToken: &models.ServiceAccountTokenConfig{
+				Audience:          "kubernetes.default.svc",
+				ExpirationSeconds: func() *int64 { e := int64(7200); return &e }(),
+			},
+		},
+	}
+
+	deployment := buildContainerDeployment("web", spec, nil, goldenAnnotations(), goldenDeploymentID, true)
+	assertGolden(t, "container-deployment-sa-token.json", deployment)
+}
+
+func TestBuildContainerIngressGolden(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "nginx:1.25",
+			Ports: []models.PortConfig{
+				{ContainerPort: 8080, ServicePort: 80},
+			},
+			Ingress: &models.IngressConfig{
+				Host:      "web.example.com",
+				Path:      "/app",
+				TLSSecret: "web-tls",
+				ClassName: "nginx",
+			},
+		},
+	}
+
+	ingress := buildContainerIngress("web", spec, map[string]string{"team": "platform"}, goldenAnnotations(), goldenDeploymentID)
+	assertGolden(t, "container-ingress.json", ingress)
+}
+
+func TestBuildContainerIngressDefaultsGolden(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "nginx:1.25",
+			Ports: []models.PortConfig{
+				{ContainerPort: 8080},
+			},
+			Ingress: &models.IngressConfig{
+				Host: "web.example.com",
+			},
+		},
+	}
+
+	ingress := buildContainerIngress("web", spec, nil, goldenAnnotations(), goldenDeploymentID)
+	assertGolden(t, "container-ingress-defaults.json", ingress)
+}
+
+func TestBuildContainerServiceGolden(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "nginx:1.25",
+			Ports: []models.PortConfig{
+				{ContainerPort: 8080, ServicePort: 80},
+				{ContainerPort: 9090},
+			},
+		},
+	}
+
+	service := buildContainerService("web", spec, map[string]string{"team": "platform"}, goldenAnnotations(), goldenDeploymentID)
+	assertGolden(t, "container-service.json", service)
+}
+
+func TestBuildContainerServiceLoadBalancerGolden(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "nginx:1.25",
+			Ports: []models.PortConfig{
+				{ContainerPort: 8080, ServicePort: 80},
+			},
+			Service: &models.ServiceConfig{
+				Type: "LoadBalancer",
+				Annotations: map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-type": "nlb",
+				},
+			},
+		},
+	}
+
+	service := buildContainerService("web", spec, nil, goldenAnnotations(), goldenDeploymentID)
+	assertGolden(t, "container-service-loadbalancer.json", service)
+}
+
+func TestBuildJobGolden(t *testing.T) {
+	spec := &models.JobSpec{
+		Job: models.JobConfig{
+			Image:                 "migrate:v2",
+			Command:               []string{"/bin/migrate"},
+			Args:                  []string{"--target", "latest"},
+			Completions:           func() *int32 { c := int32(1); return &c }(),
+			Parallelism:           func() *int32 { p := int32(1); return &p }(),
+			ActiveDeadlineSeconds: func() *int64 { d := int64(600); return &d }(),
+			BackoffLimit:          func() *int32 { b := int32(2); return &b }(),
+			Resources: &models.ResourceConfig{
+				CPU:    "250m",
+				Memory: "256Mi",
+			},
+			Environment: []models.EnvironmentVariable{
+				{Name: "DATABASE_URL", Value: "postgres://db:5432/app"},
+			},
+		},
+	}
+
+	job := buildJob("schema-migration", spec, map[string]string{"team": "platform"}, goldenAnnotations(), goldenDeploymentID)
+	assertGolden(t, "job.json", job)
+}
+
+func TestBuildJobDefaultsGolden(t *testing.T) {
+	spec := &models.JobSpec{
+		Job: models.JobConfig{
+			Image: "busybox:latest",
+		},
+	}
+
+	job := buildJob("one-off", spec, nil, goldenAnnotations(), goldenDeploymentID)
+	assertGolden(t, "job-defaults.json", job)
+}
+
+func TestBuildCronJobGolden(t *testing.T) {
+	spec := &models.CronJobSpec{
+		CronJob: models.CronJobConfig{
+			JobConfig: models.JobConfig{
+				Image:   "backup:v1",
+				Command: []string{"/bin/backup"},
+				Environment: []models.EnvironmentVariable{
+					{Name: "BUCKET", Value: "nightly-backups"},
+				},
+			},
+			Schedule:                   "0 2 * * *",
+			ConcurrencyPolicy:          "Forbid",
+			SuccessfulJobsHistoryLimit: func() *int32 { l := int32(3); return &l }(),
+			FailedJobsHistoryLimit:     func() *int32 { l := int32(1); return &l }(),
+			StartingDeadlineSeconds:    func() *int64 { d := int64(300); return &d }(),
+		},
+	}
+
+	cronJob := buildCronJob("nightly-backup", spec, map[string]string{"team": "platform"}, goldenAnnotations(), goldenDeploymentID)
+	assertGolden(t, "cronjob.json", cronJob)
+}
+
+func TestBuildCronJobDefaultsGolden(t *testing.T) {
+	spec := &models.CronJobSpec{
+		CronJob: models.CronJobConfig{
+			JobConfig: models.JobConfig{Image: "busybox:latest"},
+			Schedule:  "*/5 * * * *",
+		},
+	}
+
+	cronJob := buildCronJob("sweeper", spec, nil, goldenAnnotations(), goldenDeploymentID)
+	assertGolden(t, "cronjob-defaults.json", cronJob)
+}
+
+func TestBuildStatefulSetGolden(t *testing.T) {
+	spec := &models.StatefulSetSpec{
+		StatefulSet: models.StatefulSetConfig{
+			ContainerConfig: models.ContainerConfig{
+				Image:    "postgres:16",
+				Replicas: func() *int { r := 3; return &r }(),
+				Ports: []models.PortConfig{
+					{ContainerPort: 5432},
+				},
+				Environment: []models.EnvironmentVariable{
+					{Name: "PGDATA", Value: "/var/lib/postgresql/data/pgdata"},
+				},
+				Resources: &models.ResourceConfig{
+					CPU:    "500m",
+					Memory: "1Gi",
+				},
+			},
+			VolumeClaims: []models.VolumeClaimConfig{
+				{
+					Name:         "data",
+					Size:         "10Gi",
+					MountPath:    "/var/lib/postgresql/data",
+					StorageClass: "fast-ssd",
+					AccessMode:   "ReadWriteOnce",
+				},
+			},
+		},
+	}
+
+	statefulSet := buildStatefulSet("db", spec, map[string]string{"team": "platform"}, goldenAnnotations(), goldenDeploymentID, false)
+	assertGolden(t, "statefulset.json", statefulSet)
+}
+
+func TestBuildStatefulSetDefaultsGolden(t *testing.T) {
+	spec := &models.StatefulSetSpec{
+		StatefulSet: models.StatefulSetConfig{
+			ContainerConfig: models.ContainerConfig{Image: "redis:7"},
+		},
+	}
+
+	statefulSet := buildStatefulSet("cache", spec, nil, goldenAnnotations(), goldenDeploymentID, false)
+	assertGolden(t, "statefulset-defaults.json", statefulSet)
+}
+
+func TestBuildStatefulSetHeadlessServiceGolden(t *testing.T) {
+	spec := &models.StatefulSetSpec{
+		StatefulSet: models.StatefulSetConfig{
+			ContainerConfig: models.ContainerConfig{
+				Image: "postgres:16",
+				Ports: []models.PortConfig{
+					{ContainerPort: 5432},
+				},
+			},
+		},
+	}
+
+	service := buildStatefulSetHeadlessService("db", spec, map[string]string{"team": "platform"}, goldenAnnotations(), goldenDeploymentID)
+	assertGolden(t, "statefulset-headless-service.json", service)
+}
+
+func TestBuildManifestRecordGolden(t *testing.T) {
+	refs := []manifestObjectRef{
+		{APIVersion: "v1", Kind: "ConfigMap", Name: "app-config", Namespace: "default"},
+		{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "app-reader"},
+	}
+
+	record := buildManifestRecord("raw", refs, map[string]string{"team": "platform"}, goldenAnnotations(), goldenDeploymentID)
+	assertGolden(t, "manifest-record.json", record)
+}
+
+func TestBuildVirtualMachineGolden(t *testing.T) {
+	vmConfig := &models.VMConfig{
+		Ram: 4,
+		Cpu: 2,
+		Os:  "fedora",
+	}
+
+	labels := models.BuildDeploymentLabels(goldenDeploymentID, "devbox")
+	vm := buildVirtualMachine("devbox", "default", vmConfig, labels, goldenAnnotations(), "", goldenUserData("fedora", "devbox"))
+	assertGolden(t, "virtual-machine.json", vm)
+}
+
+func TestBuildVirtualMachineWithSSHGolden(t *testing.T) {
+	vmConfig := &models.VMConfig{
+		Ram: 8,
+		Cpu: 4,
+		Os:  "ubuntu",
+	}
+
+	labels := models.BuildDeploymentLabels(goldenDeploymentID, "devbox")
+	labels[models.LabelSSHSecretCreated] = "true"
+	vm := buildVirtualMachine("devbox", "dev-team", vmConfig, labels, goldenAnnotations(), "devbox-ssh-key", goldenUserData("ubuntu", "devbox"))
+	assertGolden(t, "virtual-machine-ssh.json", vm)
+}
+
+func TestBuildVirtualMachineCloudInitSecretGolden(t *testing.T) {
+	secretName := "gitops-cloud-init"
+	vmConfig := &models.VMConfig{
+		Ram:             4,
+		Cpu:             2,
+		Os:              "fedora",
+		CloudInitSecret: &secretName,
+	}
+
+	labels := models.BuildDeploymentLabels(goldenDeploymentID, "devbox")
+	vm := buildVirtualMachine("devbox", "default", vmConfig, labels, goldenAnnotations(), "", "")
+	assertGolden(t, "virtual-machine-cloud-init-secret.json", vm)
+}
+
+func TestBuildVirtualMachinePersistentDisksGolden(t *testing.T) {
+	vmConfig := &models.VMConfig{
+		Ram: 4,
+		Cpu: 2,
+		Os:  "fedora",
+		RootDisk: &models.VMDiskConfig{
+			Size:         "20Gi",
+			StorageClass: "fast-ssd",
+		},
+		DataDisks: []models.VMDiskConfig{
+			{Name: "scratch", Size: "50Gi"},
+			{Size: "10Gi"},
+		},
+	}
+
+	labels := models.BuildDeploymentLabels(goldenDeploymentID, "devbox")
+	vm := buildVirtualMachine("devbox", "default", vmConfig, labels, goldenAnnotations(), "", goldenUserData("fedora", "devbox"))
+	assertGolden(t, "virtual-machine-persistent-disks.json", vm)
+}
+
+func TestGetOSImage(t *testing.T) {
+	tests := []struct {
+		os       string
+		expected string
+	}{
+		{"fedora", "quay.io/containerdisks/fedora:latest"},
+		{"ubuntu", "quay.io/containerdisks/ubuntu:latest"},
+		{"centos", "quay.io/containerdisks/centos:latest"},
+		{"rhel", "quay.io/containerdisks/rhel:latest"},
+		{"unknown", "quay.io/containerdisks/fedora:latest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.os, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getOSImage(tt.os))
+		})
+	}
+}
+
+func TestApplyNetworkIdentity(t *testing.T) {
+	vmConfig := &models.VMConfig{
+		Ram: 4,
+		Cpu: 2,
+		Os:  "fedora",
+	}
+
+	labels := models.BuildDeploymentLabels(goldenDeploymentID, "devbox")
+	vm := buildVirtualMachine("devbox", "default", vmConfig, labels, goldenAnnotations(), "", goldenUserData("fedora", "devbox"))
+
+	applyNetworkIdentity(vm, &netid.Reservation{
+		MAC:      "52:54:00:ab:cd:ef",
+		Hostname: "devbox-0e7c6c7a",
+	})
+
+	assert.Equal(t, "devbox-0e7c6c7a", vm.Spec.Template.Spec.Hostname)
+	assert.Equal(t, "52:54:00:ab:cd:ef", vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress)
+}
+
+func TestBuildDefaultCloudInitUserData(t *testing.T) {
+	withPassword := buildDefaultCloudInitUserData("admin", "devbox", "s3cretpass", false)
+	assert.Contains(t, withPassword, "user: admin")
+	assert.Contains(t, withPassword, "password: s3cretpass")
+	assert.Contains(t, withPassword, "hostname: devbox")
+
+	locked := buildDefaultCloudInitUserData("admin", "devbox", "", true)
+	assert.Contains(t, locked, "lock_passwd: true")
+	assert.Contains(t, locked, "ssh_pwauth: false")
+	assert.NotContains(t, locked, "password:")
+}
+
+func TestBuildContainerDeploymentUserAnnotations(t *testing.T) {
+	annotations := goldenAnnotations()
+	models.MergeUserAnnotations(annotations, map[string]string{"prometheus.io/scrape": "true"})
+
+	spec := &models.ContainerSpec{Container: models.ContainerConfig{Image: "nginx:latest"}}
+	deployment := buildContainerDeployment("web", spec, nil, annotations, goldenDeploymentID, false)
+
+	// The full annotation map lands on the object, but only the caller's
+	// annotations propagate to the pod template
+	assert.Equal(t, "true", deployment.Annotations["prometheus.io/scrape"])
+	assert.Equal(t, map[string]string{"prometheus.io/scrape": "true"}, deployment.Spec.Template.Annotations)
+
+	// Without caller annotations the template metadata stays empty
+	plain := buildContainerDeployment("web", spec, nil, goldenAnnotations(), goldenDeploymentID, false)
+	assert.Nil(t, plain.Spec.Template.Annotations)
+}
+
+func TestBuildVirtualMachineUserAnnotations(t *testing.T) {
+	annotations := goldenAnnotations()
+	models.MergeUserAnnotations(annotations, map[string]string{"prometheus.io/scrape": "true"})
+
+	vm := buildVirtualMachine("devbox", "default",
+		&models.VMConfig{Os: "fedora", Ram: 2, Cpu: 1},
+		map[string]string{}, annotations, "", "")
+
+	assert.Equal(t, map[string]string{"prometheus.io/scrape": "true"}, vm.Spec.Template.ObjectMeta.Annotations)
+}
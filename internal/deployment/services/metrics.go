@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// podMetricsBasePath is the aggregated API served by metrics-server; querying
+// it through the clientset's REST client avoids a dedicated metrics clientset
+const podMetricsBasePath = "/apis/metrics.k8s.io/v1beta1"
+
+// podMetricsList mirrors the subset of the metrics.k8s.io PodMetricsList we
+// consume, so the dependency stays at the wire format
+type podMetricsList struct {
+	Items []podMetricsItem `json:"items"`
+}
+
+type podMetricsItem struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Timestamp  time.Time `json:"timestamp"`
+	Containers []struct {
+		Name  string `json:"name"`
+		Usage struct {
+			CPU    resource.Quantity `json:"cpu"`
+			Memory resource.Quantity `json:"memory"`
+		} `json:"usage"`
+	} `json:"containers"`
+}
+
+// GetDeploymentMetrics returns the current resource usage of the pods backing
+// a deployment, normalized per pod with deployment totals. VM deployments are
+// measured through their virt-launcher pods, so guest and virtualization
+// overhead are reported together.
+func (d *DeploymentService) GetDeploymentMetrics(ctx context.Context, id string) (*models.DeploymentMetricsResponse, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("deployment_id", id))
+
+	deployment, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := models.BuildDeploymentSelector(id)
+	if deployment.Kind == models.DeploymentKindVM {
+		// virt-launcher pods carry KubeVirt's VM name label, not ours
+		selector = fmt.Sprintf("%s=%s", kubevirtVMNameLabel, deployment.Metadata.Name)
+	}
+
+	raw, err := d.readClient.CoreV1().RESTClient().Get().
+		AbsPath(podMetricsBasePath, "namespaces", deployment.Metadata.Namespace, "pods").
+		Param("labelSelector", selector).
+		Do(ctx).Raw()
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, models.NewErrMetricsUnavailable()
+		}
+		return nil, fmt.Errorf("failed to query pod metrics: %w", err)
+	}
+
+	var list podMetricsList
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode pod metrics: %w", err)
+	}
+
+	response := buildDeploymentMetrics(deployment, &list)
+	logger.Info("Retrieved deployment metrics", zap.Int("pods", len(response.Pods)))
+	return response, nil
+}
+
+// buildDeploymentMetrics normalizes a raw pod metrics list into the response
+// model, summing container usage per pod and pod usage into deployment totals
+func buildDeploymentMetrics(deployment *models.DeploymentResponse, list *podMetricsList) *models.DeploymentMetricsResponse {
+	response := &models.DeploymentMetricsResponse{
+		ID:        deployment.ID,
+		Kind:      deployment.Kind,
+		Namespace: deployment.Metadata.Namespace,
+		Pods:      []models.PodUsage{},
+	}
+
+	for _, item := range list.Items {
+		pod := models.PodUsage{
+			Name:      item.Metadata.Name,
+			Timestamp: item.Timestamp,
+		}
+		for _, container := range item.Containers {
+			usage := models.ResourceUsage{
+				CPUMillicores: container.Usage.CPU.MilliValue(),
+				MemoryBytes:   container.Usage.Memory.Value(),
+			}
+			pod.Containers = append(pod.Containers, models.ContainerUsage{
+				Name:  container.Name,
+				Usage: usage,
+			})
+			pod.Usage.CPUMillicores += usage.CPUMillicores
+			pod.Usage.MemoryBytes += usage.MemoryBytes
+		}
+		response.Totals.CPUMillicores += pod.Usage.CPUMillicores
+		response.Totals.MemoryBytes += pod.Usage.MemoryBytes
+		response.Pods = append(response.Pods, pod)
+	}
+
+	return response
+}
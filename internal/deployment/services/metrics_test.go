@@ -0,0 +1,79 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDeploymentMetrics(t *testing.T) {
+	// A metrics.k8s.io PodMetricsList as served by metrics-server
+	raw := `{
+		"items": [
+			{
+				"metadata": {"name": "web-0e7c6c7a-abc"},
+				"timestamp": "2024-01-02T03:04:05Z",
+				"containers": [
+					{"name": "web", "usage": {"cpu": "250m", "memory": "128Mi"}},
+					{"name": "sidecar", "usage": {"cpu": "50m", "memory": "32Mi"}}
+				]
+			},
+			{
+				"metadata": {"name": "web-0e7c6c7a-def"},
+				"timestamp": "2024-01-02T03:04:05Z",
+				"containers": [
+					{"name": "web", "usage": {"cpu": "1", "memory": "256Mi"}}
+				]
+			}
+		]
+	}`
+
+	var list podMetricsList
+	require.NoError(t, json.Unmarshal([]byte(raw), &list))
+
+	deployment := &models.DeploymentResponse{
+		ID:   "0e7c6c7a-1111-2222-3333-444455556666",
+		Kind: models.DeploymentKindContainer,
+		Metadata: models.Metadata{
+			Name:      "web",
+			Namespace: "default",
+		},
+	}
+
+	response := buildDeploymentMetrics(deployment, &list)
+
+	assert.Equal(t, deployment.ID, response.ID)
+	assert.Equal(t, models.DeploymentKindContainer, response.Kind)
+	assert.Equal(t, "default", response.Namespace)
+	require.Len(t, response.Pods, 2)
+
+	first := response.Pods[0]
+	assert.Equal(t, "web-0e7c6c7a-abc", first.Name)
+	require.Len(t, first.Containers, 2)
+	assert.Equal(t, int64(250), first.Containers[0].Usage.CPUMillicores)
+	assert.Equal(t, int64(128*1024*1024), first.Containers[0].Usage.MemoryBytes)
+	assert.Equal(t, int64(300), first.Usage.CPUMillicores)
+	assert.Equal(t, int64(160*1024*1024), first.Usage.MemoryBytes)
+
+	assert.Equal(t, int64(1300), response.Totals.CPUMillicores)
+	assert.Equal(t, int64(416*1024*1024), response.Totals.MemoryBytes)
+}
+
+func TestBuildDeploymentMetricsEmpty(t *testing.T) {
+	deployment := &models.DeploymentResponse{
+		ID:   "0e7c6c7a-1111-2222-3333-444455556666",
+		Kind: models.DeploymentKindVM,
+		Metadata: models.Metadata{
+			Namespace: "vms",
+		},
+	}
+
+	response := buildDeploymentMetrics(deployment, &podMetricsList{})
+	assert.NotNil(t, response.Pods)
+	assert.Empty(t, response.Pods)
+	assert.Equal(t, int64(0), response.Totals.CPUMillicores)
+	assert.Equal(t, int64(0), response.Totals.MemoryBytes)
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// NamespacePolicy restricts the namespaces deployments may target. Denied
+// patterns always win over allowed ones, so system namespaces stay protected
+// even when an allowlist would cover them. A nil policy (the default) allows
+// every namespace and implicit namespace creation.
+type NamespacePolicy struct {
+	denied     []string
+	allowed    []string
+	autoCreate bool
+}
+
+// NewNamespacePolicy builds a namespace policy from its configuration,
+// rejecting malformed glob patterns up front
+func NewNamespacePolicy(cfg config.NamespacePolicyConfig) (*NamespacePolicy, error) {
+	denied, err := parseNamespacePatterns(cfg.Denied)
+	if err != nil {
+		return nil, fmt.Errorf("invalid denied namespace pattern: %w", err)
+	}
+	allowed, err := parseNamespacePatterns(cfg.Allowed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowed namespace pattern: %w", err)
+	}
+	return &NamespacePolicy{
+		denied:     denied,
+		allowed:    allowed,
+		autoCreate: cfg.AutoCreate,
+	}, nil
+}
+
+// parseNamespacePatterns splits a comma-separated glob pattern list,
+// validating each pattern against the path.Match syntax
+func parseNamespacePatterns(raw string) ([]string, error) {
+	var patterns []string
+	for _, pattern := range strings.Split(raw, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if _, err := path.Match(pattern, "probe"); err != nil {
+			return nil, fmt.Errorf("%q: %w", pattern, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+// Check refuses namespaces matching a denied pattern, and — when an
+// allowlist is configured — namespaces matching no allowed pattern
+func (p *NamespacePolicy) Check(namespace string) error {
+	for _, pattern := range p.denied {
+		if matched, _ := path.Match(pattern, namespace); matched {
+			return models.NewErrNamespaceDenied(namespace,
+				fmt.Sprintf("matches the denied namespace pattern %q", pattern))
+		}
+	}
+	if len(p.allowed) == 0 {
+		return nil
+	}
+	for _, pattern := range p.allowed {
+		if matched, _ := path.Match(pattern, namespace); matched {
+			return nil
+		}
+	}
+	return models.NewErrNamespaceDenied(namespace, "not in the allowed namespace list")
+}
+
+// SetNamespacePolicy installs the operator's namespace policy, enforced on
+// every create before any resource is touched
+func (d *DeploymentService) SetNamespacePolicy(policy *NamespacePolicy) {
+	d.nsPolicy = policy
+}
+
+// checkNamespacePolicy enforces the namespace policy on a request's target
+// namespace. With auto-creation disabled the namespace must already exist.
+func (d *DeploymentService) checkNamespacePolicy(ctx context.Context, namespace string) error {
+	if d.nsPolicy == nil {
+		return nil
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	if err := d.nsPolicy.Check(namespace); err != nil {
+		return err
+	}
+	if !d.nsPolicy.autoCreate {
+		if _, err := d.readClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{}); err != nil {
+			return models.NewErrNamespaceDenied(namespace,
+				"namespace does not exist and implicit namespace creation is disabled")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestNewNamespacePolicy(t *testing.T) {
+	policy, err := NewNamespacePolicy(config.NamespacePolicyConfig{
+		Denied:     "kube-system, kube-public",
+		Allowed:    "team-*",
+		AutoCreate: true,
+	})
+	require.NoError(t, err)
+	assert.Len(t, policy.denied, 2)
+	assert.Len(t, policy.allowed, 1)
+
+	// Malformed glob patterns are rejected up front
+	_, err = NewNamespacePolicy(config.NamespacePolicyConfig{Denied: "kube-["})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "denied namespace pattern")
+}
+
+func TestNamespacePolicyCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       config.NamespacePolicyConfig
+		namespace string
+		forbidden bool
+		contains  string
+	}{
+		{
+			name:      "no patterns allows everything",
+			cfg:       config.NamespacePolicyConfig{},
+			namespace: "anything",
+		},
+		{
+			name:      "denied pattern refuses the namespace",
+			cfg:       config.NamespacePolicyConfig{Denied: "kube-*"},
+			namespace: "kube-system",
+			forbidden: true,
+			contains:  "denied namespace pattern",
+		},
+		{
+			name:      "allowlist admits matching namespaces",
+			cfg:       config.NamespacePolicyConfig{Allowed: "team-*"},
+			namespace: "team-a",
+		},
+		{
+			name:      "allowlist refuses everything else",
+			cfg:       config.NamespacePolicyConfig{Allowed: "team-*"},
+			namespace: "default",
+			forbidden: true,
+			contains:  "allowed namespace list",
+		},
+		{
+			name:      "denied wins over the allowlist",
+			cfg:       config.NamespacePolicyConfig{Denied: "team-ops", Allowed: "team-*"},
+			namespace: "team-ops",
+			forbidden: true,
+			contains:  "denied namespace pattern",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := NewNamespacePolicy(tt.cfg)
+			require.NoError(t, err)
+
+			err = policy.Check(tt.namespace)
+
+			if !tt.forbidden {
+				assert.NoError(t, err)
+				return
+			}
+			require.True(t, models.IsNamespaceForbiddenError(err))
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// multusNetworksAnnotation is the Multus pod annotation listing the
+// NetworkAttachmentDefinitions a pod's secondary interfaces attach to
+const multusNetworksAnnotation = "k8s.v1.cni.cncf.io/networks"
+
+// applyVMNetworks appends a KubeVirt interface and Multus network for each
+// secondary attachment. Interfaces are named net1, net2, ... in spec order;
+// the default pod network NIC stays first. Binding defaults to bridge, the
+// usual choice for L2 NetworkAttachmentDefinitions.
+func applyVMNetworks(vm *kubevirtv1.VirtualMachine, networks []models.NetworkAttachmentConfig) {
+	spec := &vm.Spec.Template.Spec
+	for i, network := range networks {
+		name := fmt.Sprintf("net%d", i+1)
+
+		iface := kubevirtv1.Interface{
+			Name:       name,
+			MacAddress: network.MacAddress,
+		}
+		switch network.Binding {
+		case "masquerade":
+			iface.Masquerade = &kubevirtv1.InterfaceMasquerade{}
+		case "sriov":
+			iface.SRIOV = &kubevirtv1.InterfaceSRIOV{}
+		default:
+			iface.Bridge = &kubevirtv1.InterfaceBridge{}
+		}
+		spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, iface)
+
+		spec.Networks = append(spec.Networks, kubevirtv1.Network{
+			Name: name,
+			NetworkSource: kubevirtv1.NetworkSource{
+				Multus: &kubevirtv1.MultusNetwork{
+					NetworkName: network.Name,
+				},
+			},
+		})
+	}
+}
+
+// multusNetworkSelection mirrors the Multus network selection JSON carried in
+// the pod networks annotation
+type multusNetworkSelection struct {
+	Name       string   `json:"name"`
+	Namespace  string   `json:"namespace,omitempty"`
+	MacAddress string   `json:"mac,omitempty"`
+	IPs        []string `json:"ips,omitempty"`
+}
+
+// buildNetworksAnnotation renders the Multus annotation value for a pod's
+// secondary attachments. Validation runs before manifests are built, so
+// marshalling here cannot fail.
+func buildNetworksAnnotation(networks []models.NetworkAttachmentConfig) string {
+	selections := make([]multusNetworkSelection, 0, len(networks))
+	for _, network := range networks {
+		selection := multusNetworkSelection{
+			Name:       network.Name,
+			MacAddress: network.MacAddress,
+		}
+		if namespace, name, found := strings.Cut(network.Name, "/"); found {
+			selection.Namespace = namespace
+			selection.Name = name
+		}
+		if network.IPAddress != "" {
+			selection.IPs = []string{network.IPAddress}
+		}
+		selections = append(selections, selection)
+	}
+
+	value, _ := json.Marshal(selections)
+	return string(value)
+}
+
+// validateNetworkAttachments checks secondary interface attachments. field
+// names the spec section for error messages; forVM selects between the VM
+// interface rules (no static IPs, any binding) and the container pod rules
+// (no binding, IPs pass to the CNI IPAM).
+func validateNetworkAttachments(field string, networks []models.NetworkAttachmentConfig, forVM bool) error {
+	seen := make(map[string]bool)
+	for i, network := range networks {
+		label := fmt.Sprintf("%s[%d]", field, i)
+		if seen[network.Name] {
+			return models.NewErrInvalidSpec(label,
+				fmt.Sprintf("duplicate network attachment %q", network.Name))
+		}
+		seen[network.Name] = true
+
+		if network.MacAddress != "" {
+			if _, err := net.ParseMAC(network.MacAddress); err != nil {
+				return models.NewErrInvalidSpec(label,
+					fmt.Sprintf("macAddress %q is not a valid MAC address", network.MacAddress))
+			}
+		}
+		if network.IPAddress != "" {
+			if forVM {
+				return models.NewErrInvalidSpec(label,
+					"ipAddress is not supported on VM interfaces; addresses come from the attachment definition's IPAM")
+			}
+			if net.ParseIP(network.IPAddress) == nil {
+				return models.NewErrInvalidSpec(label,
+					fmt.Sprintf("ipAddress %q is not a valid IP address", network.IPAddress))
+			}
+		}
+		if network.Binding != "" && !forVM {
+			return models.NewErrInvalidSpec(label,
+				"binding is only supported on VM interfaces; pod interface behavior comes from the attachment definition")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestValidateNetworkAttachments(t *testing.T) {
+	tests := []struct {
+		name     string
+		networks []models.NetworkAttachmentConfig
+		forVM    bool
+		wantErr  string
+	}{
+		{
+			name:     "valid VM attachments",
+			networks: []models.NetworkAttachmentConfig{{Name: "vlan10"}, {Name: "sriov-net", Binding: "sriov", MacAddress: "02:42:ac:11:00:02"}},
+			forVM:    true,
+		},
+		{
+			name:     "valid pod attachment with static IP",
+			networks: []models.NetworkAttachmentConfig{{Name: "infra/vlan10", IPAddress: "192.168.10.5"}},
+		},
+		{
+			name:     "duplicate attachment",
+			networks: []models.NetworkAttachmentConfig{{Name: "vlan10"}, {Name: "vlan10"}},
+			forVM:    true,
+			wantErr:  "duplicate network attachment",
+		},
+		{
+			name:     "invalid MAC",
+			networks: []models.NetworkAttachmentConfig{{Name: "vlan10", MacAddress: "not-a-mac"}},
+			forVM:    true,
+			wantErr:  "not a valid MAC address",
+		},
+		{
+			name:     "static IPs are pod-only",
+			networks: []models.NetworkAttachmentConfig{{Name: "vlan10", IPAddress: "192.168.10.5"}},
+			forVM:    true,
+			wantErr:  "ipAddress is not supported on VM interfaces",
+		},
+		{
+			name:     "invalid pod IP",
+			networks: []models.NetworkAttachmentConfig{{Name: "vlan10", IPAddress: "999.1.1.1"}},
+			wantErr:  "not a valid IP address",
+		},
+		{
+			name:     "bindings are VM-only",
+			networks: []models.NetworkAttachmentConfig{{Name: "vlan10", Binding: "bridge"}},
+			wantErr:  "binding is only supported on VM interfaces",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNetworkAttachments("spec.vm.networks", tt.networks, tt.forVM)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplyVMNetworks(t *testing.T) {
+	vmConfig := &models.VMConfig{
+		Os:  "fedora",
+		Ram: 2,
+		Cpu: 1,
+		Networks: []models.NetworkAttachmentConfig{
+			{Name: "infra/vlan10", MacAddress: "02:42:ac:11:00:02"},
+			{Name: "sriov-net", Binding: "sriov"},
+		},
+	}
+
+	vm := buildVirtualMachine("devbox", "default", vmConfig, map[string]string{}, map[string]string{}, "", "")
+	interfaces := vm.Spec.Template.Spec.Domain.Devices.Interfaces
+	networks := vm.Spec.Template.Spec.Networks
+
+	// The default pod network NIC stays first
+	require.Len(t, interfaces, 3)
+	require.Len(t, networks, 3)
+	require.NotNil(t, networks[0].Pod)
+
+	assert.Equal(t, "net1", interfaces[1].Name)
+	assert.Equal(t, "02:42:ac:11:00:02", interfaces[1].MacAddress)
+	require.NotNil(t, interfaces[1].Bridge, "binding defaults to bridge")
+	assert.Equal(t, "infra/vlan10", networks[1].Multus.NetworkName)
+
+	assert.Equal(t, "net2", interfaces[2].Name)
+	require.NotNil(t, interfaces[2].SRIOV)
+	assert.Equal(t, "sriov-net", networks[2].Multus.NetworkName)
+}
+
+func TestBuildNetworksAnnotation(t *testing.T) {
+	annotation := buildNetworksAnnotation([]models.NetworkAttachmentConfig{
+		{Name: "infra/vlan10", MacAddress: "02:42:ac:11:00:02", IPAddress: "192.168.10.5"},
+		{Name: "vlan20"},
+	})
+
+	assert.JSONEq(t, `[
+		{"name":"vlan10","namespace":"infra","mac":"02:42:ac:11:00:02","ips":["192.168.10.5"]},
+		{"name":"vlan20"}
+	]`, annotation)
+}
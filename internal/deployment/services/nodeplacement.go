@@ -0,0 +1,191 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// SetDefaultTolerations installs the operator's default tolerations. They are
+// injected into every deployment on create and update, so tenant workloads
+// can land on dedicated, tainted node pools without each spec repeating the
+// toleration.
+func (d *DeploymentService) SetDefaultTolerations(tolerations []models.TolerationConfig) {
+	d.containerService.SetDefaultTolerations(tolerations)
+	d.statefulSetService.SetDefaultTolerations(tolerations)
+	d.vmService.SetDefaultTolerations(tolerations)
+}
+
+// SetDefaultTolerations installs the operator's default tolerations
+func (c *ContainerService) SetDefaultTolerations(tolerations []models.TolerationConfig) {
+	c.defaultTolerations = tolerations
+}
+
+// SetDefaultTolerations installs the operator's default tolerations
+func (s *StatefulSetService) SetDefaultTolerations(tolerations []models.TolerationConfig) {
+	s.defaultTolerations = tolerations
+}
+
+// SetDefaultTolerations installs the operator's default tolerations
+func (v *VMService) SetDefaultTolerations(tolerations []models.TolerationConfig) {
+	v.defaultTolerations = tolerations
+}
+
+// ParseDefaultTolerations parses the operator's default toleration list from
+// its configuration string: comma-separated "key[=value][:effect]" entries,
+// the same shape kubelet uses for --register-with-taints. An entry without a
+// value tolerates the taint key with the Exists operator.
+func ParseDefaultTolerations(raw string) ([]models.TolerationConfig, error) {
+	var tolerations []models.TolerationConfig
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		toleration := models.TolerationConfig{Operator: "Exists"}
+		rest := entry
+		if key, effect, found := strings.Cut(rest, ":"); found {
+			switch effect {
+			case "NoSchedule", "PreferNoSchedule", "NoExecute":
+				toleration.Effect = effect
+			default:
+				return nil, fmt.Errorf("toleration %q: unknown effect %q", entry, effect)
+			}
+			rest = key
+		}
+		if key, value, found := strings.Cut(rest, "="); found {
+			toleration.Operator = "Equal"
+			toleration.Value = value
+			rest = key
+		}
+		if rest == "" {
+			return nil, fmt.Errorf("toleration %q: empty key", entry)
+		}
+		toleration.Key = rest
+		tolerations = append(tolerations, toleration)
+	}
+	return tolerations, nil
+}
+
+// validatePlacement checks the combinations the binding tags cannot express.
+// The field prefix keeps error messages accurate across kinds.
+func validatePlacement(field string, placement *models.PlacementConfig) error {
+	if placement == nil {
+		return nil
+	}
+	for i, toleration := range placement.Tolerations {
+		if toleration.Operator == "Exists" && toleration.Value != "" {
+			return models.NewErrInvalidSpec(fmt.Sprintf("%s.tolerations[%d].value", field, i),
+				"an Exists toleration must not set a value")
+		}
+	}
+	return nil
+}
+
+// mergeTolerationPolicy injects the operator's default tolerations into a
+// placement config. A toleration the spec already declares for the same key
+// wins over the default; with no defaults configured the placement passes
+// through untouched.
+func mergeTolerationPolicy(placement *models.PlacementConfig, defaults []models.TolerationConfig) *models.PlacementConfig {
+	if len(defaults) == 0 {
+		return placement
+	}
+	if placement == nil {
+		placement = &models.PlacementConfig{}
+	}
+	tolerated := make(map[string]bool)
+	for _, toleration := range placement.Tolerations {
+		tolerated[toleration.Key] = true
+	}
+	for _, toleration := range defaults {
+		if !tolerated[toleration.Key] {
+			placement.Tolerations = append(placement.Tolerations, toleration)
+		}
+	}
+	return placement
+}
+
+// applyPodPlacement renders a placement config onto a pod template
+func applyPodPlacement(podSpec *corev1.PodSpec, placement *models.PlacementConfig) {
+	if placement == nil {
+		return
+	}
+	if len(placement.NodeSelector) > 0 {
+		podSpec.NodeSelector = placement.NodeSelector
+	}
+	if len(placement.Tolerations) > 0 {
+		podSpec.Tolerations = buildTolerations(placement.Tolerations)
+	}
+	podSpec.Affinity = buildAffinity(placement)
+}
+
+// applyVMPlacement renders a placement config onto the VM's instance
+// template; KubeVirt copies the fields onto the virt-launcher pod
+func applyVMPlacement(vm *kubevirtv1.VirtualMachine, placement *models.PlacementConfig) {
+	if placement == nil {
+		return
+	}
+	templateSpec := &vm.Spec.Template.Spec
+	if len(placement.NodeSelector) > 0 {
+		templateSpec.NodeSelector = placement.NodeSelector
+	}
+	if len(placement.Tolerations) > 0 {
+		templateSpec.Tolerations = buildTolerations(placement.Tolerations)
+	}
+	templateSpec.Affinity = buildAffinity(placement)
+}
+
+// buildTolerations translates toleration configs into pod tolerations
+func buildTolerations(tolerations []models.TolerationConfig) []corev1.Toleration {
+	built := make([]corev1.Toleration, 0, len(tolerations))
+	for _, toleration := range tolerations {
+		operator := corev1.TolerationOpEqual
+		if toleration.Operator == "Exists" {
+			operator = corev1.TolerationOpExists
+		}
+		built = append(built, corev1.Toleration{
+			Key:      toleration.Key,
+			Operator: operator,
+			Value:    toleration.Value,
+			Effect:   corev1.TaintEffect(toleration.Effect),
+		})
+	}
+	return built
+}
+
+// buildAffinity renders the placement label maps as required pod
+// (anti-)affinity rules on the hostname topology, co-scheduling the workload
+// with — or spreading it away from — pods matching the labels. Returns nil
+// when neither map is set so the manifest stays minimal.
+func buildAffinity(placement *models.PlacementConfig) *corev1.Affinity {
+	if len(placement.Affinity) == 0 && len(placement.AntiAffinity) == 0 {
+		return nil
+	}
+	affinity := &corev1.Affinity{}
+	if len(placement.Affinity) > 0 {
+		affinity.PodAffinity = &corev1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{hostnameAffinityTerm(placement.Affinity)},
+		}
+	}
+	if len(placement.AntiAffinity) > 0 {
+		affinity.PodAntiAffinity = &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{hostnameAffinityTerm(placement.AntiAffinity)},
+		}
+	}
+	return affinity
+}
+
+// hostnameAffinityTerm builds a required affinity term matching pods by label
+// on the kubernetes.io/hostname topology
+func hostnameAffinityTerm(labels map[string]string) corev1.PodAffinityTerm {
+	return corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: labels},
+		TopologyKey:   corev1.LabelHostname,
+	}
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestParseDefaultTolerations(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		expected    []models.TolerationConfig
+		expectError bool
+	}{
+		{
+			name: "key value and effect",
+			raw:  "dedicated=tenant-a:NoSchedule",
+			expected: []models.TolerationConfig{
+				{Key: "dedicated", Operator: "Equal", Value: "tenant-a", Effect: "NoSchedule"},
+			},
+		},
+		{
+			name: "bare key tolerates with Exists",
+			raw:  "gpu-pool",
+			expected: []models.TolerationConfig{
+				{Key: "gpu-pool", Operator: "Exists"},
+			},
+		},
+		{
+			name: "multiple entries with whitespace",
+			raw:  "dedicated=tenant-a:NoSchedule, gpu-pool:NoExecute",
+			expected: []models.TolerationConfig{
+				{Key: "dedicated", Operator: "Equal", Value: "tenant-a", Effect: "NoSchedule"},
+				{Key: "gpu-pool", Operator: "Exists", Effect: "NoExecute"},
+			},
+		},
+		{
+			name:        "unknown effect",
+			raw:         "dedicated=tenant-a:Sometimes",
+			expectError: true,
+		},
+		{
+			name:        "empty key",
+			raw:         "=tenant-a:NoSchedule",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tolerations, err := ParseDefaultTolerations(tt.raw)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, tolerations)
+		})
+	}
+}
+
+func TestMergeTolerationPolicy(t *testing.T) {
+	defaults := []models.TolerationConfig{
+		{Key: "dedicated", Operator: "Equal", Value: "tenant-a", Effect: "NoSchedule"},
+	}
+
+	// No defaults passes the placement through untouched
+	assert.Nil(t, mergeTolerationPolicy(nil, nil))
+
+	// Defaults materialize a placement when the spec has none
+	merged := mergeTolerationPolicy(nil, defaults)
+	require.NotNil(t, merged)
+	assert.Equal(t, defaults, merged.Tolerations)
+
+	// A spec toleration for the same key wins over the default
+	merged = mergeTolerationPolicy(&models.PlacementConfig{
+		Tolerations: []models.TolerationConfig{{Key: "dedicated", Operator: "Exists"}},
+	}, defaults)
+	require.Len(t, merged.Tolerations, 1)
+	assert.Equal(t, "Exists", merged.Tolerations[0].Operator)
+}
+
+func TestValidatePlacement(t *testing.T) {
+	assert.NoError(t, validatePlacement("spec.container.placement", nil))
+	assert.NoError(t, validatePlacement("spec.container.placement", &models.PlacementConfig{
+		Tolerations: []models.TolerationConfig{{Key: "dedicated", Operator: "Exists"}},
+	}))
+
+	err := validatePlacement("spec.container.placement", &models.PlacementConfig{
+		Tolerations: []models.TolerationConfig{{Key: "dedicated", Operator: "Exists", Value: "tenant-a"}},
+	})
+	require.True(t, models.IsInvalidSpecError(err))
+	assert.Contains(t, err.Error(), "must not set a value")
+}
+
+func TestBuildContainerDeploymentPlacement(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "nginx:latest",
+			Placement: &models.PlacementConfig{
+				NodeSelector: map[string]string{"node-pool": "tenant-a"},
+				Tolerations: []models.TolerationConfig{
+					{Key: "dedicated", Operator: "Equal", Value: "tenant-a", Effect: "NoSchedule"},
+				},
+				AntiAffinity: map[string]string{"app": "web"},
+			},
+		},
+	}
+
+	deployment := buildContainerDeployment("web", spec, nil, goldenAnnotations(), goldenDeploymentID, false)
+	podSpec := deployment.Spec.Template.Spec
+
+	assert.Equal(t, map[string]string{"node-pool": "tenant-a"}, podSpec.NodeSelector)
+
+	require.Len(t, podSpec.Tolerations, 1)
+	assert.Equal(t, corev1.TolerationOpEqual, podSpec.Tolerations[0].Operator)
+	assert.Equal(t, corev1.TaintEffectNoSchedule, podSpec.Tolerations[0].Effect)
+
+	require.NotNil(t, podSpec.Affinity)
+	assert.Nil(t, podSpec.Affinity.PodAffinity)
+	terms := podSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	require.Len(t, terms, 1)
+	assert.Equal(t, corev1.LabelHostname, terms[0].TopologyKey)
+	assert.Equal(t, map[string]string{"app": "web"}, terms[0].LabelSelector.MatchLabels)
+}
+
+func TestBuildVirtualMachinePlacement(t *testing.T) {
+	vm := buildVirtualMachine("devbox", "default",
+		&models.VMConfig{
+			Os: "fedora", Ram: 2, Cpu: 1,
+			Placement: &models.PlacementConfig{
+				NodeSelector: map[string]string{"node-pool": "tenant-a"},
+				Tolerations: []models.TolerationConfig{
+					{Key: "dedicated", Operator: "Exists", Effect: "NoExecute"},
+				},
+				Affinity: map[string]string{"app": "cache"},
+			},
+		},
+		map[string]string{}, map[string]string{}, "", "")
+
+	templateSpec := vm.Spec.Template.Spec
+	assert.Equal(t, map[string]string{"node-pool": "tenant-a"}, templateSpec.NodeSelector)
+
+	require.Len(t, templateSpec.Tolerations, 1)
+	assert.Equal(t, corev1.TolerationOpExists, templateSpec.Tolerations[0].Operator)
+
+	require.NotNil(t, templateSpec.Affinity)
+	terms := templateSpec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	require.Len(t, terms, 1)
+	assert.Equal(t, map[string]string{"app": "cache"}, terms[0].LabelSelector.MatchLabels)
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// StartNodeMaintenance cordons a node and evacuates the managed workloads
+// running on it: VMs are live-migrated and container/job pods are evicted so
+// their controllers reschedule them elsewhere. Evictions respect pod
+// disruption budgets, so this coordinates with the scheduler rather than
+// force-deleting pods the way a raw drain would. Per-deployment failures are
+// reported in the response instead of aborting the whole evacuation.
+func (d *DeploymentService) StartNodeMaintenance(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("node", node))
+	logger.Info("Starting node maintenance")
+
+	// Cordon first so evicted pods cannot land back on the node
+	if err := d.setNodeUnschedulable(ctx, node, true); err != nil {
+		return nil, err
+	}
+
+	pods, err := d.managedPodsOnNode(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	evacuations := make([]models.NodeEvacuation, 0, len(pods))
+	migrated := make(map[string]bool)
+	for i := range pods {
+		pod := &pods[i]
+		evacuation := models.NodeEvacuation{
+			DeploymentID: pod.Labels[models.LabelAppID],
+			Name:         pod.Labels[models.LabelApp],
+			Namespace:    pod.Namespace,
+		}
+
+		if pod.Labels[kubevirtVMNameLabel] != "" {
+			// One live migration per VM deployment moves all its pods
+			evacuation.Kind = models.DeploymentKindVM
+			if migrated[evacuation.DeploymentID] {
+				continue
+			}
+			migrated[evacuation.DeploymentID] = true
+
+			if _, err := d.vmService.PerformVMAction(ctx, evacuation.DeploymentID, pod.Namespace, models.ActionMigrate); err != nil {
+				evacuation.Status = models.EvacuationStatusFailed
+				evacuation.Message = err.Error()
+				logger.Warn("Failed to migrate VM off node",
+					zap.String("deployment_id", evacuation.DeploymentID), zap.Error(err))
+			} else {
+				evacuation.Status = models.EvacuationStatusMigrating
+			}
+		} else {
+			evacuation.Kind = kindFromPodOwner(pod)
+			eviction := &policyv1.Eviction{
+				ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+			}
+			if err := d.client.PolicyV1().Evictions(pod.Namespace).Evict(ctx, eviction); err != nil {
+				evacuation.Status = models.EvacuationStatusFailed
+				evacuation.Message = err.Error()
+				logger.Warn("Failed to evict pod",
+					zap.String("pod", pod.Name), zap.Error(err))
+			} else {
+				evacuation.Status = models.EvacuationStatusEvicted
+			}
+		}
+
+		evacuations = append(evacuations, evacuation)
+	}
+
+	logger.Info("Evacuating node", zap.Int("workloads", len(evacuations)))
+	return &models.NodeMaintenanceResponse{
+		Node:          node,
+		Unschedulable: true,
+		Evacuations:   evacuations,
+	}, nil
+}
+
+// NodeMaintenanceStatus reports the managed deployments that still have pods
+// on the node, recomputed from cluster state on each call
+func (d *DeploymentService) NodeMaintenanceStatus(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error) {
+	nodeObj, err := d.readClient.CoreV1().Nodes().Get(ctx, node, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, models.NewErrNodeNotFound(node)
+		}
+		return nil, fmt.Errorf("failed to get node: %w", err)
+	}
+
+	pods, err := d.managedPodsOnNode(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	evacuations := make([]models.NodeEvacuation, 0, len(pods))
+	seen := make(map[string]bool)
+	for i := range pods {
+		pod := &pods[i]
+		id := pod.Labels[models.LabelAppID]
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		kind := kindFromPodOwner(pod)
+		if pod.Labels[kubevirtVMNameLabel] != "" {
+			kind = models.DeploymentKindVM
+		}
+
+		evacuations = append(evacuations, models.NodeEvacuation{
+			DeploymentID: id,
+			Kind:         kind,
+			Name:         pod.Labels[models.LabelApp],
+			Namespace:    pod.Namespace,
+			Status:       models.EvacuationStatusEvacuating,
+		})
+	}
+
+	return &models.NodeMaintenanceResponse{
+		Node:          node,
+		Unschedulable: nodeObj.Spec.Unschedulable,
+		Evacuations:   evacuations,
+	}, nil
+}
+
+// EndNodeMaintenance uncordons the node and reports its current state
+func (d *DeploymentService) EndNodeMaintenance(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("node", node))
+	logger.Info("Ending node maintenance")
+
+	if err := d.setNodeUnschedulable(ctx, node, false); err != nil {
+		return nil, err
+	}
+	return d.NodeMaintenanceStatus(ctx, node)
+}
+
+// setNodeUnschedulable cordons or uncordons a node through a merge patch
+func (d *DeploymentService) setNodeUnschedulable(ctx context.Context, node string, unschedulable bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable))
+	_, err := d.client.CoreV1().Nodes().Patch(ctx, node, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return models.NewErrNodeNotFound(node)
+		}
+		return fmt.Errorf("failed to patch node %s: %w", node, err)
+	}
+	return nil
+}
+
+// managedPodsOnNode lists the running pods of managed deployments scheduled
+// on the node, skipping pods that are already on their way out
+func (d *DeploymentService) managedPodsOnNode(ctx context.Context, node string) ([]corev1.Pod, error) {
+	pods, err := d.readClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildManagedResourceSelector(),
+		FieldSelector: "spec.nodeName=" + node,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods on node %s: %w", node, err)
+	}
+
+	active := pods.Items[:0]
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if pod.Labels[models.LabelAppID] == "" {
+			continue
+		}
+		active = append(active, pod)
+	}
+	return active, nil
+}
+
+// kindFromPodOwner infers the deployment kind from a pod's controller. Pods
+// spawned by jobs and cronjobs are both Job-owned and report as jobs here;
+// the distinction does not change how they are evacuated.
+func kindFromPodOwner(pod *corev1.Pod) models.DeploymentKind {
+	for _, owner := range pod.OwnerReferences {
+		switch owner.Kind {
+		case "Job":
+			return models.DeploymentKindJob
+		case "StatefulSet":
+			return models.DeploymentKindStatefulSet
+		}
+	}
+	return models.DeploymentKindContainer
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"go.uber.org/zap"
+)
+
+// UpdateDeploymentMetadata patches the ops notes and tags stored on a
+// deployment in place, without recreating any cluster resources. Nil request
+// fields are left unchanged; empty values clear the stored data.
+func (d *DeploymentService) UpdateDeploymentMetadata(ctx context.Context, id string, req *models.UpdateDeploymentMetadataRequest) (*models.DeploymentResponse, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("deployment_id", id))
+	logger.Info("Updating deployment ops metadata")
+
+	// Serialize mutations on this deployment ID
+	if err := d.locks.acquire(id, "update-metadata"); err != nil {
+		return nil, err
+	}
+	defer d.locks.release(id)
+
+	existing, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := opsAnnotationsPatch(req)
+	if len(annotations) == 0 {
+		return existing, nil
+	}
+
+	namespace := existing.Metadata.Namespace
+	switch existing.Kind {
+	case models.DeploymentKindContainer:
+		err = d.containerService.PatchContainerAnnotations(ctx, id, namespace, annotations)
+	case models.DeploymentKindVM:
+		err = d.vmService.PatchVMAnnotations(ctx, id, namespace, annotations)
+	case models.DeploymentKindJob:
+		err = d.jobService.PatchJobAnnotations(ctx, id, namespace, annotations)
+	case models.DeploymentKindCronJob:
+		err = d.cronJobService.PatchCronJobAnnotations(ctx, id, namespace, annotations)
+	case models.DeploymentKindStatefulSet:
+		err = d.statefulSetService.PatchStatefulSetAnnotations(ctx, id, namespace, annotations)
+	case models.DeploymentKindManifest:
+		err = d.manifestService.PatchManifestAnnotations(ctx, id, namespace, annotations)
+	default:
+		return nil, fmt.Errorf("unsupported deployment kind: %s", existing.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Successfully updated deployment ops metadata")
+	return d.GetDeploymentByID(ctx, id)
+}
+
+// opsAnnotationsPatch translates a metadata update into annotation values for
+// a JSON merge patch. Nil request fields are omitted; empty values map to nil
+// so the merge patch removes the annotation.
+func opsAnnotationsPatch(req *models.UpdateDeploymentMetadataRequest) map[string]interface{} {
+	annotations := make(map[string]interface{})
+	if req.Notes != nil {
+		if *req.Notes == "" {
+			annotations[models.AnnotationNotes] = nil
+		} else {
+			annotations[models.AnnotationNotes] = *req.Notes
+		}
+	}
+	if req.Tags != nil {
+		if len(*req.Tags) == 0 {
+			annotations[models.AnnotationTags] = nil
+		} else {
+			annotations[models.AnnotationTags] = strings.Join(*req.Tags, ",")
+		}
+	}
+	return annotations
+}
+
+// marshalAnnotationsPatch renders a JSON merge patch body updating only the
+// object's annotations
+func marshalAnnotationsPatch(annotations map[string]interface{}) ([]byte, error) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal annotations patch: %w", err)
+	}
+	return patch, nil
+}
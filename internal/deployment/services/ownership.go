@@ -0,0 +1,23 @@
+package services
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ownerReference builds the controller OwnerReference pointing a secondary
+// object at its deployment's primary object, so Kubernetes garbage
+// collection deletes it in cascade with the parent instead of relying on
+// the explicit cleanup sweeps alone
+func ownerReference(apiVersion, kind, name string, uid types.UID) metav1.OwnerReference {
+	controller := true
+	blockOwnerDeletion := true
+	return metav1.OwnerReference{
+		APIVersion:         apiVersion,
+		Kind:               kind,
+		Name:               name,
+		UID:                uid,
+		Controller:         &controller,
+		BlockOwnerDeletion: &blockOwnerDeletion,
+	}
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// continueCursor pins the position of the last deployment returned by a list
+// page. The next page resumes strictly after it in the stable listing order,
+// so pages stay consistent even when deployments are created or deleted
+// between requests.
+type continueCursor struct {
+	Kind      models.DeploymentKind `json:"kind"`
+	Namespace string                `json:"namespace"`
+	Name      string                `json:"name"`
+	ID        string                `json:"id"`
+}
+
+// cursorFor extracts the listing-order key of a deployment
+func cursorFor(deployment *models.DeploymentResponse) continueCursor {
+	return continueCursor{
+		Kind:      deployment.Kind,
+		Namespace: deployment.Metadata.Namespace,
+		Name:      deployment.Metadata.Name,
+		ID:        deployment.ID,
+	}
+}
+
+// less orders cursors by kind, namespace, name and ID — the stable listing
+// order applied to aggregated deployments before pagination
+func (c continueCursor) less(other continueCursor) bool {
+	if c.Kind != other.Kind {
+		return c.Kind < other.Kind
+	}
+	if c.Namespace != other.Namespace {
+		return c.Namespace < other.Namespace
+	}
+	if c.Name != other.Name {
+		return c.Name < other.Name
+	}
+	return c.ID < other.ID
+}
+
+// sortDeployments puts aggregated deployments into the stable listing order
+func sortDeployments(deployments []models.DeploymentResponse) {
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return cursorFor(&deployments[i]).less(cursorFor(&deployments[j]))
+	})
+}
+
+// encodeContinueToken renders the opaque continue token for the last
+// deployment of a page
+func encodeContinueToken(deployment *models.DeploymentResponse) string {
+	encoded, err := json.Marshal(cursorFor(deployment))
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// decodeContinueToken parses an opaque continue token back into its cursor
+func decodeContinueToken(token string) (*continueCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, models.NewErrInvalidContinueToken()
+	}
+	var cursor continueCursor
+	if err := json.Unmarshal(decoded, &cursor); err != nil {
+		return nil, models.NewErrInvalidContinueToken()
+	}
+	return &cursor, nil
+}
+
+// positionAfter returns the index of the first deployment ordered strictly
+// after the cursor; deployments must already be in the stable listing order.
+// When the cursor's deployment has been deleted the next page resumes at the
+// deployment that took its place in the order, never skipping survivors.
+func positionAfter(deployments []models.DeploymentResponse, cursor *continueCursor) int {
+	return sort.Search(len(deployments), func(i int) bool {
+		return cursor.less(cursorFor(&deployments[i]))
+	})
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func listedDeployment(kind models.DeploymentKind, namespace, name, id string) models.DeploymentResponse {
+	return models.DeploymentResponse{
+		ID:   id,
+		Kind: kind,
+		Metadata: models.Metadata{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+}
+
+func TestSortDeploymentsStableOrder(t *testing.T) {
+	deployments := []models.DeploymentResponse{
+		listedDeployment(models.DeploymentKindVM, "team-a", "vm-1", "id-4"),
+		listedDeployment(models.DeploymentKindContainer, "team-b", "web", "id-3"),
+		listedDeployment(models.DeploymentKindContainer, "team-a", "web", "id-2"),
+		listedDeployment(models.DeploymentKindContainer, "team-a", "api", "id-1"),
+	}
+
+	sortDeployments(deployments)
+
+	ids := make([]string, 0, len(deployments))
+	for _, deployment := range deployments {
+		ids = append(ids, deployment.ID)
+	}
+	assert.Equal(t, []string{"id-1", "id-2", "id-3", "id-4"}, ids)
+}
+
+func TestContinueTokenRoundTrip(t *testing.T) {
+	deployment := listedDeployment(models.DeploymentKindContainer, "team-a", "web", "id-1")
+
+	token := encodeContinueToken(&deployment)
+	require.NotEmpty(t, token)
+
+	cursor, err := decodeContinueToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, cursorFor(&deployment), *cursor)
+}
+
+func TestDecodeContinueTokenRejectsGarbage(t *testing.T) {
+	for _, token := range []string{"not base64!", "bm90IGpzb24"} {
+		_, err := decodeContinueToken(token)
+		assert.True(t, models.IsInvalidContinueTokenError(err))
+	}
+}
+
+func TestPositionAfterResumesWithoutSkipping(t *testing.T) {
+	deployments := []models.DeploymentResponse{
+		listedDeployment(models.DeploymentKindContainer, "team-a", "api", "id-1"),
+		listedDeployment(models.DeploymentKindContainer, "team-a", "web", "id-2"),
+		listedDeployment(models.DeploymentKindVM, "team-a", "vm-1", "id-3"),
+	}
+
+	cursor := cursorFor(&deployments[1])
+	assert.Equal(t, 2, positionAfter(deployments, &cursor))
+
+	// The cursor's deployment was deleted between pages: the next page
+	// resumes at the deployment that took its place in the order
+	remaining := []models.DeploymentResponse{deployments[0], deployments[2]}
+	assert.Equal(t, 1, positionAfter(remaining, &cursor))
+
+	// A cursor past the end yields an empty page
+	last := cursorFor(&deployments[2])
+	assert.Equal(t, 3, positionAfter(deployments, &last))
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	kubevirtv1 "kubevirt.io/api/core/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// validateVMPerformance checks that the requested performance knobs form a
+// coherent combination. KubeVirt only honors guest NUMA mapping for VMs with
+// pinned vCPUs and hugepage-backed memory, so NUMA passthrough requires both.
+func validateVMPerformance(vm *models.VMConfig) error {
+	perf := vm.Performance
+	if perf == nil {
+		return nil
+	}
+	if perf.NumaPassthrough {
+		if !perf.DedicatedCpuPlacement {
+			return models.NewErrInvalidVMShape("numaPassthrough requires dedicatedCpuPlacement")
+		}
+		if perf.HugepagesSize == "" {
+			return models.NewErrInvalidVMShape("numaPassthrough requires hugepages-backed memory; set hugepagesSize")
+		}
+	}
+	return nil
+}
+
+// applyVMPerformance stamps the performance tuning onto a built
+// VirtualMachine. Validation runs before manifests are built, so the
+// combination is known to be coherent here.
+func applyVMPerformance(vm *kubevirtv1.VirtualMachine, perf *models.VMPerformanceConfig) {
+	if perf == nil {
+		return
+	}
+	domain := &vm.Spec.Template.Spec.Domain
+
+	if perf.DedicatedCpuPlacement {
+		domain.CPU.DedicatedCPUPlacement = true
+	}
+	if perf.HugepagesSize != "" {
+		domain.Memory.Hugepages = &kubevirtv1.Hugepages{PageSize: perf.HugepagesSize}
+	}
+	if perf.NumaPassthrough {
+		domain.CPU.NUMA = &kubevirtv1.NUMA{
+			GuestMappingPassthrough: &kubevirtv1.NUMAGuestMappingPassthrough{},
+		}
+	}
+}
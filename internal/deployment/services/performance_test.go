@@ -0,0 +1,72 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestValidateVMPerformance(t *testing.T) {
+	// No performance section is always coherent
+	assert.NoError(t, validateVMPerformance(&models.VMConfig{Os: "fedora"}))
+
+	// Each knob stands on its own
+	assert.NoError(t, validateVMPerformance(&models.VMConfig{
+		Performance: &models.VMPerformanceConfig{DedicatedCpuPlacement: true},
+	}))
+	assert.NoError(t, validateVMPerformance(&models.VMConfig{
+		Performance: &models.VMPerformanceConfig{HugepagesSize: "2Mi"},
+	}))
+
+	// NUMA passthrough needs pinned vCPUs and hugepages
+	err := validateVMPerformance(&models.VMConfig{
+		Performance: &models.VMPerformanceConfig{NumaPassthrough: true, HugepagesSize: "1Gi"},
+	})
+	require.True(t, models.IsInvalidVMShapeError(err))
+	assert.Contains(t, err.Error(), "requires dedicatedCpuPlacement")
+
+	err = validateVMPerformance(&models.VMConfig{
+		Performance: &models.VMPerformanceConfig{NumaPassthrough: true, DedicatedCpuPlacement: true},
+	})
+	require.True(t, models.IsInvalidVMShapeError(err))
+	assert.Contains(t, err.Error(), "set hugepagesSize")
+
+	assert.NoError(t, validateVMPerformance(&models.VMConfig{
+		Performance: &models.VMPerformanceConfig{
+			NumaPassthrough:       true,
+			DedicatedCpuPlacement: true,
+			HugepagesSize:         "1Gi",
+		},
+	}))
+}
+
+func TestBuildVirtualMachinePerformance(t *testing.T) {
+	vmConfig := &models.VMConfig{
+		Os:  "fedora",
+		Ram: 8,
+		Cpu: 4,
+		Performance: &models.VMPerformanceConfig{
+			DedicatedCpuPlacement: true,
+			HugepagesSize:         "1Gi",
+			NumaPassthrough:       true,
+		},
+	}
+
+	vm := buildVirtualMachine("devbox", "default", vmConfig, map[string]string{}, map[string]string{}, "", "")
+	domain := vm.Spec.Template.Spec.Domain
+
+	assert.True(t, domain.CPU.DedicatedCPUPlacement)
+	require.NotNil(t, domain.Memory.Hugepages)
+	assert.Equal(t, "1Gi", domain.Memory.Hugepages.PageSize)
+	require.NotNil(t, domain.CPU.NUMA)
+	assert.NotNil(t, domain.CPU.NUMA.GuestMappingPassthrough)
+
+	// Untuned VMs keep the plain topology
+	plain := buildVirtualMachine("devbox", "default", &models.VMConfig{Os: "fedora", Ram: 2, Cpu: 1},
+		map[string]string{}, map[string]string{}, "", "")
+	assert.False(t, plain.Spec.Template.Spec.Domain.CPU.DedicatedCPUPlacement)
+	assert.Nil(t, plain.Spec.Template.Spec.Domain.Memory.Hugepages)
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// pullSecretName names the docker-registry Secret materialized from inline
+// registry credentials
+func pullSecretName(name, id string) string {
+	return fmt.Sprintf("%s-registry-%s", name, id[:8])
+}
+
+// buildDockerConfigJSON renders inline registry credentials into the
+// .dockerconfigjson document kubelet reads from pull Secrets
+func buildDockerConfigJSON(creds *models.RegistryCredentials) ([]byte, error) {
+	auth := map[string]string{
+		"username": creds.Username,
+		"password": creds.Password,
+		"auth":     base64.StdEncoding.EncodeToString([]byte(creds.Username + ":" + creds.Password)),
+	}
+	if creds.Email != "" {
+		auth["email"] = creds.Email
+	}
+	return json.Marshal(map[string]interface{}{
+		"auths": map[string]map[string]string{creds.Server: auth},
+	})
+}
+
+// ensureRegistrySecret materializes inline registry credentials as a pull
+// Secret in the target namespace before the workload references it. Existing
+// Secrets are updated in place so credential rotations roll out with the
+// deployment, keeping whatever ownership they already carry.
+func (c *ContainerService) ensureRegistrySecret(ctx context.Context, namespace, name, id string, creds *models.RegistryCredentials) error {
+	dockerConfig, err := buildDockerConfigJSON(creds)
+	if err != nil {
+		return fmt.Errorf("failed to render registry credentials: %w", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pullSecretName(name, id),
+			Namespace: namespace,
+			Labels:    models.BuildDeploymentLabels(id, name),
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig},
+	}
+
+	if _, err := c.client.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create registry secret: %w", err)
+		}
+		existing, err := c.client.CoreV1().Secrets(namespace).Get(ctx, secret.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to read registry secret: %w", err)
+		}
+		existing.Labels = secret.Labels
+		existing.Type = secret.Type
+		existing.Data = secret.Data
+		if _, err := c.client.CoreV1().Secrets(namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update registry secret: %w", err)
+		}
+	}
+	return nil
+}
+
+// adoptRegistrySecret points the deployment's pull Secret at its Deployment
+// through an OwnerReference, so Kubernetes garbage collection deletes it in
+// cascade. Failures only degrade cleanup, so they are logged rather than
+// failing the deployment.
+func (c *ContainerService) adoptRegistrySecret(ctx context.Context, namespace, name, id string, owner metav1.OwnerReference, logger *zap.Logger) {
+	secret, err := c.client.CoreV1().Secrets(namespace).Get(ctx, pullSecretName(name, id), metav1.GetOptions{})
+	if err != nil {
+		logger.Warn("Failed to read registry secret for ownership", zap.Error(err))
+		return
+	}
+	secret.OwnerReferences = []metav1.OwnerReference{owner}
+	if _, err := c.client.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		logger.Warn("Failed to adopt registry secret", zap.Error(err))
+	}
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestBuildDockerConfigJSON(t *testing.T) {
+	rendered, err := buildDockerConfigJSON(&models.RegistryCredentials{
+		Server:   "registry.example.com",
+		Username: "robot",
+		Password: "hunter22",
+		Email:    "robot@example.com",
+	})
+	require.NoError(t, err)
+
+	// base64("robot:hunter22")
+	assert.JSONEq(t, `{
+		"auths": {
+			"registry.example.com": {
+				"username": "robot",
+				"password": "hunter22",
+				"email": "robot@example.com",
+				"auth": "cm9ib3Q6aHVudGVyMjI="
+			}
+		}
+	}`, string(rendered))
+
+	// Email stays out of the document when not given
+	rendered, err = buildDockerConfigJSON(&models.RegistryCredentials{
+		Server:   "registry.example.com",
+		Username: "robot",
+		Password: "hunter22",
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, string(rendered), "email")
+}
+
+func TestBuildContainerDeploymentImagePullSecrets(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image:            "registry.example.com/team/app:1.0",
+			ImagePullSecrets: []string{"team-pull-secret"},
+			RegistryCredentials: &models.RegistryCredentials{
+				Server:   "registry.example.com",
+				Username: "robot",
+				Password: "hunter22",
+			},
+		},
+	}
+
+	deployment := buildContainerDeployment("app", spec, nil, goldenAnnotations(), goldenDeploymentID, false)
+
+	secrets := deployment.Spec.Template.Spec.ImagePullSecrets
+	require.Len(t, secrets, 2)
+	assert.Equal(t, "team-pull-secret", secrets[0].Name)
+	assert.Equal(t, pullSecretName("app", goldenDeploymentID), secrets[1].Name)
+}
+
+func TestBuildVirtualMachineImagePullSecret(t *testing.T) {
+	// Plain containerdisk pulls carry the Secret on the volume source
+	vm := buildVirtualMachine("devbox", "default",
+		&models.VMConfig{Os: "fedora", Ram: 2, Cpu: 1, ImagePullSecret: "team-pull-secret"},
+		map[string]string{}, map[string]string{}, "", "")
+	require.NotNil(t, vm.Spec.Template.Spec.Volumes[0].ContainerDisk)
+	assert.Equal(t, "team-pull-secret", vm.Spec.Template.Spec.Volumes[0].ContainerDisk.ImagePullSecret)
+
+	// Root disk imports reference it from the DataVolume registry source
+	vm = buildVirtualMachine("devbox", "default",
+		&models.VMConfig{
+			Os: "fedora", Ram: 2, Cpu: 1, ImagePullSecret: "team-pull-secret",
+			RootDisk: &models.VMDiskConfig{Size: "20Gi", SourceImage: "registry.example.com/images/fedora:40"},
+		},
+		map[string]string{}, map[string]string{}, "", "")
+	require.Len(t, vm.Spec.DataVolumeTemplates, 1)
+	registry := vm.Spec.DataVolumeTemplates[0].Spec.Source.Registry
+	require.NotNil(t, registry)
+	require.NotNil(t, registry.SecretRef)
+	assert.Equal(t, "team-pull-secret", *registry.SecretRef)
+}
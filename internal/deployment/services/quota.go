@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// checkNamespaceQuota fails fast when the namespace's ResourceQuotas leave no
+// headroom for the requested resources, instead of creating a workload whose
+// pods sit pending behind the quota. Quotas that do not track a requested
+// resource are ignored, and an unreadable quota list falls back to the API
+// server's own admission.
+func checkNamespaceQuota(ctx context.Context, client kubernetes.Interface, namespace string, requests corev1.ResourceList) error {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	quotas, err := client.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil
+	}
+
+	for i := range quotas.Items {
+		for name, requested := range requests {
+			hard, used, tracked := quotaFor(&quotas.Items[i], name)
+			if !tracked {
+				continue
+			}
+			headroom := hard.DeepCopy()
+			headroom.Sub(used)
+			if requested.Cmp(headroom) > 0 {
+				return models.NewErrQuotaExceeded(namespace, string(name),
+					requested.String(), used.String(), hard.String())
+			}
+		}
+	}
+	return nil
+}
+
+// quotaFor looks up the hard limit and current usage a quota tracks for a
+// resource, preferring the requests.* key over the bare one
+func quotaFor(quota *corev1.ResourceQuota, name corev1.ResourceName) (resource.Quantity, resource.Quantity, bool) {
+	for _, key := range []corev1.ResourceName{corev1.ResourceName("requests." + string(name)), name} {
+		if hard, ok := quota.Spec.Hard[key]; ok {
+			return hard, quota.Status.Used[key], true
+		}
+	}
+	return resource.Quantity{}, resource.Quantity{}, false
+}
+
+// containerQuotaRequests totals the CPU and memory a pod-backed deployment
+// will request across its replicas, counting the main container and its
+// sidecars. Values the spec leaves unset contribute nothing; malformed ones
+// are caught by spec validation before this runs.
+func containerQuotaRequests(container *models.ContainerConfig, replicas int) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	accumulateQuotaRequests(requests, container.Resources)
+	for i := range container.Sidecars {
+		accumulateQuotaRequests(requests, container.Sidecars[i].Resources)
+	}
+	return scaleResourceList(requests, replicas)
+}
+
+// vmQuotaRequests totals the guest CPU and memory a VM deployment will
+// request. Flavor-sized VMs resolve their shape later, so only an explicit
+// cpu and ram count here.
+func vmQuotaRequests(vm *models.VMConfig) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	if vm.Cpu > 0 {
+		requests[corev1.ResourceCPU] = *resource.NewQuantity(int64(vm.Cpu), resource.DecimalSI)
+	}
+	if vm.Ram > 0 {
+		requests[corev1.ResourceMemory] = resource.MustParse(fmt.Sprintf("%dGi", vm.Ram))
+	}
+	return requests
+}
+
+// accumulateQuotaRequests adds a resource config's CPU and memory into a
+// running total
+func accumulateQuotaRequests(requests corev1.ResourceList, cfg *models.ResourceConfig) {
+	if cfg == nil {
+		return
+	}
+	addQuotaRequest(requests, corev1.ResourceCPU, cfg.CPU)
+	addQuotaRequest(requests, corev1.ResourceMemory, cfg.Memory)
+}
+
+// addQuotaRequest parses a quantity string into a resource total, ignoring
+// unset and malformed values
+func addQuotaRequest(requests corev1.ResourceList, name corev1.ResourceName, value string) {
+	if value == "" {
+		return
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return
+	}
+	if existing, ok := requests[name]; ok {
+		quantity.Add(existing)
+	}
+	requests[name] = quantity
+}
+
+// scaleResourceList multiplies per-pod totals by the replica count
+func scaleResourceList(requests corev1.ResourceList, replicas int) corev1.ResourceList {
+	if replicas <= 1 {
+		return requests
+	}
+	scaled := corev1.ResourceList{}
+	for name, quantity := range requests {
+		total := quantity.DeepCopy()
+		for i := 1; i < replicas; i++ {
+			total.Add(quantity)
+		}
+		scaled[name] = total
+	}
+	return scaled
+}
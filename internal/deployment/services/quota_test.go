@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func quotaFixture(hard, used corev1.ResourceList) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-quota", Namespace: "team-a"},
+		Spec:       corev1.ResourceQuotaSpec{Hard: hard},
+		Status:     corev1.ResourceQuotaStatus{Hard: hard, Used: used},
+	}
+}
+
+func TestCheckNamespaceQuota(t *testing.T) {
+	quota := quotaFixture(
+		corev1.ResourceList{
+			"requests.cpu":    resource.MustParse("2"),
+			"requests.memory": resource.MustParse("4Gi"),
+		},
+		corev1.ResourceList{
+			"requests.cpu":    resource.MustParse("1500m"),
+			"requests.memory": resource.MustParse("1Gi"),
+		},
+	)
+
+	tests := []struct {
+		name     string
+		requests corev1.ResourceList
+		wantErr  bool
+		contains string
+	}{
+		{
+			name: "fits within headroom",
+			requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		},
+		{
+			name: "cpu exceeds headroom",
+			requests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("1"),
+			},
+			wantErr:  true,
+			contains: "used 1500m of 2",
+		},
+		{
+			name: "memory exceeds headroom",
+			requests: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+			wantErr:  true,
+			contains: "used 1Gi of 4Gi",
+		},
+		{
+			name: "untracked resource is ignored",
+			requests: corev1.ResourceList{
+				corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+			},
+		},
+		{
+			name:     "empty request list passes",
+			requests: corev1.ResourceList{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(quota)
+			err := checkNamespaceQuota(context.Background(), client, "team-a", tt.requests)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, models.IsQuotaExceededError(err))
+				assert.Contains(t, err.Error(), tt.contains)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	// A namespace without quotas never blocks a create
+	client := fake.NewSimpleClientset()
+	err := checkNamespaceQuota(context.Background(), client, "team-a", corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("100"),
+	})
+	assert.NoError(t, err)
+}
+
+func TestCheckNamespaceQuotaBareKeys(t *testing.T) {
+	// Quotas written with the bare "cpu"/"memory" keys are honoured too
+	quota := quotaFixture(
+		corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("900m")},
+	)
+	client := fake.NewSimpleClientset(quota)
+
+	err := checkNamespaceQuota(context.Background(), client, "team-a", corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("200m"),
+	})
+	require.Error(t, err)
+	assert.True(t, models.IsQuotaExceededError(err))
+}
+
+func TestContainerQuotaRequests(t *testing.T) {
+	container := &models.ContainerConfig{
+		Resources: &models.ResourceConfig{CPU: "250m", Memory: "256Mi"},
+		Sidecars: []models.AuxiliaryContainer{
+			{Resources: &models.ResourceConfig{CPU: "50m", Memory: "64Mi"}},
+		},
+	}
+
+	requests := containerQuotaRequests(container, 3)
+	cpu := requests[corev1.ResourceCPU]
+	memory := requests[corev1.ResourceMemory]
+	assert.Equal(t, "900m", cpu.String())
+	assert.Equal(t, "960Mi", memory.String())
+
+	// Unset resources contribute nothing
+	assert.Empty(t, containerQuotaRequests(&models.ContainerConfig{}, 3))
+}
+
+func TestVMQuotaRequests(t *testing.T) {
+	requests := vmQuotaRequests(&models.VMConfig{Cpu: 2, Ram: 4})
+	cpu := requests[corev1.ResourceCPU]
+	memory := requests[corev1.ResourceMemory]
+	assert.Equal(t, "2", cpu.String())
+	assert.Equal(t, "4Gi", memory.String())
+
+	// Flavor-sized VMs carry no explicit shape, so nothing is counted
+	assert.Empty(t, vmQuotaRequests(&models.VMConfig{}))
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// kindHandler bundles the backend operations a deployment kind registers
+// with the orchestrator. The create through events operations are required
+// for every kind; logs and action are optional and left nil by kinds that
+// do not support them.
+type kindHandler struct {
+	create func(ctx context.Context, req *models.DeploymentRequest, id string) error
+	get    func(ctx context.Context, id string) (*models.DeploymentResponse, error)
+	update func(ctx context.Context, req *models.DeploymentRequest, id string) error
+	delete func(ctx context.Context, id, namespace string) error
+	list   func(ctx context.Context, namespace string, limit, offset int) ([]models.DeploymentResponse, error)
+	dryRun func(ctx context.Context, req *models.DeploymentRequest, id string) ([]interface{}, error)
+	watch  func(ctx context.Context, id, namespace string) (<-chan models.DeploymentResponse, error)
+	events func(ctx context.Context, id, namespace string) ([]models.DeploymentEvent, error)
+	logs   func(ctx context.Context, id, namespace string, req *models.DeploymentLogsRequest) (io.ReadCloser, error)
+	action func(ctx context.Context, id, namespace, action string) (models.DeploymentPhase, error)
+}
+
+// registerKind adds a kind's backend to the dispatch registry. Kinds are
+// dispatched in registration order wherever results are aggregated, so the
+// order here fixes the order of global lookups and listings.
+func (d *DeploymentService) registerKind(kind models.DeploymentKind, handler *kindHandler) {
+	d.kinds[kind] = handler
+	d.kindOrder = append(d.kindOrder, kind)
+}
+
+// handlerFor resolves the registered backend for a deployment kind
+func (d *DeploymentService) handlerFor(kind models.DeploymentKind) (*kindHandler, error) {
+	handler, ok := d.kinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported deployment kind: %s", kind)
+	}
+	return handler, nil
+}
+
+// registerBuiltinKinds wires the built-in kind backends into the dispatch
+// registry. New kinds plug in with a registerKind call here instead of
+// editing the orchestrator's dispatch paths.
+func (d *DeploymentService) registerBuiltinKinds() {
+	d.registerKind(models.DeploymentKindContainer, &kindHandler{
+		create: d.containerService.CreateContainer,
+		get:    d.containerService.GetContainer,
+		update: d.containerService.UpdateContainer,
+		delete: d.containerService.DeleteContainer,
+		list:   d.containerService.ListContainers,
+		dryRun: d.containerService.DryRunContainer,
+		watch:  d.containerService.WatchContainer,
+		events: d.containerService.GetContainerEvents,
+		logs:   d.containerService.GetContainerLogs,
+	})
+	d.registerKind(models.DeploymentKindVM, &kindHandler{
+		create: d.vmService.CreateVM,
+		get:    d.vmService.GetVM,
+		update: d.vmService.UpdateVM,
+		delete: d.vmService.DeleteVM,
+		list:   d.vmService.ListVMs,
+		dryRun: d.vmService.DryRunVM,
+		watch:  d.vmService.WatchVM,
+		events: d.vmService.GetVMEvents,
+		action: d.vmService.PerformVMAction,
+	})
+	d.registerKind(models.DeploymentKindJob, &kindHandler{
+		create: d.jobService.CreateJob,
+		get:    d.jobService.GetJob,
+		update: d.jobService.UpdateJob,
+		delete: d.jobService.DeleteJob,
+		list:   d.jobService.ListJobs,
+		dryRun: d.jobService.DryRunJob,
+		watch:  d.jobService.WatchJob,
+		events: d.jobService.GetJobEvents,
+		logs:   d.jobService.GetJobLogs,
+	})
+	d.registerKind(models.DeploymentKindCronJob, &kindHandler{
+		create: d.cronJobService.CreateCronJob,
+		get:    d.cronJobService.GetCronJob,
+		update: d.cronJobService.UpdateCronJob,
+		delete: d.cronJobService.DeleteCronJob,
+		list:   d.cronJobService.ListCronJobs,
+		dryRun: d.cronJobService.DryRunCronJob,
+		watch:  d.cronJobService.WatchCronJob,
+		events: d.cronJobService.GetCronJobEvents,
+		// Pods spawned by the schedule carry the deployment labels, so the
+		// job log path serves cronjobs as well
+		logs:   d.jobService.GetJobLogs,
+		action: d.cronJobService.PerformCronJobAction,
+	})
+	d.registerKind(models.DeploymentKindStatefulSet, &kindHandler{
+		create: d.statefulSetService.CreateStatefulSet,
+		get:    d.statefulSetService.GetStatefulSet,
+		update: d.statefulSetService.UpdateStatefulSet,
+		delete: d.statefulSetService.DeleteStatefulSet,
+		list:   d.statefulSetService.ListStatefulSets,
+		dryRun: d.statefulSetService.DryRunStatefulSet,
+		watch:  d.statefulSetService.WatchStatefulSet,
+		events: d.statefulSetService.GetStatefulSetEvents,
+		// StatefulSet replicas carry the deployment labels, so the container
+		// log path serves them as well
+		logs: d.containerService.GetContainerLogs,
+	})
+	d.registerKind(models.DeploymentKindManifest, &kindHandler{
+		create: d.manifestService.CreateManifest,
+		get:    d.manifestService.GetManifest,
+		update: d.manifestService.UpdateManifest,
+		delete: d.manifestService.DeleteManifest,
+		list:   d.manifestService.ListManifests,
+		dryRun: d.manifestService.DryRunManifest,
+		watch:  d.manifestService.WatchManifest,
+		events: d.manifestService.GetManifestEvents,
+	})
+}
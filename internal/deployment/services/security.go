@@ -0,0 +1,92 @@
+package services
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// applySecurityPolicy enforces the operator pod security profile on a
+// container config. Under the restricted profile, unset security fields are
+// filled with the Pod Security Standards "restricted" defaults — run as
+// non-root with all capabilities dropped — and specs that explicitly violate
+// the profile are rejected. The field prefix keeps error messages accurate
+// across kinds.
+func applySecurityPolicy(workload config.WorkloadConfig, field string, container *models.ContainerConfig) error {
+	if workload.PodSecurityProfile != config.PodSecurityRestricted {
+		return nil
+	}
+
+	if container.Security == nil {
+		container.Security = &models.SecurityConfig{}
+	}
+	security := container.Security
+
+	if security.RunAsNonRoot == nil {
+		security.RunAsNonRoot = ptr.To(true)
+	} else if !*security.RunAsNonRoot {
+		return models.NewErrInvalidSpec(field+".runAsNonRoot",
+			"the restricted pod security profile requires containers to run as non-root")
+	}
+
+	if len(security.DropCapabilities) == 0 {
+		security.DropCapabilities = []string{"ALL"}
+	} else if !dropsAllCapabilities(security.DropCapabilities) {
+		return models.NewErrInvalidSpec(field+".dropCapabilities",
+			`the restricted pod security profile requires dropping "ALL" capabilities`)
+	}
+	return nil
+}
+
+// dropsAllCapabilities reports whether a capability drop list includes the
+// ALL wildcard
+func dropsAllCapabilities(capabilities []string) bool {
+	for _, capability := range capabilities {
+		if strings.EqualFold(capability, "ALL") {
+			return true
+		}
+	}
+	return false
+}
+
+// applySecurityContext renders a security config onto a pod template.
+// RunAsNonRoot lands on the pod-level security context; the container-level
+// fields apply to the main container, init containers and sidecars alike.
+func applySecurityContext(podSpec *corev1.PodSpec, security *models.SecurityConfig) {
+	if security == nil {
+		return
+	}
+	if security.RunAsNonRoot != nil {
+		if podSpec.SecurityContext == nil {
+			podSpec.SecurityContext = &corev1.PodSecurityContext{}
+		}
+		podSpec.SecurityContext.RunAsNonRoot = security.RunAsNonRoot
+	}
+	for i := range podSpec.InitContainers {
+		podSpec.InitContainers[i].SecurityContext = buildSecurityContext(security)
+	}
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].SecurityContext = buildSecurityContext(security)
+	}
+}
+
+// buildSecurityContext translates a security config into a container
+// security context
+func buildSecurityContext(security *models.SecurityConfig) *corev1.SecurityContext {
+	built := &corev1.SecurityContext{
+		RunAsNonRoot:           security.RunAsNonRoot,
+		ReadOnlyRootFilesystem: security.ReadOnlyRootFilesystem,
+	}
+	if len(security.DropCapabilities) > 0 {
+		drops := make([]corev1.Capability, 0, len(security.DropCapabilities))
+		for _, capability := range security.DropCapabilities {
+			drops = append(drops, corev1.Capability(capability))
+		}
+		built.Capabilities = &corev1.Capabilities{Drop: drops}
+	}
+	return built
+}
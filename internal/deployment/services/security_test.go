@@ -0,0 +1,120 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestApplySecurityPolicy(t *testing.T) {
+	restricted := config.WorkloadConfig{PodSecurityProfile: config.PodSecurityRestricted}
+
+	tests := []struct {
+		name        string
+		workload    config.WorkloadConfig
+		security    *models.SecurityConfig
+		expected    *models.SecurityConfig
+		expectError bool
+		contains    string
+	}{
+		{
+			name:     "no profile passes the spec through",
+			workload: config.WorkloadConfig{},
+			security: nil,
+			expected: nil,
+		},
+		{
+			name:     "restricted fills defaults into an empty spec",
+			workload: restricted,
+			security: nil,
+			expected: &models.SecurityConfig{
+				RunAsNonRoot:     ptr.To(true),
+				DropCapabilities: []string{"ALL"},
+			},
+		},
+		{
+			name:     "restricted keeps compliant spec values",
+			workload: restricted,
+			security: &models.SecurityConfig{
+				ReadOnlyRootFilesystem: ptr.To(true),
+				DropCapabilities:       []string{"all"},
+			},
+			expected: &models.SecurityConfig{
+				RunAsNonRoot:           ptr.To(true),
+				ReadOnlyRootFilesystem: ptr.To(true),
+				DropCapabilities:       []string{"all"},
+			},
+		},
+		{
+			name:        "restricted rejects running as root",
+			workload:    restricted,
+			security:    &models.SecurityConfig{RunAsNonRoot: ptr.To(false)},
+			expectError: true,
+			contains:    "run as non-root",
+		},
+		{
+			name:        "restricted rejects a partial capability drop",
+			workload:    restricted,
+			security:    &models.SecurityConfig{DropCapabilities: []string{"NET_RAW"}},
+			expectError: true,
+			contains:    `dropping "ALL"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container := models.ContainerConfig{Image: "nginx:latest", Security: tt.security}
+
+			err := applySecurityPolicy(tt.workload, "spec.container.security", &container)
+
+			if tt.expectError {
+				require.True(t, models.IsInvalidSpecError(err))
+				assert.Contains(t, err.Error(), tt.contains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, container.Security)
+		})
+	}
+}
+
+func TestBuildContainerDeploymentSecurityContext(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "app:1.0",
+			InitContainers: []models.AuxiliaryContainer{
+				{Name: "migrate", Image: "app:1.0"},
+			},
+			Sidecars: []models.AuxiliaryContainer{
+				{Name: "log-shipper", Image: "fluent-bit:2.2"},
+			},
+			Security: &models.SecurityConfig{
+				RunAsNonRoot:           ptr.To(true),
+				ReadOnlyRootFilesystem: ptr.To(true),
+				DropCapabilities:       []string{"ALL"},
+			},
+		},
+	}
+
+	deployment := buildContainerDeployment("app", spec, nil, goldenAnnotations(), goldenDeploymentID, false)
+	podSpec := deployment.Spec.Template.Spec
+
+	require.NotNil(t, podSpec.SecurityContext)
+	assert.Equal(t, ptr.To(true), podSpec.SecurityContext.RunAsNonRoot)
+
+	// Init containers and sidecars are hardened like the main container
+	containers := append(podSpec.InitContainers, podSpec.Containers...)
+	require.Len(t, containers, 3)
+	for _, container := range containers {
+		require.NotNil(t, container.SecurityContext, container.Name)
+		assert.Equal(t, ptr.To(true), container.SecurityContext.ReadOnlyRootFilesystem)
+		require.NotNil(t, container.SecurityContext.Capabilities)
+		assert.Equal(t, []corev1.Capability{"ALL"}, container.SecurityContext.Capabilities.Drop)
+	}
+}
@@ -2,36 +2,246 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
 
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/defaults"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/events"
+	"github.com/dcm-project/k8s-service-provider/internal/flavors"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
 	"github.com/dcm-project/k8s-service-provider/internal/k8s"
+	"github.com/dcm-project/k8s-service-provider/internal/netid"
+	"github.com/dcm-project/k8s-service-provider/internal/placement"
+	"github.com/dcm-project/k8s-service-provider/internal/records"
+	"github.com/dcm-project/k8s-service-provider/internal/tenancy"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 )
 
+// listAllLimit removes the per-kind cap when aggregating deployments for a
+// list: limit and continue are applied to the stable aggregate afterwards,
+// not to the individual sub-lists
+const listAllLimit = math.MaxInt
+
 // DeploymentServiceInterface defines the interface for deployment operations
 type DeploymentServiceInterface interface {
 	CreateDeployment(ctx context.Context, req *models.DeploymentRequest, id string) error
+	DryRunDeployment(ctx context.Context, req *models.DeploymentRequest, id string) (*models.DryRunResponse, error)
 	GetDeploymentByID(ctx context.Context, id string) (*models.DeploymentResponse, error)
 	UpdateDeployment(ctx context.Context, req *models.DeploymentRequest, id string) error
+	UpdateDeploymentMetadata(ctx context.Context, id string, req *models.UpdateDeploymentMetadataRequest) (*models.DeploymentResponse, error)
 	DeleteDeployment(ctx context.Context, id string) error
 	ListDeployments(ctx context.Context, req *models.ListDeploymentsRequest) (*models.ListDeploymentsResponse, error)
+	WatchDeployment(ctx context.Context, id string) (<-chan models.DeploymentResponse, error)
+	GetDeploymentLogs(ctx context.Context, id string, req *models.DeploymentLogsRequest) (io.ReadCloser, error)
+	GetDeploymentConsoleLog(ctx context.Context, id string, req *models.DeploymentLogsRequest) (io.ReadCloser, error)
+	StreamDeploymentConsole(ctx context.Context, id, consoleType string) (kvcorev1.StreamInterface, error)
+	GetDeploymentEvents(ctx context.Context, id string) (*models.DeploymentEventsResponse, error)
+	GetDeploymentMetrics(ctx context.Context, id string) (*models.DeploymentMetricsResponse, error)
+	NamespaceWarningEvents(ctx context.Context, namespace string) ([]models.DeploymentEvent, error)
+	PerformDeploymentAction(ctx context.Context, id, action string) (*models.DeploymentActionResponse, error)
+	AttachDeploymentDisk(ctx context.Context, id string, disk *models.VMDiskConfig) (*models.DeploymentActionResponse, error)
+	DetachDeploymentDisk(ctx context.Context, id, diskName string) (*models.DeploymentActionResponse, error)
+	AdoptDeployment(ctx context.Context, req *models.AdoptDeploymentRequest, id string) (*models.DeploymentResponse, error)
+	DeploymentDrift(ctx context.Context, id string) (*models.DriftResponse, error)
+	SweepOrphans(ctx context.Context, remove bool) (*models.OrphanSweepResponse, error)
+	StartNodeMaintenance(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error)
+	NodeMaintenanceStatus(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error)
+	EndNodeMaintenance(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error)
+	DefaultsProfiles(ctx context.Context) []defaults.Profile
+	VMFlavors(ctx context.Context) []flavors.Flavor
+	BuildSyncPlan(ctx context.Context, req *models.SyncPlanRequest) (*models.SyncPlanResponse, error)
+	ApplySyncPlan(ctx context.Context, req *models.SyncApplyRequest) (*models.SyncApplyResponse, error)
+	RefreshCapabilities(ctx context.Context) models.Capabilities
 }
 
 // DeploymentService orchestrates container and VM deployments
 type DeploymentService struct {
-	containerService *ContainerService
-	vmService        *VMService
-	logger           *zap.Logger
+	containerService   *ContainerService
+	vmService          *VMService
+	jobService         *JobService
+	cronJobService     *CronJobService
+	statefulSetService *StatefulSetService
+	manifestService    *ManifestService
+	client             kubernetes.Interface
+	readClient         kubernetes.Interface
+	workload           config.WorkloadConfig
+	eventBus           events.BusInterface
+	placement          placement.Strategy
+	defaults           *defaults.Engine
+	flavorCatalog      *flavors.Catalog
+	netRegistry        netid.Registry
+	nsPolicy           *NamespacePolicy
+	records            records.StoreInterface
+	clusters           map[string]*DeploymentService
+	kinds              map[models.DeploymentKind]*kindHandler
+	kindOrder          []models.DeploymentKind
+	locks              *operationLocks
+	logger             *zap.Logger
 }
 
 // NewDeploymentService creates a new deployment service
-func NewDeploymentService(k8sClient k8s.ClientInterface, logger *zap.Logger) *DeploymentService {
-	return &DeploymentService{
-		containerService: NewContainerService(k8sClient.GetClientset(), logger),
-		vmService:        NewVMService(k8sClient.GetClientset(), logger),
-		logger:           logger,
+func NewDeploymentService(k8sClient k8s.ClientInterface, eventBus events.BusInterface, vmShape config.VMShapeConfig, workload config.WorkloadConfig, logger *zap.Logger) *DeploymentService {
+	vmService := NewVMService(k8sClient.GetClientset(), k8sClient.GetReadClientset(), vmShape, logger)
+	return newDeploymentService(k8sClient, vmService, eventBus, workload, logger)
+}
+
+// NewClusterDeploymentService creates a deployment service for a registered
+// target cluster. It mirrors NewDeploymentService except that the KubeVirt
+// client authenticates with the cluster's kubeconfig rather than the
+// provider's own environment.
+func NewClusterDeploymentService(k8sClient k8s.ClientInterface, kubeconfig string, eventBus events.BusInterface, vmShape config.VMShapeConfig, workload config.WorkloadConfig, logger *zap.Logger) *DeploymentService {
+	vmService := NewVMServiceFromKubeconfig(k8sClient.GetClientset(), k8sClient.GetReadClientset(), kubeconfig, vmShape, logger)
+	return newDeploymentService(k8sClient, vmService, eventBus, workload, logger)
+}
+
+func newDeploymentService(k8sClient k8s.ClientInterface, vmService *VMService, eventBus events.BusInterface, workload config.WorkloadConfig, logger *zap.Logger) *DeploymentService {
+	service := &DeploymentService{
+		containerService:   NewContainerService(k8sClient.GetClientset(), k8sClient.GetReadClientset(), workload, logger),
+		vmService:          vmService,
+		jobService:         NewJobService(k8sClient.GetClientset(), k8sClient.GetReadClientset(), logger),
+		cronJobService:     NewCronJobService(k8sClient.GetClientset(), k8sClient.GetReadClientset(), logger),
+		statefulSetService: NewStatefulSetService(k8sClient.GetClientset(), k8sClient.GetReadClientset(), workload, logger),
+		manifestService:    NewManifestService(k8sClient.GetClientset(), k8sClient.GetReadClientset(), k8sClient.GetDynamicClient(), k8sClient.GetRESTMapper(), logger),
+		client:             k8sClient.GetClientset(),
+		readClient:         k8sClient.GetReadClientset(),
+		workload:           workload,
+		eventBus:           eventBus,
+		placement:          placement.NewPassthrough(),
+		kinds:              make(map[models.DeploymentKind]*kindHandler),
+		locks:              newOperationLocks(),
+		logger:             logger,
+	}
+	service.registerBuiltinKinds()
+	return service
+}
+
+// SetNetworkIdentityRegistry enables stable MAC address and hostname
+// reservations for VM deployments. Reservations are applied on create and
+// held across updates; they are released when the deployment is deleted.
+func (d *DeploymentService) SetNetworkIdentityRegistry(registry netid.Registry) {
+	d.netRegistry = registry
+	d.vmService.SetNetworkIdentityRegistry(registry)
+}
+
+// SetDefaultsEngine enables operator-provided defaults profiles, applied to
+// create requests after placement resolves the target namespace
+func (d *DeploymentService) SetDefaultsEngine(engine *defaults.Engine) {
+	d.defaults = engine
+}
+
+// StartInformerCache builds and starts the shared informer cache and routes
+// deployment and VM reads through it. The informers run until ctx is
+// cancelled; reads fall back to live LIST calls until the stores sync. The
+// VM informer is only created when KubeVirt is available at startup.
+func (d *DeploymentService) StartInformerCache(ctx context.Context, resync time.Duration) {
+	cache := NewInformerCache(d.readClient, d.vmService.kubevirtClient, resync, d.vmService.Available(), d.logger)
+	cache.Start(ctx)
+	d.containerService.cache = cache
+	d.vmService.cache = cache
+}
+
+// SetPlacementStrategy swaps the placement strategy consulted at create time.
+// The service defaults to passthrough placement; call this before serving
+// requests, as the strategy is not guarded for concurrent replacement.
+func (d *DeploymentService) SetPlacementStrategy(strategy placement.Strategy) {
+	d.placement = strategy
+}
+
+// SetClusterTargets registers deployment services for additional target
+// clusters. Creates and updates naming a cluster via metadata.cluster are
+// delegated to the matching target, and global ID lookups and aggregated
+// listings span every registered cluster.
+func (d *DeploymentService) SetClusterTargets(targets map[string]*DeploymentService) {
+	d.clusters = targets
+}
+
+// SetRecordStore persists deployment records outside the cluster. Records
+// are written on create and update and removed on delete, and ID lookups
+// fall back to the store when the cluster objects are gone.
+func (d *DeploymentService) SetRecordStore(store records.StoreInterface) {
+	d.records = store
+}
+
+// saveRecord snapshots a deployment into the record store; persistence is
+// best-effort and never fails the cluster operation that triggered it
+func (d *DeploymentService) saveRecord(ctx context.Context, req *models.DeploymentRequest, id string) {
+	if d.records == nil {
+		return
 	}
+
+	spec, err := json.Marshal(req.Spec)
+	if err != nil {
+		d.logger.Warn("Failed to snapshot deployment spec", zap.String("deployment_id", id), zap.Error(err))
+		spec = nil
+	}
+	record := &records.Record{
+		ID:        id,
+		Kind:      string(req.Kind),
+		Name:      req.Metadata.Name,
+		Namespace: req.Metadata.Namespace,
+		Spec:      spec,
+		Phase:     string(models.DeploymentPhasePending),
+	}
+	if creator, ok := identity.FromContext(ctx); ok {
+		record.Owner = creator.Subject
+	}
+	if err := d.records.Save(ctx, record); err != nil {
+		d.logger.Warn("Failed to persist deployment record", zap.String("deployment_id", id), zap.Error(err))
+	}
+}
+
+// clusterTarget resolves the deployment service for a named target cluster
+func (d *DeploymentService) clusterTarget(name string) (*DeploymentService, error) {
+	if target, ok := d.clusters[name]; ok {
+		return target, nil
+	}
+	return nil, models.NewErrClusterNotFound(name)
+}
+
+// clusterNames returns the registered target cluster names in stable order
+func (d *DeploymentService) clusterNames() []string {
+	names := make([]string, 0, len(d.clusters))
+	for name := range d.clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultsProfiles returns the loaded defaults profiles in application order,
+// or nil when no defaults engine is configured
+func (d *DeploymentService) DefaultsProfiles(ctx context.Context) []defaults.Profile {
+	if d.defaults == nil {
+		return nil
+	}
+	return d.defaults.Profiles()
+}
+
+// publishEvent publishes a lifecycle event on the bus if one is configured
+func (d *DeploymentService) publishEvent(eventType events.EventType, id string, kind models.DeploymentKind, name, namespace, phase string) {
+	if d.eventBus == nil {
+		return
+	}
+	d.eventBus.Publish(events.Event{
+		Type:         eventType,
+		DeploymentID: id,
+		Kind:         string(kind),
+		Name:         name,
+		Namespace:    namespace,
+		Phase:        phase,
+	})
 }
 
 // CreateDeployment creates a new deployment based on the kind
@@ -44,8 +254,33 @@ func (d *DeploymentService) CreateDeployment(ctx context.Context, req *models.De
 
 	logger.Info("Creating deployment")
 
-	// Check for global ID uniqueness before creating
-	existingDeployment, err := d.GetDeploymentByID(ctx, id)
+	// Caller annotations must never shadow the provider's reserved keys
+	if err := models.ValidateUserAnnotations(req.Metadata.Annotations); err != nil {
+		return err
+	}
+
+	// Route the create to its target cluster when one is named; the target
+	// then handles the request as its own local cluster
+	if req.Metadata.Cluster != "" {
+		target, err := d.clusterTarget(req.Metadata.Cluster)
+		if err != nil {
+			logger.Warn("Requested cluster is not registered", zap.String("cluster", req.Metadata.Cluster))
+			return err
+		}
+		req.Metadata.Cluster = ""
+		return target.CreateDeployment(ctx, req, id)
+	}
+
+	// Serialize mutations on this deployment ID
+	if err := d.locks.acquire(id, "create"); err != nil {
+		return err
+	}
+	defer d.locks.release(id)
+
+	// Check for global ID uniqueness before creating. Only live cluster
+	// objects count: a surviving record without objects is exactly what the
+	// reconciler recreates under the recorded ID.
+	existingDeployment, err := d.liveDeploymentByID(ctx, id)
 	if err == nil {
 		// Deployment with this ID already exists
 		logger.Error("Deployment ID already exists",
@@ -68,14 +303,171 @@ func (d *DeploymentService) CreateDeployment(ctx context.Context, req *models.De
 		return fmt.Errorf("failed to validate deployment ID uniqueness: %w", err)
 	}
 
-	switch req.Kind {
-	case models.DeploymentKindContainer:
-		return d.containerService.CreateContainer(ctx, req, id)
-	case models.DeploymentKindVM:
-		return d.vmService.CreateVM(ctx, req, id)
-	default:
-		return fmt.Errorf("unsupported deployment kind: %s", req.Kind)
+	// Let the placement strategy choose the target namespace
+	decision, err := d.placement.Place(ctx, &placement.Request{
+		Kind:      string(req.Kind),
+		Name:      req.Metadata.Name,
+		Namespace: req.Metadata.Namespace,
+		Labels:    req.Metadata.Labels,
+	})
+	if err != nil {
+		logger.Error("Placement failed", zap.String("strategy", d.placement.Name()), zap.Error(err))
+		return fmt.Errorf("failed to place deployment: %w", err)
+	}
+	if decision.Namespace != req.Metadata.Namespace {
+		logger.Info("Placement chose namespace",
+			zap.String("strategy", d.placement.Name()),
+			zap.String("namespace", decision.Namespace))
+		req.Metadata.Namespace = decision.Namespace
+	}
+
+	// Reject creates into namespaces the tenant does not own; checked after
+	// placement so a strategy cannot route a tenant outside their namespaces
+	if !tenancy.Allowed(ctx, req.Metadata.Namespace) {
+		logger.Warn("Tenant is not allowed to deploy into namespace",
+			zap.String("namespace", req.Metadata.Namespace))
+		return models.NewErrNamespaceForbidden(req.Metadata.Namespace)
+	}
+
+	// Enforce the operator's namespace policy: protected system namespaces,
+	// the optional allowlist, and the implicit namespace creation toggle
+	if err := d.checkNamespacePolicy(ctx, req.Metadata.Namespace); err != nil {
+		logger.Warn("Namespace refused by policy",
+			zap.String("namespace", req.Metadata.Namespace), zap.Error(err))
+		return err
+	}
+
+	// Enforce the operator's name uniqueness policy in the target namespace
+	if err := d.resolveNameConflict(ctx, req, id, logger); err != nil {
+		return err
+	}
+
+	// Fill unset spec fields from the operator defaults profiles
+	if d.defaults != nil {
+		d.defaults.Apply(ctx, req)
+	}
+
+	handler, err := d.handlerFor(req.Kind)
+	if err != nil {
+		return err
+	}
+	if err := handler.create(ctx, req, id); err != nil {
+		return err
+	}
+
+	d.publishEvent(events.EventTypeCreated, id, req.Kind, req.Metadata.Name, req.Metadata.Namespace, string(models.DeploymentPhasePending))
+	d.saveRecord(ctx, req, id)
+	return nil
+}
+
+// resolveNameConflict enforces the operator's name uniqueness policy when the
+// requested metadata.name is already taken by a managed deployment in the
+// target namespace. Under the reject policy the create fails with a conflict;
+// under the suffix policy the name is made unique with the deployment ID
+// prefix and the rewrite is logged.
+func (d *DeploymentService) resolveNameConflict(ctx context.Context, req *models.DeploymentRequest, id string, logger *zap.Logger) error {
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	taken, err := d.namespaceNameTaken(ctx, namespace, req.Metadata.Name)
+	if err != nil {
+		return fmt.Errorf("failed to check name uniqueness: %w", err)
+	}
+	if !taken {
+		return nil
+	}
+
+	if d.workload.NameConflictPolicy == config.NameConflictSuffix {
+		resolved := fmt.Sprintf("%s-%s", req.Metadata.Name, id[:8])
+		logger.Warn("Deployment name already in use, applying unique suffix",
+			zap.String("namespace", namespace),
+			zap.String("resolved_name", resolved))
+		req.Metadata.Name = resolved
+		return nil
+	}
+
+	logger.Error("Deployment name already in use",
+		zap.String("namespace", namespace))
+	return models.NewErrNameConflict(req.Metadata.Name, namespace)
+}
+
+// namespaceNameTaken reports whether a managed deployment of any kind already
+// uses the name in the namespace. VM listing is skipped when KubeVirt is
+// unavailable, matching ListDeployments.
+func (d *DeploymentService) namespaceNameTaken(ctx context.Context, namespace, name string) (bool, error) {
+	const listLimit = 1000
+
+	deployments, err := d.containerService.ListContainers(ctx, namespace, listLimit, 0)
+	if err != nil {
+		return false, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	if vms, err := d.vmService.ListVMs(ctx, namespace, listLimit, 0); err == nil {
+		deployments = append(deployments, vms...)
+	} else if !models.IsKubeVirtUnavailableError(err) {
+		return false, fmt.Errorf("failed to list VMs: %w", err)
+	}
+
+	jobs, err := d.jobService.ListJobs(ctx, namespace, listLimit, 0)
+	if err != nil {
+		return false, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	deployments = append(deployments, jobs...)
+
+	cronJobs, err := d.cronJobService.ListCronJobs(ctx, namespace, listLimit, 0)
+	if err != nil {
+		return false, fmt.Errorf("failed to list cronjobs: %w", err)
+	}
+	deployments = append(deployments, cronJobs...)
+
+	statefulSets, err := d.statefulSetService.ListStatefulSets(ctx, namespace, listLimit, 0)
+	if err != nil {
+		return false, fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+	deployments = append(deployments, statefulSets...)
+
+	manifests, err := d.manifestService.ListManifests(ctx, namespace, listLimit, 0)
+	if err != nil {
+		return false, fmt.Errorf("failed to list manifest deployments: %w", err)
+	}
+	deployments = append(deployments, manifests...)
+
+	for i := range deployments {
+		if deployments[i].Metadata.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// DryRunDeployment validates a deployment request against the cluster using a
+// Kubernetes server-side dry-run and returns the rendered manifests without
+// persisting anything
+func (d *DeploymentService) DryRunDeployment(ctx context.Context, req *models.DeploymentRequest, id string) (*models.DryRunResponse, error) {
+	logger := d.logger.Named("deployment_service").With(
+		zap.String("kind", string(req.Kind)),
+		zap.String("name", req.Metadata.Name),
+		zap.String("deployment_id", id),
+	)
+
+	logger.Info("Dry-running deployment")
+
+	handler, err := d.handlerFor(req.Kind)
+	if err != nil {
+		return nil, err
 	}
+	manifests, err := handler.dryRun(ctx, req, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.DryRunResponse{
+		ID:        id,
+		Kind:      req.Kind,
+		Manifests: manifests,
+	}, nil
 }
 
 // GetDeployment retrieves a deployment by ID and kind
@@ -87,14 +479,11 @@ func (d *DeploymentService) GetDeployment(ctx context.Context, id, namespace str
 
 	logger.Info("Getting deployment")
 
-	switch kind {
-	case models.DeploymentKindContainer:
-		return d.containerService.GetContainer(ctx, id)
-	case models.DeploymentKindVM:
-		return d.vmService.GetVM(ctx, id)
-	default:
-		return nil, fmt.Errorf("unsupported deployment kind: %s", kind)
+	handler, err := d.handlerFor(kind)
+	if err != nil {
+		return nil, err
 	}
+	return handler.get(ctx, id)
 }
 
 // UpdateDeployment updates an existing deployment
@@ -107,14 +496,54 @@ func (d *DeploymentService) UpdateDeployment(ctx context.Context, req *models.De
 
 	logger.Info("Updating deployment")
 
-	switch req.Kind {
-	case models.DeploymentKindContainer:
-		return d.containerService.UpdateContainer(ctx, req, id)
-	case models.DeploymentKindVM:
-		return d.vmService.UpdateVM(ctx, req, id)
-	default:
-		return fmt.Errorf("unsupported deployment kind: %s", req.Kind)
+	// Caller annotations must never shadow the provider's reserved keys
+	if err := models.ValidateUserAnnotations(req.Metadata.Annotations); err != nil {
+		return err
+	}
+
+	// Route the update to its target cluster when one is named in the body
+	if req.Metadata.Cluster != "" {
+		target, err := d.clusterTarget(req.Metadata.Cluster)
+		if err != nil {
+			logger.Warn("Requested cluster is not registered", zap.String("cluster", req.Metadata.Cluster))
+			return err
+		}
+		req.Metadata.Cluster = ""
+		return target.UpdateDeployment(ctx, req, id)
+	}
+
+	// Serialize mutations on this deployment ID so a concurrent delete cannot
+	// interleave with the delete-and-recreate cycle
+	if err := d.locks.acquire(id, "update"); err != nil {
+		return err
 	}
+	defer d.locks.release(id)
+
+	// Tenants may only update deployments inside their namespaces. The lookup
+	// is tenancy-aware, so foreign IDs read as not found; the target namespace
+	// is checked separately so an update cannot move into a foreign namespace.
+	if _, restricted := tenancy.FromContext(ctx); restricted {
+		if _, err := d.GetDeploymentByID(ctx, id); err != nil {
+			return err
+		}
+		if !tenancy.Allowed(ctx, req.Metadata.Namespace) {
+			logger.Warn("Tenant is not allowed to deploy into namespace",
+				zap.String("namespace", req.Metadata.Namespace))
+			return models.NewErrNamespaceForbidden(req.Metadata.Namespace)
+		}
+	}
+
+	handler, err := d.handlerFor(req.Kind)
+	if err != nil {
+		return err
+	}
+	if err := handler.update(ctx, req, id); err != nil {
+		return err
+	}
+
+	d.publishEvent(events.EventTypeUpdated, id, req.Kind, req.Metadata.Name, req.Metadata.Namespace, "")
+	d.saveRecord(ctx, req, id)
+	return nil
 }
 
 // DeleteDeployment deletes a deployment by ID (auto-detects namespace and kind)
@@ -123,21 +552,58 @@ func (d *DeploymentService) DeleteDeployment(ctx context.Context, id string) err
 
 	logger.Info("Deleting deployment")
 
+	// Serialize mutations on this deployment ID
+	if err := d.locks.acquire(id, "delete"); err != nil {
+		return err
+	}
+	defer d.locks.release(id)
+
 	// First, find the deployment to get its details
 	deployment, err := d.GetDeploymentByID(ctx, id)
 	if err != nil {
 		return err // This will include "multiple deployments found" or "deployment not found" errors
 	}
 
+	// Deployments found on a target cluster are deleted by that cluster's
+	// own service
+	if deployment.Metadata.Cluster != "" {
+		target, err := d.clusterTarget(deployment.Metadata.Cluster)
+		if err != nil {
+			return err
+		}
+		return target.DeleteDeployment(ctx, id)
+	}
+
 	// Delete based on the found deployment's kind
-	switch deployment.Kind {
-	case models.DeploymentKindContainer:
-		return d.containerService.DeleteContainer(ctx, id, deployment.Metadata.Namespace)
-	case models.DeploymentKindVM:
-		return d.vmService.DeleteVM(ctx, id, deployment.Metadata.Namespace)
-	default:
-		return fmt.Errorf("unsupported deployment kind: %s", deployment.Kind)
+	handler, err := d.handlerFor(deployment.Kind)
+	if err != nil {
+		return err
+	}
+	if err := handler.delete(ctx, id, deployment.Metadata.Namespace); err != nil {
+		// With a record store the cluster objects may already be gone while
+		// the record survives; removing the record is all that is left to do
+		if d.records == nil || !models.IsNotFoundError(err) {
+			return err
+		}
+		logger.Warn("Cluster objects were already gone, removing the record", zap.Error(err))
+	}
+
+	// Release the reserved network identity only on real deletes; updates
+	// recreate through the VM service directly and keep the reservation
+	if deployment.Kind == models.DeploymentKindVM && d.netRegistry != nil {
+		if err := d.netRegistry.Release(ctx, id); err != nil {
+			logger.Warn("Failed to release network identity", zap.Error(err))
+		}
 	}
+
+	if d.records != nil {
+		if err := d.records.Delete(ctx, id); err != nil && !errors.Is(err, records.ErrNotFound) {
+			logger.Warn("Failed to delete deployment record", zap.Error(err))
+		}
+	}
+
+	d.publishEvent(events.EventTypeDeleted, id, deployment.Kind, deployment.Metadata.Name, deployment.Metadata.Namespace, "")
+	return nil
 }
 
 // ListDeployments lists deployments with filtering and pagination
@@ -151,49 +617,119 @@ func (d *DeploymentService) ListDeployments(ctx context.Context, req *models.Lis
 
 	logger.Info("Listing deployments")
 
+	// Aggregate this cluster's backends, then fold in the registered target
+	// clusters unless the request names one explicitly
 	var allDeployments []models.DeploymentResponse
-
-	// List containers if kind is empty or container
-	if req.Kind == "" || req.Kind == models.DeploymentKindContainer {
-		containers, err := d.containerService.ListContainers(ctx, req.Namespace, req.Limit, 0)
+	var warnings []string
+	if req.Cluster != "" {
+		target, err := d.clusterTarget(req.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		allDeployments, warnings, err = target.collectDeployments(ctx, req, logger)
+		if err != nil {
+			return nil, err
+		}
+		stampCluster(allDeployments, req.Cluster)
+	} else {
+		var err error
+		allDeployments, warnings, err = d.collectDeployments(ctx, req, logger)
 		if err != nil {
-			logger.Error("Failed to list containers", zap.Error(err))
-			return nil, fmt.Errorf("failed to list containers: %w", err)
+			return nil, err
+		}
+		// An unreachable cluster degrades the aggregate to partial results
+		// the same way a failing per-kind backend does
+		for _, name := range d.clusterNames() {
+			clusterDeployments, clusterWarnings, err := d.clusters[name].collectDeployments(ctx, req, logger)
+			if err != nil {
+				logger.Warn("Cluster listing degraded to partial results",
+					zap.String("cluster", name), zap.Error(err))
+				warnings = append(warnings, fmt.Sprintf("cluster %s omitted: the listing failed", name))
+				continue
+			}
+			stampCluster(clusterDeployments, name)
+			allDeployments = append(allDeployments, clusterDeployments...)
+			for _, warning := range clusterWarnings {
+				warnings = append(warnings, fmt.Sprintf("cluster %s: %s", name, warning))
+			}
 		}
-		allDeployments = append(allDeployments, containers...)
 	}
 
-	// List VMs if kind is empty or vm
-	if req.Kind == "" || req.Kind == models.DeploymentKindVM {
-		vms, err := d.vmService.ListVMs(ctx, req.Namespace, req.Limit, 0)
-		if err != nil {
-			logger.Error("Failed to list VMs", zap.Error(err))
-			return nil, fmt.Errorf("failed to list VMs: %w", err)
+	// Drop deployments in namespaces the tenant does not own before any
+	// requested filters, so pagination totals never leak foreign namespaces
+	if _, restricted := tenancy.FromContext(ctx); restricted {
+		filtered := allDeployments[:0]
+		for _, deployment := range allDeployments {
+			if tenancy.Allowed(ctx, deployment.Metadata.Namespace) {
+				filtered = append(filtered, deployment)
+			}
 		}
-		allDeployments = append(allDeployments, vms...)
+		allDeployments = filtered
 	}
 
-	// Apply pagination
+	// Filter by creator subject. Identities are persisted as annotations, which
+	// Kubernetes cannot select on, so the filter is applied after aggregation.
+	if req.Owner != "" {
+		filtered := allDeployments[:0]
+		for _, deployment := range allDeployments {
+			if deployment.CreatedBy != nil && deployment.CreatedBy.Subject == req.Owner {
+				filtered = append(filtered, deployment)
+			}
+		}
+		allDeployments = filtered
+	}
+
+	// Filter by ops tag. Tags are persisted as a single annotation, which
+	// Kubernetes cannot select on, so the filter is applied after aggregation.
+	if req.Tag != "" {
+		filtered := allDeployments[:0]
+		for _, deployment := range allDeployments {
+			if models.HasTag(deployment.Metadata.Tags, req.Tag) {
+				filtered = append(filtered, deployment)
+			}
+		}
+		allDeployments = filtered
+	}
+
+	// Put the aggregate into its stable listing order so pages are
+	// consistent across the per-kind sub-lists and across requests
+	sortDeployments(allDeployments)
+
+	// Apply pagination; a continue token resumes strictly after the last
+	// deployment of the previous page and takes precedence over offset
 	total := len(allDeployments)
 	start := req.Offset
+	if req.Continue != "" {
+		cursor, err := decodeContinueToken(req.Continue)
+		if err != nil {
+			return nil, err
+		}
+		start = positionAfter(allDeployments, cursor)
+	}
+
 	end := start + req.Limit
+	if end > total {
+		end = total
+	}
+	page := []models.DeploymentResponse{}
+	if start < total {
+		page = allDeployments[start:end]
+	}
 
-	if start >= total {
-		allDeployments = []models.DeploymentResponse{}
-	} else {
-		if end > total {
-			end = total
-		}
-		allDeployments = allDeployments[start:end]
+	continueToken := ""
+	if end < total && len(page) > 0 {
+		continueToken = encodeContinueToken(&page[len(page)-1])
 	}
 
 	response := &models.ListDeploymentsResponse{
-		Deployments: allDeployments,
+		Deployments: page,
+		Warnings:    warnings,
 		Pagination: models.Pagination{
-			Limit:   req.Limit,
-			Offset:  req.Offset,
-			Total:   total,
-			HasMore: req.Offset+req.Limit < total,
+			Limit:    req.Limit,
+			Offset:   req.Offset,
+			Total:    total,
+			HasMore:  end < total,
+			Continue: continueToken,
 		},
 	}
 
@@ -201,19 +737,389 @@ func (d *DeploymentService) ListDeployments(ctx context.Context, req *models.Lis
 	return response, nil
 }
 
-// GetDeploymentByID retrieves a deployment by ID, searching both containers and VMs across all namespaces
+// collectDeployments aggregates this cluster's per-kind sub-lists into one
+// unfiltered slice, downgrading backend failures to warnings when no explicit
+// kind was requested. Tenancy, request filters, ordering and pagination are
+// applied by ListDeployments on the combined aggregate.
+func (d *DeploymentService) collectDeployments(ctx context.Context, req *models.ListDeploymentsRequest, logger *zap.Logger) ([]models.DeploymentResponse, []string, error) {
+	// Run the per-kind sub-lists concurrently; each goroutine writes only
+	// its own slot, and slots are merged in fixed order below so the result
+	// stays deterministic
+	type subList struct {
+		kind models.DeploymentKind
+		list func(ctx context.Context) ([]models.DeploymentResponse, error)
+	}
+	subLists := make([]subList, 0, len(d.kindOrder))
+	for _, kind := range d.kindOrder {
+		handler := d.kinds[kind]
+		subLists = append(subLists, subList{kind, func(ctx context.Context) ([]models.DeploymentResponse, error) {
+			return handler.list(ctx, req.Namespace, listAllLimit, 0)
+		}})
+	}
+
+	results := make([][]models.DeploymentResponse, len(subLists))
+	listErrs := make([]error, len(subLists))
+	group, groupCtx := errgroup.WithContext(ctx)
+	for i, sub := range subLists {
+		if req.Kind != "" && req.Kind != sub.kind {
+			continue
+		}
+		group.Go(func() error {
+			results[i], listErrs[i] = sub.list(groupCtx)
+			// Errors are resolved during the merge: an aggregated listing
+			// degrades to partial results instead of cancelling its siblings
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	// Merge the slots in fixed kind order, downgrading backend failures to
+	// warnings when no explicit kind was requested
+	var deployments []models.DeploymentResponse
+	var warnings []string
+	for i, sub := range subLists {
+		if req.Kind != "" && req.Kind != sub.kind {
+			continue
+		}
+		err := listErrs[i]
+		switch {
+		case err == nil:
+			deployments = append(deployments, results[i]...)
+		case req.Kind != "":
+			// An explicitly requested kind must not degrade to partial results
+			logger.Error("Failed to list deployments", zap.String("list_kind", string(sub.kind)), zap.Error(err))
+			return nil, nil, fmt.Errorf("failed to list %s deployments: %w", sub.kind, err)
+		case models.IsKubeVirtUnavailableError(err):
+			// Without KubeVirt there are no VM deployments; keep the listing whole
+			logger.Debug("Skipping VM listing, KubeVirt is unavailable")
+		default:
+			logger.Warn("Listing degraded to partial results",
+				zap.String("list_kind", string(sub.kind)), zap.Error(err))
+			warnings = append(warnings, fmt.Sprintf("%s deployments omitted: the backend listing failed", sub.kind))
+		}
+	}
+
+	return deployments, warnings, nil
+}
+
+// stampCluster marks listed deployments with the target cluster they came from
+func stampCluster(deployments []models.DeploymentResponse, cluster string) {
+	for i := range deployments {
+		deployments[i].Metadata.Cluster = cluster
+	}
+}
+
+// RefreshCapabilities re-detects optional cluster capabilities such as KubeVirt.
+// It allows VM endpoints to become functional without a restart once the
+// KubeVirt CRDs are installed on the cluster.
+func (d *DeploymentService) RefreshCapabilities(ctx context.Context) models.Capabilities {
+	logger := d.logger.Named("deployment_service")
+
+	capabilities := models.Capabilities{
+		KubeVirt: d.vmService.CheckAvailability(ctx),
+	}
+
+	logger.Info("Refreshed cluster capabilities", zap.Bool("kubevirt", capabilities.KubeVirt))
+	return capabilities
+}
+
+// WatchDeployment streams status updates for a deployment until the context is cancelled.
+// The current state is emitted first, followed by updates from a Kubernetes watch.
+func (d *DeploymentService) WatchDeployment(ctx context.Context, id string) (<-chan models.DeploymentResponse, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("deployment_id", id))
+
+	// Find the deployment to determine its kind and namespace
+	deployment, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Watching deployment", zap.String("kind", string(deployment.Kind)))
+
+	handler, err := d.handlerFor(deployment.Kind)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := handler.watch(ctx, id, deployment.Metadata.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the current state before forwarding watch events
+	out := make(chan models.DeploymentResponse)
+	go func() {
+		defer close(out)
+
+		select {
+		case out <- *deployment:
+		case <-ctx.Done():
+			return
+		}
+
+		lastPhase := deployment.Status.Phase
+		for update := range updates {
+			if update.Status.Phase != lastPhase {
+				lastPhase = update.Status.Phase
+				d.publishEvent(events.EventTypePhaseChanged, id, update.Kind, update.Metadata.Name, update.Metadata.Namespace, string(update.Status.Phase))
+			}
+
+			select {
+			case out <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// GetDeploymentLogs streams logs for a deployment by ID (auto-detects namespace).
+// Only pod-backed kinds support logs; VM deployments return ErrLogsNotSupported.
+func (d *DeploymentService) GetDeploymentLogs(ctx context.Context, id string, req *models.DeploymentLogsRequest) (io.ReadCloser, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("deployment_id", id))
+
+	// Find the deployment to determine its kind and namespace
+	deployment, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Fetching deployment logs", zap.String("namespace", deployment.Metadata.Namespace))
+
+	handler, err := d.handlerFor(deployment.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if handler.logs == nil {
+		return nil, models.NewErrLogsNotSupported(id, deployment.Kind)
+	}
+	return handler.logs(ctx, id, deployment.Metadata.Namespace, req)
+}
+
+// GetDeploymentConsoleLog streams the serial console output for a VM deployment by ID.
+// Only VM deployments have a serial console; other kinds return ErrConsoleLogNotSupported.
+func (d *DeploymentService) GetDeploymentConsoleLog(ctx context.Context, id string, req *models.DeploymentLogsRequest) (io.ReadCloser, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("deployment_id", id))
+
+	// Find the deployment to determine its kind and namespace
+	deployment, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if deployment.Kind != models.DeploymentKindVM {
+		return nil, models.NewErrConsoleLogNotSupported(id, deployment.Kind)
+	}
+
+	logger.Info("Fetching VM console log", zap.String("namespace", deployment.Metadata.Namespace))
+	return d.vmService.GetVMConsoleLog(ctx, id, deployment.Metadata.Namespace, req)
+}
+
+// StreamDeploymentConsole opens a VNC or serial console stream for a VM deployment by ID.
+// Only VM deployments have a console; other kinds return ErrConsoleLogNotSupported.
+func (d *DeploymentService) StreamDeploymentConsole(ctx context.Context, id, consoleType string) (kvcorev1.StreamInterface, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("deployment_id", id))
+
+	// Find the deployment to determine its kind and namespace
+	deployment, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if deployment.Kind != models.DeploymentKindVM {
+		return nil, models.NewErrConsoleLogNotSupported(id, deployment.Kind)
+	}
+
+	logger.Info("Opening VM console",
+		zap.String("namespace", deployment.Metadata.Namespace),
+		zap.String("console_type", consoleType))
+	return d.vmService.StreamVMConsole(ctx, id, deployment.Metadata.Namespace, consoleType)
+}
+
+// PerformDeploymentAction performs a lifecycle action on a deployment by ID
+// and returns the phase observed right after the action. VM deployments
+// support the power actions (start, stop, restart, pause, unpause, migrate,
+// restore); cronjob deployments support the schedule actions (trigger,
+// suspend, resume). Other kinds return ErrActionNotSupported.
+func (d *DeploymentService) PerformDeploymentAction(ctx context.Context, id, action string) (*models.DeploymentActionResponse, error) {
+	logger := d.logger.Named("deployment_service").With(
+		zap.String("deployment_id", id),
+		zap.String("action", action))
+
+	logger.Info("Performing deployment action")
+
+	// Serialize with other mutations so an action cannot race a delete-and-recreate
+	if err := d.locks.acquire(id, action); err != nil {
+		return nil, err
+	}
+	defer d.locks.release(id)
+
+	// Find the deployment to determine its kind and namespace
+	deployment, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	handler, err := d.handlerFor(deployment.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if handler.action == nil {
+		return nil, models.NewErrActionNotSupported(id, deployment.Kind, action)
+	}
+	phase, err := handler.action(ctx, id, deployment.Metadata.Namespace, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.DeploymentActionResponse{
+		ID:        id,
+		Action:    action,
+		Phase:     phase,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// GetDeploymentEvents returns the Kubernetes events related to a deployment's
+// underlying resources by ID (auto-detects namespace and kind)
+func (d *DeploymentService) GetDeploymentEvents(ctx context.Context, id string) (*models.DeploymentEventsResponse, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("deployment_id", id))
+
+	// Find the deployment to determine its kind and namespace
+	deployment, err := d.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Fetching deployment events",
+		zap.String("kind", string(deployment.Kind)),
+		zap.String("namespace", deployment.Metadata.Namespace))
+
+	handler, err := d.handlerFor(deployment.Kind)
+	if err != nil {
+		return nil, err
+	}
+	events, err := handler.events(ctx, id, deployment.Metadata.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.DeploymentEventsResponse{
+		Events: events,
+		Count:  len(events),
+	}, nil
+}
+
+// NamespaceWarningEvents returns Kubernetes warning events involving managed
+// resources in the namespace, feeding the namespace activity view. Object
+// names are collected from the managed core resources plus VirtualMachines;
+// the VM lookup is best-effort so the feed works without KubeVirt.
+func (d *DeploymentService) NamespaceWarningEvents(ctx context.Context, namespace string) ([]models.DeploymentEvent, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("namespace", namespace))
+
+	names := make(map[string]bool)
+	managed := metav1.ListOptions{LabelSelector: models.BuildManagedResourceSelector()}
+
+	deployments, err := d.readClient.AppsV1().Deployments(namespace).List(ctx, managed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	for _, item := range deployments.Items {
+		names[item.Name] = true
+	}
+
+	services, err := d.readClient.CoreV1().Services(namespace).List(ctx, managed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+	for _, item := range services.Items {
+		names[item.Name] = true
+	}
+
+	pods, err := d.readClient.CoreV1().Pods(namespace).List(ctx, managed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	for _, item := range pods.Items {
+		names[item.Name] = true
+	}
+
+	if vms, err := d.vmService.ListVMs(ctx, namespace, 1000, 0); err == nil {
+		for _, vm := range vms {
+			names[vm.Metadata.Name] = true
+		}
+	}
+
+	events, err := listEventsForObjects(ctx, d.readClient, namespace, names)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []models.DeploymentEvent
+	for _, event := range events {
+		if event.Type == corev1.EventTypeWarning {
+			warnings = append(warnings, event)
+		}
+	}
+
+	logger.Info("Collected namespace warning events", zap.Int("count", len(warnings)))
+	return warnings, nil
+}
+
+// GetDeploymentByID retrieves a deployment by ID, searching all kinds across all namespaces
 func (d *DeploymentService) GetDeploymentByID(ctx context.Context, id string) (*models.DeploymentResponse, error) {
 	logger := d.logger.Named("deployment_service").With(zap.String("deployment_id", id))
 
+	deployment, err := d.liveDeploymentByID(ctx, id)
+	if err == nil || !models.IsNotFoundError(err) {
+		return deployment, err
+	}
+
+	// Fall back to the record store so IDs stay resolvable after their
+	// cluster objects are deleted out-of-band. Foreign-namespace records
+	// read as not found, matching the live lookup above.
+	if d.records != nil {
+		if record, err := d.records.Get(ctx, id); err == nil {
+			if !tenancy.Allowed(ctx, record.Namespace) {
+				return nil, models.NewErrDeploymentNotFound(id)
+			}
+			logger.Info("Serving deployment from the record store, cluster objects are gone")
+			return recordResponse(record), nil
+		}
+	}
+
+	logger.Warn("Deployment not found", zap.String("deployment_id", id))
+	return nil, models.NewErrDeploymentNotFound(id)
+}
+
+// liveDeploymentByID searches the cluster objects for a deployment ID across
+// all kinds and registered clusters, without consulting the record store.
+// Create and the reconciler use it directly: a surviving record must not
+// block recreating a deployment whose cluster objects are gone.
+func (d *DeploymentService) liveDeploymentByID(ctx context.Context, id string) (*models.DeploymentResponse, error) {
+	logger := d.logger.Named("deployment_service").With(zap.String("deployment_id", id))
+
 	var foundDeployments []*models.DeploymentResponse
 
-	// Try to find as container
-	if deployment, err := d.containerService.GetContainer(ctx, id); err == nil {
-		foundDeployments = append(foundDeployments, deployment)
+	// Try each registered kind; at most one backend should know the ID
+	for _, kind := range d.kindOrder {
+		if deployment, err := d.kinds[kind].get(ctx, id); err == nil {
+			foundDeployments = append(foundDeployments, deployment)
+		}
 	}
 
-	// Try to find as VM
-	if deployment, err := d.vmService.GetVM(ctx, id); err == nil {
+	// Search the registered target clusters as well; remote hits are stamped
+	// with their cluster name so callers can route follow-up operations
+	for _, name := range d.clusterNames() {
+		deployment, err := d.clusters[name].liveDeploymentByID(ctx, id)
+		if err != nil {
+			if models.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		deployment.Metadata.Cluster = name
 		foundDeployments = append(foundDeployments, deployment)
 	}
 
@@ -231,11 +1137,46 @@ func (d *DeploymentService) GetDeploymentByID(ctx context.Context, id string) (*
 		return nil, models.NewErrMultipleDeploymentsFound(id, len(foundDeployments), namespaces...)
 	}
 
-	// Return the found deployment
+	// Return the found deployment. Deployments in namespaces the tenant does
+	// not own are reported as not found rather than forbidden, so tenants
+	// cannot probe for IDs outside their namespaces.
 	if len(foundDeployments) == 1 {
+		if !tenancy.Allowed(ctx, foundDeployments[0].Metadata.Namespace) {
+			logger.Warn("Deployment exists outside the tenant's namespaces",
+				zap.String("namespace", foundDeployments[0].Metadata.Namespace))
+			return nil, models.NewErrDeploymentNotFound(id)
+		}
 		return foundDeployments[0], nil
 	}
 
-	logger.Warn("Deployment not found", zap.String("deployment_id", id))
 	return nil, models.NewErrDeploymentNotFound(id)
 }
+
+// recordResponse rebuilds a deployment response from a stored record. The
+// cluster objects no longer exist, so the phase is unknown and the spec is
+// the snapshot taken at the last write.
+func recordResponse(record *records.Record) *models.DeploymentResponse {
+	var spec interface{}
+	if len(record.Spec) > 0 {
+		_ = json.Unmarshal(record.Spec, &spec)
+	}
+	response := &models.DeploymentResponse{
+		ID:   record.ID,
+		Kind: models.DeploymentKind(record.Kind),
+		Metadata: models.Metadata{
+			Name:      record.Name,
+			Namespace: record.Namespace,
+		},
+		Spec: spec,
+		Status: models.DeploymentStatus{
+			Phase:   models.DeploymentPhaseUnknown,
+			Message: "the cluster objects for this deployment no longer exist; served from the record store",
+		},
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+	}
+	if record.Owner != "" {
+		response.CreatedBy = &models.Creator{Subject: record.Owner}
+	}
+	return response
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// applyExtraContainers renders init containers, sidecars and the main
+// container's mounts into a pod template. Every distinct volume name used by
+// a mount becomes a shared emptyDir, so an init container can prepare data
+// the main container or a sidecar reads.
+func applyExtraContainers(podSpec *corev1.PodSpec, container *models.ContainerConfig) {
+	for _, mount := range container.Mounts {
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, buildVolumeMount(mount))
+	}
+	for i := range container.InitContainers {
+		podSpec.InitContainers = append(podSpec.InitContainers, buildAuxiliaryContainer(&container.InitContainers[i]))
+	}
+	for i := range container.Sidecars {
+		podSpec.Containers = append(podSpec.Containers, buildAuxiliaryContainer(&container.Sidecars[i]))
+	}
+
+	seen := make(map[string]bool)
+	mounts := container.Mounts
+	for _, aux := range container.InitContainers {
+		mounts = append(mounts, aux.Mounts...)
+	}
+	for _, aux := range container.Sidecars {
+		mounts = append(mounts, aux.Mounts...)
+	}
+	for _, mount := range mounts {
+		if seen[mount.Name] {
+			continue
+		}
+		seen[mount.Name] = true
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name:         mount.Name,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+	}
+}
+
+// buildAuxiliaryContainer renders an init container or sidecar
+func buildAuxiliaryContainer(aux *models.AuxiliaryContainer) corev1.Container {
+	built := corev1.Container{
+		Name:    aux.Name,
+		Image:   aux.Image,
+		Command: aux.Command,
+		Args:    aux.Args,
+	}
+	if len(aux.Environment) > 0 {
+		built.Env = buildEnvVars(aux.Environment)
+	}
+	if aux.Resources != nil {
+		built.Resources = buildResourceRequirements(aux.Resources)
+	}
+	for _, mount := range aux.Mounts {
+		built.VolumeMounts = append(built.VolumeMounts, buildVolumeMount(mount))
+	}
+	return built
+}
+
+// buildVolumeMount translates a mount config into a pod volume mount
+func buildVolumeMount(mount models.ContainerMountConfig) corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      mount.Name,
+		MountPath: mount.MountPath,
+		ReadOnly:  mount.ReadOnly,
+	}
+}
+
+// validateExtraContainers checks the extra containers of a pod-backed spec:
+// container names must not collide, and their environments follow the same
+// rules as the main container's
+func validateExtraContainers(field string, container *models.ContainerConfig) error {
+	names := make(map[string]bool)
+	for i, aux := range container.InitContainers {
+		if err := validateAuxiliaryContainer(fmt.Sprintf("%s.initContainers[%d]", field, i), &aux, names); err != nil {
+			return err
+		}
+	}
+	for i, aux := range container.Sidecars {
+		if err := validateAuxiliaryContainer(fmt.Sprintf("%s.sidecars[%d]", field, i), &aux, names); err != nil {
+			return err
+		}
+	}
+	return validateMounts(fmt.Sprintf("%s.mounts", field), container.Mounts)
+}
+
+// validateAuxiliaryContainer checks one extra container and records its name
+// in names to detect collisions across init containers and sidecars
+func validateAuxiliaryContainer(field string, aux *models.AuxiliaryContainer, names map[string]bool) error {
+	if names[aux.Name] {
+		return models.NewErrInvalidSpec(field+".name", fmt.Sprintf("duplicate container name %q", aux.Name))
+	}
+	names[aux.Name] = true
+
+	if err := validateEnvironment(field+".environment", aux.Environment); err != nil {
+		return err
+	}
+	if err := validateExtendedResources(field+".resources.extended", aux.Resources); err != nil {
+		return err
+	}
+	return validateMounts(field+".mounts", aux.Mounts)
+}
+
+// validateMounts rejects mount lists that reuse a mount path within one
+// container
+func validateMounts(field string, mounts []models.ContainerMountConfig) error {
+	paths := make(map[string]bool)
+	for i, mount := range mounts {
+		if paths[mount.MountPath] {
+			return models.NewErrInvalidSpec(fmt.Sprintf("%s[%d].mountPath", field, i), fmt.Sprintf("duplicate mount path %q", mount.MountPath))
+		}
+		paths[mount.MountPath] = true
+	}
+	return nil
+}
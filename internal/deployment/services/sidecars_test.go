@@ -0,0 +1,142 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestValidateExtraContainers(t *testing.T) {
+	tests := []struct {
+		name        string
+		container   models.ContainerConfig
+		expectError bool
+		contains    string
+	}{
+		{
+			name:      "no extra containers",
+			container: models.ContainerConfig{Image: "nginx:latest"},
+		},
+		{
+			name: "init container and sidecar",
+			container: models.ContainerConfig{
+				Image: "app:1.0",
+				InitContainers: []models.AuxiliaryContainer{
+					{Name: "migrate", Image: "app:1.0", Command: []string{"./migrate"}},
+				},
+				Sidecars: []models.AuxiliaryContainer{
+					{Name: "log-shipper", Image: "fluent-bit:2.2"},
+				},
+			},
+		},
+		{
+			name: "duplicate container name across init and sidecar",
+			container: models.ContainerConfig{
+				Image: "app:1.0",
+				InitContainers: []models.AuxiliaryContainer{
+					{Name: "helper", Image: "app:1.0"},
+				},
+				Sidecars: []models.AuxiliaryContainer{
+					{Name: "helper", Image: "fluent-bit:2.2"},
+				},
+			},
+			expectError: true,
+			contains:    "duplicate container name",
+		},
+		{
+			name: "invalid sidecar environment",
+			container: models.ContainerConfig{
+				Image: "app:1.0",
+				Sidecars: []models.AuxiliaryContainer{
+					{Name: "shipper", Image: "fluent-bit:2.2", Environment: []models.EnvironmentVariable{{Name: "bad name"}}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate mount path in one container",
+			container: models.ContainerConfig{
+				Image: "app:1.0",
+				Mounts: []models.ContainerMountConfig{
+					{Name: "shared", MountPath: "/data"},
+					{Name: "scratch", MountPath: "/data"},
+				},
+			},
+			expectError: true,
+			contains:    "duplicate mount path",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateExtraContainers("spec.container", &tt.container)
+
+			if !tt.expectError {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			if tt.contains != "" {
+				assert.Contains(t, err.Error(), tt.contains)
+			}
+		})
+	}
+}
+
+func TestBuildContainerDeploymentExtraContainers(t *testing.T) {
+	spec := &models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "app:1.0",
+			Mounts: []models.ContainerMountConfig{
+				{Name: "logs", MountPath: "/var/log/app"},
+			},
+			InitContainers: []models.AuxiliaryContainer{
+				{
+					Name:    "migrate",
+					Image:   "app:1.0",
+					Command: []string{"./migrate", "up"},
+					Environment: []models.EnvironmentVariable{
+						{Name: "DB_HOST", Value: "postgres"},
+					},
+					Resources: &models.ResourceConfig{CPU: "50m"},
+				},
+			},
+			Sidecars: []models.AuxiliaryContainer{
+				{
+					Name:  "log-shipper",
+					Image: "fluent-bit:2.2",
+					Mounts: []models.ContainerMountConfig{
+						{Name: "logs", MountPath: "/logs", ReadOnly: true},
+					},
+				},
+			},
+		},
+	}
+
+	deployment := buildContainerDeployment("app", spec, nil, goldenAnnotations(), goldenDeploymentID, false)
+	podSpec := deployment.Spec.Template.Spec
+
+	require.Len(t, podSpec.InitContainers, 1)
+	assert.Equal(t, "migrate", podSpec.InitContainers[0].Name)
+	assert.Equal(t, []string{"./migrate", "up"}, podSpec.InitContainers[0].Command)
+	assert.Equal(t, "DB_HOST", podSpec.InitContainers[0].Env[0].Name)
+	assert.Equal(t, "50m", podSpec.InitContainers[0].Resources.Requests.Cpu().String())
+
+	require.Len(t, podSpec.Containers, 2)
+	assert.Equal(t, "app", podSpec.Containers[0].Name)
+	assert.Equal(t, "log-shipper", podSpec.Containers[1].Name)
+	assert.True(t, podSpec.Containers[1].VolumeMounts[0].ReadOnly)
+
+	// The shared volume named by the mounts exists exactly once
+	require.Len(t, podSpec.Volumes, 1)
+	assert.Equal(t, "logs", podSpec.Volumes[0].Name)
+	assert.NotNil(t, podSpec.Volumes[0].EmptyDir)
+
+	// Both the main container and the sidecar mount it
+	assert.Equal(t, "/var/log/app", podSpec.Containers[0].VolumeMounts[0].MountPath)
+	assert.Equal(t, "/logs", podSpec.Containers[1].VolumeMounts[0].MountPath)
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/sshkeys"
+)
+
+// SetSSHKeyRegistry enables cross-namespace SSH key references for VM
+// deployments: a VM spec naming a key with no Secret in its namespace is
+// resolved against the registry and the key is copied in.
+func (d *DeploymentService) SetSSHKeyRegistry(registry *sshkeys.Registry) {
+	d.vmService.SetSSHKeyRegistry(registry)
+}
+
+// SetSSHKeyRegistry wires the SSH key registry. Call before serving
+// requests; a nil registry (the default) limits key references to Secrets
+// in the VM's own namespace.
+func (v *VMService) SetSSHKeyRegistry(registry *sshkeys.Registry) {
+	v.sshKeys = registry
+}
+
+// resolveRegisteredSSHKey copies a registered key into the VM's namespace as
+// a Secret named after the key. The copy carries the deployment labels, so
+// it is cleaned up with the VM, plus the key-name label the registry scans
+// for usage tracking. Returns false when no key is registered under name.
+func (v *VMService) resolveRegisteredSSHKey(ctx context.Context, namespace, name, deploymentID string) (bool, error) {
+	if v.sshKeys == nil {
+		return false, nil
+	}
+
+	publicKey, err := v.sshKeys.Resolve(ctx, name)
+	if err != nil {
+		if errors.Is(err, sshkeys.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	labels := models.BuildDeploymentLabels(deploymentID, name)
+	labels[sshkeys.LabelKeyName] = name
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Type: corev1.SecretTypeOpaque,
+		StringData: map[string]string{
+			"key": publicKey,
+		},
+	}
+	if _, err := v.k8sClient.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return false, fmt.Errorf("failed to copy registered SSH key %s: %w", name, err)
+	}
+	return true, nil
+}
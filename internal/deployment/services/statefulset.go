@@ -0,0 +1,582 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	deployspec "github.com/dcm-project/k8s-service-provider/internal/deployment/spec"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+// StatefulSetService handles stateful workload deployment operations.
+// Writes go through client; read-heavy operations (get/list/watch) use
+// readClient so they are throttled on a separate budget.
+type StatefulSetService struct {
+	client             kubernetes.Interface
+	readClient         kubernetes.Interface
+	workload           config.WorkloadConfig
+	defaultTolerations []models.TolerationConfig
+	logger             *zap.Logger
+}
+
+// NewStatefulSetService creates a new statefulset service instance
+func NewStatefulSetService(client, readClient kubernetes.Interface, workload config.WorkloadConfig, logger *zap.Logger) *StatefulSetService {
+	return &StatefulSetService{
+		client:     client,
+		readClient: readClient,
+		workload:   workload,
+		logger:     logger,
+	}
+}
+
+// CreateStatefulSet creates a new statefulset deployment
+func (s *StatefulSetService) CreateStatefulSet(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	now := time.Now()
+	return s.createStatefulSet(ctx, req, id, stampCreator(ctx, models.BuildTimestampAnnotations(now, now)))
+}
+
+// createStatefulSet creates the statefulset resources with the provided timestamp annotations
+func (s *StatefulSetService) createStatefulSet(ctx context.Context, req *models.DeploymentRequest, id string, annotations map[string]string) error {
+	logger := s.logger.Named("statefulset_service").With(zap.String("deployment_id", id))
+	logger.Info("Starting statefulset deployment")
+
+	statefulSpec, ok := req.Spec.(models.StatefulSetSpec)
+	if !ok {
+		return fmt.Errorf("invalid statefulset spec format")
+	}
+
+	if err := validateStatefulSetSpec(&statefulSpec); err != nil {
+		return err
+	}
+
+	// Operator policy: default tolerations for dedicated tenant node pools
+	statefulSpec.StatefulSet.Placement = mergeTolerationPolicy(statefulSpec.StatefulSet.Placement, s.defaultTolerations)
+
+	if err := applyResourcePolicy(s.workload, "spec.statefulset.resources", &statefulSpec.StatefulSet.ContainerConfig); err != nil {
+		return err
+	}
+
+	if err := applySecurityPolicy(s.workload, "spec.statefulset.security", &statefulSpec.StatefulSet.ContainerConfig); err != nil {
+		return err
+	}
+
+	if err := ensureClusterExtendedResources(ctx, s.readClient, logger, "spec.statefulset.resources.extended", statefulSpec.StatefulSet.Resources, s.workload.StrictSpec); err != nil {
+		return err
+	}
+
+	if err := ensureStorageClassesSupported(ctx, s.readClient, logger, "spec.statefulset.volumeClaims", statefulSpec.StatefulSet.VolumeClaims, s.workload.StrictSpec); err != nil {
+		return err
+	}
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Fail fast when the namespace quota has no headroom for this deployment
+	replicas := ptr.Deref(statefulSpec.StatefulSet.Replicas, 1)
+	if err := checkNamespaceQuota(ctx, s.readClient, namespace, containerQuotaRequests(&statefulSpec.StatefulSet.ContainerConfig, replicas)); err != nil {
+		return err
+	}
+
+	// Create namespace if it doesn't exist
+	if err := s.ensureNamespace(ctx, namespace); err != nil {
+		return fmt.Errorf("failed to ensure namespace: %w", err)
+	}
+
+	// Persist ops notes and tags alongside the timestamps
+	models.ApplyOpsAnnotations(annotations, req.Metadata.Notes, req.Metadata.Tags)
+	models.MergeUserAnnotations(annotations, req.Metadata.Annotations)
+
+	// Persist the versioned spec so schema migrations can upgrade it later
+	if err := deployspec.Persist(annotations, req.Spec); err != nil {
+		return fmt.Errorf("failed to persist spec: %w", err)
+	}
+
+	// The headless service must exist before the StatefulSet so replicas get
+	// their DNS identity as they come up
+	service := buildStatefulSetHeadlessService(req.Metadata.Name, &statefulSpec, req.Metadata.Labels, annotations, id)
+	if _, err := s.client.CoreV1().Services(namespace).Create(ctx, service, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create headless service: %w", err)
+	}
+
+	statefulSet := buildStatefulSet(req.Metadata.Name, &statefulSpec, req.Metadata.Labels, annotations, id, s.workload.DisableSATokenAutomount)
+	if _, err := s.client.AppsV1().StatefulSets(namespace).Create(ctx, statefulSet, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create statefulset: %w", err)
+	}
+
+	logger.Info("Successfully created statefulset deployment")
+	return nil
+}
+
+// DryRunStatefulSet validates a statefulset deployment by server-side
+// dry-running the generated objects, returning the rendered manifests without
+// persisting anything. The target namespace must already exist.
+func (s *StatefulSetService) DryRunStatefulSet(ctx context.Context, req *models.DeploymentRequest, id string) ([]interface{}, error) {
+	logger := s.logger.Named("statefulset_service").With(zap.String("deployment_id", id))
+
+	statefulSpec, ok := req.Spec.(models.StatefulSetSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid statefulset spec format")
+	}
+
+	if err := validateStatefulSetSpec(&statefulSpec); err != nil {
+		return nil, err
+	}
+
+	// Operator policy: default tolerations for dedicated tenant node pools
+	statefulSpec.StatefulSet.Placement = mergeTolerationPolicy(statefulSpec.StatefulSet.Placement, s.defaultTolerations)
+
+	if err := applyResourcePolicy(s.workload, "spec.statefulset.resources", &statefulSpec.StatefulSet.ContainerConfig); err != nil {
+		return nil, err
+	}
+
+	if err := applySecurityPolicy(s.workload, "spec.statefulset.security", &statefulSpec.StatefulSet.ContainerConfig); err != nil {
+		return nil, err
+	}
+
+	if err := ensureClusterExtendedResources(ctx, s.readClient, logger, "spec.statefulset.resources.extended", statefulSpec.StatefulSet.Resources, s.workload.StrictSpec); err != nil {
+		return nil, err
+	}
+
+	if err := ensureStorageClassesSupported(ctx, s.readClient, logger, "spec.statefulset.volumeClaims", statefulSpec.StatefulSet.VolumeClaims, s.workload.StrictSpec); err != nil {
+		return nil, err
+	}
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	now := time.Now()
+	annotations := models.BuildTimestampAnnotations(now, now)
+	dryRun := metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}
+
+	service := buildStatefulSetHeadlessService(req.Metadata.Name, &statefulSpec, req.Metadata.Labels, annotations, id)
+	renderedService, err := s.client.CoreV1().Services(namespace).Create(ctx, service, dryRun)
+	if err != nil {
+		return nil, fmt.Errorf("headless service dry-run failed: %w", err)
+	}
+
+	statefulSet := buildStatefulSet(req.Metadata.Name, &statefulSpec, req.Metadata.Labels, annotations, id, s.workload.DisableSATokenAutomount)
+	rendered, err := s.client.AppsV1().StatefulSets(namespace).Create(ctx, statefulSet, dryRun)
+	if err != nil {
+		return nil, fmt.Errorf("statefulset dry-run failed: %w", err)
+	}
+
+	logger.Info("Dry-run validated statefulset deployment")
+	return []interface{}{renderedService, rendered}, nil
+}
+
+// GetStatefulSet retrieves statefulset deployment information searching across all namespaces
+func (s *StatefulSetService) GetStatefulSet(ctx context.Context, id string) (*models.DeploymentResponse, error) {
+	logger := s.logger.Named("statefulset_service").With(zap.String("deployment_id", id))
+
+	// Search across all namespaces using label selector
+	statefulSets, err := s.readClient.AppsV1().StatefulSets("").List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statefulset: %w", err)
+	}
+
+	if len(statefulSets.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+
+	response := s.buildResponse(id, &statefulSets.Items[0])
+
+	// Surface the persisted spec, migrated to the current schema version
+	if persisted, err := deployspec.FromAnnotations(statefulSets.Items[0].Annotations); err != nil {
+		logger.Warn("Failed to read persisted spec", zap.Error(err))
+	} else if persisted != nil {
+		response.Spec = persisted
+	}
+
+	logger.Info("Successfully retrieved statefulset deployment")
+	return response, nil
+}
+
+// UpdateStatefulSet updates an existing statefulset deployment
+func (s *StatefulSetService) UpdateStatefulSet(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	logger := s.logger.Named("statefulset_service").With(zap.String("deployment_id", id))
+	logger.Info("Updating statefulset deployment")
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Preserve the original creation time and creator across the delete-and-recreate cycle
+	createdAt := time.Now()
+	var creator *models.Creator
+	if existing, err := s.GetStatefulSet(ctx, id); err == nil {
+		createdAt = existing.CreatedAt
+		creator = existing.CreatedBy
+	}
+
+	// Delete and recreate like the other kinds; the per-replica PVCs are
+	// retained, so the new revision's replicas reattach to their data
+	if err := s.DeleteStatefulSet(ctx, id, namespace); err != nil {
+		logger.Warn("Failed to delete existing statefulset during update", zap.Error(err))
+	}
+
+	return s.createStatefulSet(ctx, req, id, preserveCreator(models.BuildTimestampAnnotations(createdAt, time.Now()), creator))
+}
+
+// DeleteStatefulSet deletes a statefulset deployment and its headless service.
+// The per-replica PVCs are left in place, matching the StatefulSet controller's
+// own behaviour, so data survives accidental deletes.
+func (s *StatefulSetService) DeleteStatefulSet(ctx context.Context, id, namespace string) error {
+	logger := s.logger.Named("statefulset_service").With(zap.String("deployment_id", id))
+	logger.Info("Deleting statefulset deployment")
+
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Background propagation so the replica pods are cleaned up as well
+	propagation := metav1.DeletePropagationBackground
+	err := s.client.AppsV1().StatefulSets(namespace).DeleteCollection(ctx, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	}, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		logger.Error("Failed to delete statefulset", zap.Error(err))
+		return fmt.Errorf("failed to delete statefulset: %w", err)
+	}
+
+	// Delete the headless service
+	services, err := s.client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		logger.Warn("Failed to list services for deletion", zap.Error(err))
+	} else {
+		for _, service := range services.Items {
+			err = s.client.CoreV1().Services(namespace).Delete(ctx, service.Name, metav1.DeleteOptions{})
+			if err != nil {
+				logger.Warn("Failed to delete service", zap.String("service", service.Name), zap.Error(err))
+			}
+		}
+	}
+
+	logger.Info("Successfully deleted statefulset deployment")
+	return nil
+}
+
+// PatchStatefulSetAnnotations applies a JSON merge patch to the annotations of
+// the StatefulSet object, leaving its spec untouched
+func (s *StatefulSetService) PatchStatefulSetAnnotations(ctx context.Context, id, namespace string, annotations map[string]interface{}) error {
+	statefulSets, err := s.readClient.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find statefulset: %w", err)
+	}
+	if len(statefulSets.Items) == 0 {
+		return models.NewErrDeploymentNotFound(id)
+	}
+
+	patch, err := marshalAnnotationsPatch(annotations)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.AppsV1().StatefulSets(namespace).Patch(ctx, statefulSets.Items[0].Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch statefulset annotations: %w", err)
+	}
+	return nil
+}
+
+// ListStatefulSets lists all statefulset deployments
+func (s *StatefulSetService) ListStatefulSets(ctx context.Context, namespace string, limit, offset int) ([]models.DeploymentResponse, error) {
+	logger := s.logger.Named("statefulset_service")
+
+	// Use empty string to search all namespaces if namespace is not specified
+	// Filter only resources managed by this service
+	statefulSets, err := s.readClient.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildManagedResourceSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+
+	var responses []models.DeploymentResponse
+	for i, statefulSet := range statefulSets.Items {
+		if i < offset {
+			continue
+		}
+		if len(responses) >= limit {
+			break
+		}
+
+		responses = append(responses, *s.buildResponse(statefulSet.Labels[models.LabelAppID], &statefulSet))
+	}
+
+	logger.Info("Successfully listed statefulset deployments", zap.Int("count", len(responses)))
+	return responses, nil
+}
+
+// GetStatefulSetEvents returns the Kubernetes events related to a statefulset
+// deployment's StatefulSet, headless service and pods
+func (s *StatefulSetService) GetStatefulSetEvents(ctx context.Context, id, namespace string) ([]models.DeploymentEvent, error) {
+	logger := s.logger.Named("statefulset_service").With(zap.String("deployment_id", id))
+
+	names := make(map[string]bool)
+
+	statefulSets, err := s.readClient.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+	for _, statefulSet := range statefulSets.Items {
+		names[statefulSet.Name] = true
+	}
+
+	services, err := s.readClient.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+	for _, service := range services.Items {
+		names[service.Name] = true
+	}
+
+	pods, err := s.readClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	for _, pod := range pods.Items {
+		names[pod.Name] = true
+	}
+
+	events, err := listEventsForObjects(ctx, s.readClient, namespace, names)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Retrieved statefulset deployment events", zap.Int("count", len(events)))
+	return events, nil
+}
+
+// WatchStatefulSet streams status updates for a statefulset deployment using a Kubernetes watch
+func (s *StatefulSetService) WatchStatefulSet(ctx context.Context, id, namespace string) (<-chan models.DeploymentResponse, error) {
+	logger := s.logger.Named("statefulset_service").With(zap.String("deployment_id", id))
+
+	watcher, err := s.readClient.AppsV1().StatefulSets(namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch statefulset: %w", err)
+	}
+
+	updates := make(chan models.DeploymentResponse)
+	go func() {
+		defer close(updates)
+		defer watcher.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					logger.Debug("StatefulSet watch channel closed")
+					return
+				}
+
+				statefulSet, ok := event.Object.(*appsv1.StatefulSet)
+				if !ok {
+					continue
+				}
+
+				select {
+				case updates <- *s.buildResponse(id, statefulSet):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// buildResponse converts a Kubernetes StatefulSet into the deployment response model
+func (s *StatefulSetService) buildResponse(id string, statefulSet *appsv1.StatefulSet) *models.DeploymentResponse {
+	createdAt, updatedAt := models.TimestampsFromAnnotations(statefulSet.Annotations, statefulSet.CreationTimestamp.Time)
+
+	return &models.DeploymentResponse{
+		ID:   id,
+		Kind: models.DeploymentKindStatefulSet,
+		Metadata: models.Metadata{
+			Name:        statefulSet.Name,
+			Namespace:   statefulSet.Namespace,
+			Labels:      statefulSet.Labels,
+			Annotations: models.UserAnnotations(statefulSet.Annotations),
+			Notes:       statefulSet.Annotations[models.AnnotationNotes],
+			Tags:        models.TagsFromAnnotations(statefulSet.Annotations),
+		},
+		Status: models.DeploymentStatus{
+			Phase:         s.getStatefulSetPhase(statefulSet),
+			ReadyReplicas: int(statefulSet.Status.ReadyReplicas),
+		},
+		CreatedBy: models.CreatorFromAnnotations(statefulSet.Annotations),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// ensureNamespace creates namespace if it doesn't exist
+func (s *StatefulSetService) ensureNamespace(ctx context.Context, namespace string) error {
+	_, err := s.client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+		}
+		_, err = s.client.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to create namespace %s: %w", namespace, err)
+		}
+	}
+	return nil
+}
+
+// getStatefulSetPhase determines the deployment phase from Kubernetes statefulset status
+func (s *StatefulSetService) getStatefulSetPhase(statefulSet *appsv1.StatefulSet) models.DeploymentPhase {
+	if statefulSet.Status.ReadyReplicas == 0 {
+		return models.DeploymentPhasePending
+	}
+	if statefulSet.Spec.Replicas != nil && statefulSet.Status.ReadyReplicas == *statefulSet.Spec.Replicas {
+		return models.DeploymentPhaseRunning
+	}
+	return models.DeploymentPhasePending
+}
+
+// validateStatefulSetSpec performs field-level validation that request binding
+// cannot express, reusing the container helpers with statefulset field paths
+func validateStatefulSetSpec(spec *models.StatefulSetSpec) error {
+	// Replicas are reached through the headless service; the NodePort
+	// service and ingress of container deployments do not apply here
+	if spec.StatefulSet.Service != nil || spec.StatefulSet.Ingress != nil {
+		return models.NewErrInvalidSpec("spec.statefulset",
+			"statefulsets are exposed through their headless service; service and ingress configuration is not supported")
+	}
+	if err := validateEnvironment("spec.statefulset.environment", spec.StatefulSet.Environment); err != nil {
+		return err
+	}
+	if err := validateEnvFrom("spec.statefulset.envFrom", spec.StatefulSet.EnvFrom); err != nil {
+		return err
+	}
+	if err := validateExtendedResources("spec.statefulset.resources.extended", spec.StatefulSet.Resources); err != nil {
+		return err
+	}
+	if err := validateVolumeClaims(spec.StatefulSet.VolumeClaims); err != nil {
+		return err
+	}
+	if err := validateNetworkAttachments("spec.statefulset.networks", spec.StatefulSet.Networks, false); err != nil {
+		return err
+	}
+	if err := validateExtraContainers("spec.statefulset", &spec.StatefulSet.ContainerConfig); err != nil {
+		return err
+	}
+	if err := validatePlacement("spec.statefulset.placement", spec.StatefulSet.Placement); err != nil {
+		return err
+	}
+	if err := validateExecOverrides("spec.statefulset", &spec.StatefulSet.ContainerConfig); err != nil {
+		return err
+	}
+	return validatePorts("spec.statefulset.ports", spec.StatefulSet.Ports)
+}
+
+// ensureStorageClassesSupported verifies that every storage class named by a
+// volume claim is installed on the cluster, so claims do not sit unbound
+// forever. In lenient mode a missing class is logged and passed through; the
+// check degrades gracefully when classes cannot be listed.
+func ensureStorageClassesSupported(ctx context.Context, readClient kubernetes.Interface, logger *zap.Logger, field string, claims []models.VolumeClaimConfig, strict bool) error {
+	named := false
+	for _, claim := range claims {
+		if claim.StorageClass != "" {
+			named = true
+			break
+		}
+	}
+	if !named {
+		return nil
+	}
+
+	classes, err := readClient.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.Warn("Failed to list storage classes for capability check", zap.Error(err))
+		return nil
+	}
+	installed := make(map[string]bool, len(classes.Items))
+	for i := range classes.Items {
+		installed[classes.Items[i].Name] = true
+	}
+
+	for i, claim := range claims {
+		if claim.StorageClass == "" || installed[claim.StorageClass] {
+			continue
+		}
+		if !strict {
+			logger.Warn("Requested storage class is not installed on the cluster",
+				zap.String("storage_class", claim.StorageClass))
+			continue
+		}
+		return models.NewErrFieldNotSupported(
+			fmt.Sprintf("%s[%d].storageClass", field, i),
+			fmt.Sprintf("storage class %q is not installed on the cluster", claim.StorageClass))
+	}
+	return nil
+}
+
+// validateVolumeClaims checks claim sizes and mount paths and rejects
+// duplicates, so manifests are only built from well-formed templates
+func validateVolumeClaims(claims []models.VolumeClaimConfig) error {
+	names := make(map[string]bool, len(claims))
+	mountPaths := make(map[string]bool, len(claims))
+	for i, claim := range claims {
+		if names[claim.Name] {
+			return models.NewErrInvalidSpec(fmt.Sprintf("spec.statefulset.volumeClaims[%d].name", i),
+				fmt.Sprintf("duplicate volume claim name %q", claim.Name))
+		}
+		names[claim.Name] = true
+
+		if _, err := resource.ParseQuantity(claim.Size); err != nil {
+			return models.NewErrInvalidSpec(fmt.Sprintf("spec.statefulset.volumeClaims[%d].size", i),
+				fmt.Sprintf("%q is not a valid quantity", claim.Size))
+		}
+
+		if !strings.HasPrefix(claim.MountPath, "/") {
+			return models.NewErrInvalidSpec(fmt.Sprintf("spec.statefulset.volumeClaims[%d].mountPath", i),
+				fmt.Sprintf("mount path %q must start with /", claim.MountPath))
+		}
+		if mountPaths[claim.MountPath] {
+			return models.NewErrInvalidSpec(fmt.Sprintf("spec.statefulset.volumeClaims[%d].mountPath", i),
+				fmt.Sprintf("duplicate mount path %q", claim.MountPath))
+		}
+		mountPaths[claim.MountPath] = true
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEnsureStorageClassesSupported(t *testing.T) {
+	fastClass := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "fast-ssd"},
+	}
+	client := fake.NewSimpleClientset(fastClass)
+
+	// Claims without an explicit class rely on the cluster default and pass
+	defaulted := []models.VolumeClaimConfig{
+		{Name: "data", Size: "10Gi", MountPath: "/data"},
+	}
+	err := ensureStorageClassesSupported(context.Background(), client, zap.NewNop(), "spec.statefulset.volumeClaims", defaulted, true)
+	assert.NoError(t, err)
+
+	// An installed class passes
+	installed := []models.VolumeClaimConfig{
+		{Name: "data", Size: "10Gi", MountPath: "/data", StorageClass: "fast-ssd"},
+	}
+	err = ensureStorageClassesSupported(context.Background(), client, zap.NewNop(), "spec.statefulset.volumeClaims", installed, true)
+	assert.NoError(t, err)
+
+	// Strict mode rejects a class the cluster does not serve
+	unknown := []models.VolumeClaimConfig{
+		{Name: "data", Size: "10Gi", MountPath: "/data", StorageClass: "glacier"},
+	}
+	err = ensureStorageClassesSupported(context.Background(), client, zap.NewNop(), "spec.statefulset.volumeClaims", unknown, true)
+	assert.Error(t, err)
+	assert.True(t, models.IsFieldNotSupportedError(err))
+	assert.Contains(t, err.Error(), `storage class "glacier" is not installed`)
+
+	// Lenient mode passes the same claim through
+	err = ensureStorageClassesSupported(context.Background(), client, zap.NewNop(), "spec.statefulset.volumeClaims", unknown, false)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"go.uber.org/zap"
+)
+
+// BuildSyncPlan diffs deployment records (e.g. from a backup export) against
+// the live cluster and plans an action per record: create when the ID is
+// missing, adopt when a matching deployment already exists, or conflict when
+// the ID resolves to a deployment with a different identity.
+func (d *DeploymentService) BuildSyncPlan(ctx context.Context, req *models.SyncPlanRequest) (*models.SyncPlanResponse, error) {
+	response := &models.SyncPlanResponse{
+		Entries: make([]models.SyncPlanEntry, 0, len(req.Records)),
+		Summary: map[string]int{},
+	}
+
+	for i := range req.Records {
+		entry := d.planSyncEntry(ctx, &req.Records[i])
+		response.Entries = append(response.Entries, entry)
+		response.Summary[entry.Action]++
+	}
+
+	d.logger.Info("Built differential sync plan",
+		zap.Int("records", len(req.Records)),
+		zap.Int("creates", response.Summary[models.SyncActionCreate]),
+		zap.Int("conflicts", response.Summary[models.SyncActionConflict]))
+
+	return response, nil
+}
+
+// ApplySyncPlan re-plans the given records and applies the planned creates.
+// IDs selects a subset of records; adopted and conflicting records are never
+// modified and are reported as skipped.
+func (d *DeploymentService) ApplySyncPlan(ctx context.Context, req *models.SyncApplyRequest) (*models.SyncApplyResponse, error) {
+	selected := map[string]bool{}
+	for _, id := range req.IDs {
+		selected[id] = true
+	}
+
+	response := &models.SyncApplyResponse{
+		Results: make([]models.SyncApplyResult, 0, len(req.Records)),
+	}
+
+	for i := range req.Records {
+		record := &req.Records[i]
+		entry := d.planSyncEntry(ctx, record)
+
+		result := models.SyncApplyResult{
+			ID:     record.ID,
+			Action: entry.Action,
+			Status: models.SyncStatusSkipped,
+		}
+
+		switch {
+		case entry.Action != models.SyncActionCreate:
+			result.Error = entry.Reason
+		case len(req.IDs) > 0 && !selected[record.ID]:
+			// Not selected for this apply; leave it for a later pass
+		default:
+			if err := d.CreateDeployment(ctx, &record.Deployment, record.ID); err != nil {
+				result.Status = models.SyncStatusFailed
+				result.Error = err.Error()
+			} else {
+				result.Status = models.SyncStatusApplied
+			}
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	return response, nil
+}
+
+// planSyncEntry determines the sync action for a single record by looking up
+// its ID across all namespaces and comparing the identity of any match
+func (d *DeploymentService) planSyncEntry(ctx context.Context, record *models.SyncRecord) models.SyncPlanEntry {
+	entry := models.SyncPlanEntry{
+		ID:        record.ID,
+		Kind:      record.Deployment.Kind,
+		Name:      record.Deployment.Metadata.Name,
+		Namespace: record.Deployment.Metadata.Namespace,
+	}
+
+	existing, err := d.GetDeploymentByID(ctx, record.ID)
+	switch {
+	case err == nil:
+		if mismatch := syncIdentityMismatch(record, existing); mismatch != "" {
+			entry.Action = models.SyncActionConflict
+			entry.Reason = mismatch
+		} else {
+			entry.Action = models.SyncActionAdopt
+		}
+	case models.IsNotFoundError(err):
+		entry.Action = models.SyncActionCreate
+	case models.IsMultipleFoundError(err):
+		entry.Action = models.SyncActionConflict
+		entry.Reason = "deployment ID exists in multiple namespaces"
+	default:
+		entry.Action = models.SyncActionConflict
+		entry.Reason = fmt.Sprintf("lookup failed: %v", err)
+	}
+
+	return entry
+}
+
+// syncIdentityMismatch compares a record against a live deployment with the
+// same ID and returns a human-readable reason when they differ. The namespace
+// is only compared when the record pins one.
+func syncIdentityMismatch(record *models.SyncRecord, existing *models.DeploymentResponse) string {
+	if record.Deployment.Kind != existing.Kind {
+		return fmt.Sprintf("existing deployment has kind %q, record has %q", existing.Kind, record.Deployment.Kind)
+	}
+	if record.Deployment.Metadata.Name != existing.Metadata.Name {
+		return fmt.Sprintf("existing deployment is named %q, record says %q", existing.Metadata.Name, record.Deployment.Metadata.Name)
+	}
+	if ns := record.Deployment.Metadata.Namespace; ns != "" && ns != existing.Metadata.Namespace {
+		return fmt.Sprintf("existing deployment is in namespace %q, record says %q", existing.Metadata.Namespace, ns)
+	}
+	return ""
+}
@@ -5,338 +5,1526 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"regexp"
 	"strings"
+	"sync/atomic"
+	"time"
 
+	"github.com/dcm-project/k8s-service-provider/internal/config"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/spec"
+	"github.com/dcm-project/k8s-service-provider/internal/flavors"
+	"github.com/dcm-project/k8s-service-provider/internal/netid"
+	"github.com/dcm-project/k8s-service-provider/internal/sshkeys"
 	"github.com/spf13/pflag"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 )
 
-// VMService handles virtual machine deployment operations using KubeVirt
+// kubevirtGroupName is the API group served by the cluster once the KubeVirt CRDs are installed
+const kubevirtGroupName = "kubevirt.io"
+
+// Labels and container names used by KubeVirt's virt-launcher pods
+const (
+	kubevirtVMNameLabel      = "vm.kubevirt.io/name"
+	guestConsoleLogContainer = "guest-console-log"
+)
+
+// Console types supported by the console proxy
+const (
+	ConsoleTypeSerial = "serial"
+	ConsoleTypeVNC    = "vnc"
+)
+
+// serialConsoleTimeout bounds how long we wait for the guest serial console to attach
+const serialConsoleTimeout = 30 * time.Second
+
+// How long to wait for a pre-update VirtualMachineSnapshot to become ready
+// before giving up on the update
+const (
+	snapshotReadyTimeout = 2 * time.Minute
+	snapshotPollInterval = 2 * time.Second
+)
+
+// cloudInitUserDataKey is the key that referenced Secrets and ConfigMaps must
+// carry their cloud-init user data under; it matches KubeVirt's convention for
+// CloudInitNoCloud secret references
+const cloudInitUserDataKey = "userdata"
+
+// VMService handles virtual machine deployment operations using KubeVirt.
+// Writes go through k8sClient; read-heavy operations (discovery) use
+// readClient so they are throttled on a separate budget.
 type VMService struct {
-	k8sClient      kubernetes.Interface
-	kubevirtClient kubecli.KubevirtClient
-	logger         *zap.Logger
+	k8sClient          kubernetes.Interface
+	readClient         kubernetes.Interface
+	kubevirtClient     kubecli.KubevirtClient
+	cache              *InformerCache
+	kubevirtAvailable  atomic.Bool
+	shape              config.VMShapeConfig
+	netRegistry        netid.Registry
+	sshKeys            *sshkeys.Registry
+	flavorCatalog      *flavors.Catalog
+	defaultTolerations []models.TolerationConfig
+	logger             *zap.Logger
+}
+
+// SetNetworkIdentityRegistry enables stable MAC address and hostname
+// reservations for VM deployments. Call before serving requests; a nil
+// registry (the default) leaves network identities up to the cluster.
+func (v *VMService) SetNetworkIdentityRegistry(registry netid.Registry) {
+	v.netRegistry = registry
+}
+
+// NewVMService creates a new VM service instance
+func NewVMService(k8sClient, readClient kubernetes.Interface, shape config.VMShapeConfig, logger *zap.Logger) *VMService {
+	// Create KubeVirt client using default config
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(kubecli.DefaultClientConfig(&pflag.FlagSet{}))
+	if err != nil {
+		logger.Fatal("Failed to create KubeVirt client", zap.Error(err))
+	}
+
+	return newVMService(k8sClient, readClient, virtClient, shape, logger)
+}
+
+// NewVMServiceFromKubeconfig creates a VM service whose KubeVirt client
+// authenticates with the given kubeconfig instead of the provider's own
+// environment; used for registered target clusters
+func NewVMServiceFromKubeconfig(k8sClient, readClient kubernetes.Interface, kubeconfig string, shape config.VMShapeConfig, logger *zap.Logger) *VMService {
+	virtClient, err := kubecli.GetKubevirtClientFromFlags("", kubeconfig)
+	if err != nil {
+		logger.Fatal("Failed to create KubeVirt client", zap.Error(err))
+	}
+
+	return newVMService(k8sClient, readClient, virtClient, shape, logger)
 }
 
-// NewVMService creates a new VM service instance
-func NewVMService(k8sClient kubernetes.Interface, logger *zap.Logger) *VMService {
-	// Create KubeVirt client using default config
-	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(kubecli.DefaultClientConfig(&pflag.FlagSet{}))
-	if err != nil {
-		logger.Fatal("Failed to create KubeVirt client", zap.Error(err))
+func newVMService(k8sClient, readClient kubernetes.Interface, virtClient kubecli.KubevirtClient, shape config.VMShapeConfig, logger *zap.Logger) *VMService {
+	service := &VMService{
+		k8sClient:      k8sClient,
+		readClient:     readClient,
+		kubevirtClient: virtClient,
+		shape:          shape,
+		logger:         logger,
+	}
+
+	// Detect KubeVirt up front; operations re-detect on demand so the service
+	// recovers once KubeVirt is installed after startup
+	if service.CheckAvailability(context.Background()) {
+		logger.Info("KubeVirt detected, VM deployments are enabled")
+	} else {
+		logger.Warn("KubeVirt not detected, VM deployments are unavailable until the CRDs are installed")
+	}
+
+	return service
+}
+
+// Available reports the most recently detected KubeVirt availability
+func (v *VMService) Available() bool {
+	return v.kubevirtAvailable.Load()
+}
+
+// CheckAvailability re-detects whether the cluster serves the KubeVirt API group
+func (v *VMService) CheckAvailability(ctx context.Context) bool {
+	groups, err := v.readClient.Discovery().ServerGroups()
+	if err != nil {
+		v.logger.Warn("Failed to query API groups for KubeVirt detection", zap.Error(err))
+		v.kubevirtAvailable.Store(false)
+		return false
+	}
+
+	for _, group := range groups.Groups {
+		if group.Name == kubevirtGroupName {
+			v.kubevirtAvailable.Store(true)
+			return true
+		}
+	}
+
+	v.kubevirtAvailable.Store(false)
+	return false
+}
+
+// ensureAvailable lazily re-checks availability so VM operations transition
+// from unavailable to functional without a provider restart
+func (v *VMService) ensureAvailable(ctx context.Context) error {
+	if v.Available() {
+		return nil
+	}
+	if v.CheckAvailability(ctx) {
+		return nil
+	}
+	return models.NewErrKubeVirtUnavailable()
+}
+
+// CreateVM creates a new virtual machine deployment using KubeVirt
+func (v *VMService) CreateVM(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	now := time.Now()
+	return v.createVM(ctx, req, id, stampCreator(ctx, models.BuildTimestampAnnotations(now, now)))
+}
+
+// createVM creates the VirtualMachine resources with the provided timestamp annotations
+func (v *VMService) createVM(ctx context.Context, req *models.DeploymentRequest, id string, annotations map[string]string) error {
+	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
+	logger.Info("Starting VM deployment")
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Fail fast when the namespace quota has no headroom for this VM
+	if vmSpec, ok := req.Spec.(models.VMSpec); ok {
+		if err := checkNamespaceQuota(ctx, v.readClient, namespace, vmQuotaRequests(&vmSpec.VM)); err != nil {
+			return err
+		}
+	}
+
+	// Create namespace if it doesn't exist
+	if err := v.ensureNamespace(ctx, namespace); err != nil {
+		return fmt.Errorf("failed to ensure namespace: %w", err)
+	}
+
+	virtualMachine, err := v.prepareVirtualMachine(ctx, req, id, namespace, annotations)
+	if err != nil {
+		return err
+	}
+
+	// Create the VirtualMachine in the cluster
+	created, err := v.kubevirtClient.VirtualMachine(namespace).Create(ctx, virtualMachine, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachine: %w", err)
+	}
+
+	// The credential and SSH key secrets are rendered before the
+	// VirtualMachine exists, so ownership is established right after it does
+	v.adoptSecrets(ctx, namespace, id, created, logger)
+
+	// Windows guests have no SSH; expose RDP instead. Either way the access
+	// Service only eases connectivity, so failures degrade rather than fail
+	// the deployment.
+	if vmSpec, ok := req.Spec.(models.VMSpec); ok {
+		switch {
+		case isWindowsGuest(vmSpec.VM.Os):
+			if err := v.createRDPService(ctx, namespace, req.Metadata.Name, id, created); err != nil {
+				logger.Warn("Failed to create RDP service", zap.Error(err))
+			}
+		case vmSpec.VM.SshServiceType != "":
+			if err := v.createSSHService(ctx, namespace, req.Metadata.Name, id, vmSpec.VM.SshServiceType, created); err != nil {
+				logger.Warn("Failed to create SSH service", zap.Error(err))
+			}
+		}
+	}
+
+	logger.Info("Successfully created VM deployment")
+	return nil
+}
+
+// adoptSecrets points the deployment's secrets at its VirtualMachine through
+// OwnerReferences, so Kubernetes garbage collection deletes them in cascade
+// with the VM. Failures only degrade cleanup back to the explicit sweep in
+// DeleteVM, so they are logged rather than failing the deployment.
+func (v *VMService) adoptSecrets(ctx context.Context, namespace, id string, vm *kubevirtv1.VirtualMachine, logger *zap.Logger) {
+	secrets, err := v.k8sClient.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		logger.Warn("Failed to list secrets for ownership", zap.Error(err))
+		return
+	}
+
+	owner := ownerReference(kubevirtv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+		kubevirtv1.VirtualMachineGroupVersionKind.Kind, vm.Name, vm.UID)
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		secret.OwnerReferences = []metav1.OwnerReference{owner}
+		if _, err := v.k8sClient.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+			logger.Warn("Failed to set secret owner",
+				zap.String("secret", secret.Name), zap.Error(err))
+		}
+	}
+}
+
+// prepareVirtualMachine validates the requested VM and renders the
+// VirtualMachine object for it, creating the SSH key and credentials secrets
+// it references and reapplying the deployment's reserved network identity.
+// Shared by the create and in-place update paths.
+func (v *VMService) prepareVirtualMachine(ctx context.Context, req *models.DeploymentRequest, id, namespace string, annotations map[string]string) (*kubevirtv1.VirtualMachine, error) {
+	if err := v.ensureAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	vmSpec, ok := req.Spec.(models.VMSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid VM spec format")
+	}
+
+	if err := v.applyFlavor(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := v.applyArchitecture(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validateVMShape(v.shape, &vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validateVMDisks(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validateVMCredentials(&vmSpec.VM); err != nil {
+		return nil, fmt.Errorf("invalid guest credentials: %w", err)
+	}
+
+	if err := validateWindowsConfig(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validateSSHService(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validateNetworkAttachments("spec.vm.networks", vmSpec.VM.Networks, true); err != nil {
+		return nil, err
+	}
+
+	if err := validateVMPerformance(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validatePlacement("spec.vm.placement", vmSpec.VM.Placement); err != nil {
+		return nil, err
+	}
+
+	// Operator policy: default tolerations for dedicated tenant node pools
+	vmSpec.VM.Placement = mergeTolerationPolicy(vmSpec.VM.Placement, v.defaultTolerations)
+
+	// Handle SSH key secret if needed
+	sshSecretName, secretWasCreated, err := v.ensureSSHKeySecret(ctx, namespace, &vmSpec.VM, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure SSH key secret: %w", err)
+	}
+
+	// Persist ops notes and tags alongside the timestamps
+	models.ApplyOpsAnnotations(annotations, req.Metadata.Notes, req.Metadata.Tags)
+	models.MergeUserAnnotations(annotations, req.Metadata.Annotations)
+
+	// Persist the versioned spec (credentials scrubbed) so schema migrations
+	// can upgrade it later
+	if err := spec.Persist(annotations, req.Spec); err != nil {
+		return nil, fmt.Errorf("failed to persist spec: %w", err)
+	}
+
+	// Create the VirtualMachine object
+	labels := models.BuildDeploymentLabels(id, req.Metadata.Name)
+	// Store in VM labels if we created a secret with random name (for cleanup tracking)
+	if secretWasCreated {
+		labels[models.LabelSSHSecretCreated] = "true"
+	}
+
+	userData, err := v.resolveCloudInitUserData(ctx, namespace, req.Metadata.Name, &vmSpec.VM)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a custom cloud-init source, generate the default document with
+	// the requested guest credentials. Windows guests take no cloud-init.
+	if userData == "" && vmSpec.VM.CloudInitSecret == nil && !isWindowsGuest(vmSpec.VM.Os) {
+		var credentialsSecretCreated bool
+		userData, credentialsSecretCreated, err = v.resolveGuestCredentials(ctx, namespace, req.Metadata.Name, id, &vmSpec.VM)
+		if err != nil {
+			return nil, err
+		}
+		if credentialsSecretCreated {
+			labels[models.LabelCredentialsSecretCreated] = "true"
+		}
+	}
+
+	virtualMachine := buildVirtualMachine(req.Metadata.Name, namespace, &vmSpec.VM, labels, annotations, sshSecretName, userData)
+
+	// Reapply the deployment's reserved MAC and hostname so DHCP
+	// reservations survive recreates
+	if v.netRegistry != nil {
+		reservation, err := v.netRegistry.Reserve(ctx, id, req.Metadata.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reserve network identity: %w", err)
+		}
+		applyNetworkIdentity(virtualMachine, reservation)
+	}
+
+	return virtualMachine, nil
+}
+
+// DryRunVM validates a VM deployment by server-side dry-running the generated
+// VirtualMachine, returning the rendered manifest without persisting anything.
+// SSH key inputs are validated but no secret is created; the target namespace
+// must already exist.
+func (v *VMService) DryRunVM(ctx context.Context, req *models.DeploymentRequest, id string) ([]interface{}, error) {
+	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
+
+	if err := v.ensureAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	vmSpec, ok := req.Spec.(models.VMSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid VM spec format")
+	}
+
+	if err := v.applyFlavor(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := v.applyArchitecture(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validateVMShape(v.shape, &vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validateVMDisks(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validateVMCredentials(&vmSpec.VM); err != nil {
+		return nil, fmt.Errorf("invalid guest credentials: %w", err)
+	}
+
+	if err := validateWindowsConfig(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validateSSHService(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validateNetworkAttachments("spec.vm.networks", vmSpec.VM.Networks, true); err != nil {
+		return nil, err
+	}
+
+	if err := validateVMPerformance(&vmSpec.VM); err != nil {
+		return nil, err
+	}
+
+	if err := validatePlacement("spec.vm.placement", vmSpec.VM.Placement); err != nil {
+		return nil, err
+	}
+
+	// Operator policy: default tolerations for dedicated tenant node pools
+	vmSpec.VM.Placement = mergeTolerationPolicy(vmSpec.VM.Placement, v.defaultTolerations)
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Validate SSH inputs without creating the secret
+	sshSecretName := ""
+	if vmSpec.VM.SshPublicKey != nil {
+		if err := v.validateSSHPublicKey(*vmSpec.VM.SshPublicKey); err != nil {
+			return nil, fmt.Errorf("invalid SSH public key: %w", err)
+		}
+	}
+	if vmSpec.VM.SshKeyName != nil {
+		if err := v.validateSecretName(*vmSpec.VM.SshKeyName); err != nil {
+			return nil, fmt.Errorf("invalid secret name: %w", err)
+		}
+		sshSecretName = *vmSpec.VM.SshKeyName
+	}
+
+	userData, err := v.resolveCloudInitUserData(ctx, namespace, req.Metadata.Name, &vmSpec.VM)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preview the default cloud-init document without persisting credentials;
+	// a generated password is shown as a placeholder. Windows guests take none.
+	if userData == "" && vmSpec.VM.CloudInitSecret == nil && !isWindowsGuest(vmSpec.VM.Os) {
+		username := vmSpec.VM.Username
+		if username == "" {
+			username = vmSpec.VM.Os
+		}
+		switch {
+		case vmSpec.VM.DisablePasswordAuth:
+			userData = buildDefaultCloudInitUserData(username, req.Metadata.Name, "", true)
+		case vmSpec.VM.Password != nil:
+			userData = buildDefaultCloudInitUserData(username, req.Metadata.Name, *vmSpec.VM.Password, false)
+		default:
+			userData = buildDefaultCloudInitUserData(username, req.Metadata.Name, dryRunPasswordPlaceholder, false)
+		}
+	}
+
+	now := time.Now()
+	labels := models.BuildDeploymentLabels(id, req.Metadata.Name)
+	virtualMachine := buildVirtualMachine(req.Metadata.Name, namespace, &vmSpec.VM, labels, models.BuildTimestampAnnotations(now, now), sshSecretName, userData)
+
+	rendered, err := v.kubevirtClient.VirtualMachine(namespace).Create(ctx, virtualMachine, metav1.CreateOptions{
+		DryRun: []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("virtual machine dry-run failed: %w", err)
+	}
+
+	logger.Info("Dry-run validated VM deployment")
+	return []interface{}{rendered}, nil
+}
+
+// GetVM retrieves VM deployment information
+func (v *VMService) GetVM(ctx context.Context, id string) (*models.DeploymentResponse, error) {
+	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
+
+	if err := v.ensureAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	// Search across all namespaces using label selector, served from the
+	// informer cache once it has synced
+	items, ok := v.cache.VirtualMachines("", models.BuildDeploymentSelector(id))
+	if !ok {
+		vms, err := v.kubevirtClient.VirtualMachine("").List(ctx, metav1.ListOptions{
+			LabelSelector: models.BuildDeploymentSelector(id),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get virtual machine: %w", err)
+		}
+		items = vms.Items
+	}
+
+	if len(items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+
+	vm := items[0]
+	createdAt, updatedAt := models.TimestampsFromAnnotations(vm.Annotations, vm.CreationTimestamp.Time)
+	runtime, conditions := v.vmRuntimeStatus(ctx, vm.Namespace, vm.Name)
+
+	// Convert VirtualMachine to our response model
+	response := &models.DeploymentResponse{
+		ID:   id,
+		Kind: models.DeploymentKindVM,
+		Metadata: models.Metadata{
+			Name:        vm.Name,
+			Namespace:   vm.Namespace,
+			Labels:      vm.Labels,
+			Annotations: models.UserAnnotations(vm.Annotations),
+			Notes:       vm.Annotations[models.AnnotationNotes],
+			Tags:        models.TagsFromAnnotations(vm.Annotations),
+		},
+		Status: models.DeploymentStatus{
+			Phase:             v.getVMPhase(&vm),
+			Message:           vmStatusMessage(&vm),
+			Conditions:        append(vmConditions(&vm), conditions...),
+			Migration:         v.migrationStatus(ctx, id, vm.Namespace),
+			Runtime:           runtime,
+			PreUpdateSnapshot: vm.Annotations[models.AnnotationPreUpdateSnapshot],
+			RestartRequired:   vmRestartRequired(&vm),
+		},
+		CreatedBy: models.CreatorFromAnnotations(vm.Annotations),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+
+	// Surface the persisted spec, migrated to the current schema version
+	if persisted, err := spec.FromAnnotations(vm.Annotations); err != nil {
+		logger.Warn("Failed to read persisted spec", zap.Error(err))
+	} else if persisted != nil {
+		response.Spec = persisted
+	}
+
+	// Report how an access Service (SSH or RDP) exposes the VM, if one exists
+	services, err := v.readClient.CoreV1().Services(vm.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		logger.Warn("Failed to list services for status", zap.Error(err))
+	} else if len(services.Items) > 0 {
+		response.Status.Service = buildServiceStatus(&services.Items[0])
+	}
+
+	logger.Info("Successfully retrieved VM deployment")
+	return response, nil
+}
+
+// PatchVMAnnotations applies a JSON merge patch to the annotations of the
+// VirtualMachine object, leaving its spec untouched
+func (v *VMService) PatchVMAnnotations(ctx context.Context, id, namespace string, annotations map[string]interface{}) error {
+	if err := v.ensureAvailable(ctx); err != nil {
+		return err
+	}
+
+	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find virtual machine: %w", err)
+	}
+	if len(vms.Items) == 0 {
+		return models.NewErrDeploymentNotFound(id)
+	}
+
+	patch, err := marshalAnnotationsPatch(annotations)
+	if err != nil {
+		return err
+	}
+
+	_, err = v.kubevirtClient.VirtualMachine(namespace).Patch(ctx, vms.Items[0].Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch virtual machine annotations: %w", err)
+	}
+	return nil
+}
+
+// UpdateVM updates an existing VM deployment
+func (v *VMService) UpdateVM(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
+	logger.Info("Updating VM deployment")
+
+	namespace := req.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Preserve the original creation time and creator across the update
+	createdAt := time.Now()
+	var creator *models.Creator
+	if existing, err := v.GetVM(ctx, id); err == nil {
+		createdAt = existing.CreatedAt
+		creator = existing.CreatedBy
+	}
+	annotations := preserveCreator(models.BuildTimestampAnnotations(createdAt, time.Now()), creator)
+
+	// Mutate the VirtualMachine spec in place where possible so the guest
+	// keeps its disks and the change applies through a KubeVirt restart.
+	// Renames and DataVolume template changes fall back to recreate: the
+	// templates are immutable and the name feeds the label selector.
+	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err == nil && len(vms.Items) > 0 && vms.Items[0].Labels[models.LabelApp] == req.Metadata.Name {
+		existing := vms.Items[0]
+
+		desired, err := v.prepareVirtualMachine(ctx, req, id, namespace, annotations)
+		if err != nil {
+			return err
+		}
+
+		if equality.Semantic.DeepEqual(existing.Spec.DataVolumeTemplates, desired.Spec.DataVolumeTemplates) {
+			existing.Labels = desired.Labels
+			existing.Annotations = desired.Annotations
+			existing.Spec = desired.Spec
+			updated, err := v.kubevirtClient.VirtualMachine(namespace).Update(ctx, &existing, metav1.UpdateOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to update VirtualMachine: %w", err)
+			}
+			// Secrets rendered for the update are adopted like on create
+			v.adoptSecrets(ctx, namespace, id, updated, logger)
+			// On clusters with the LiveUpdate rollout strategy KubeVirt
+			// hotplugs the CPU/memory delta; otherwise it raises the
+			// RestartRequired condition, surfaced on the deployment status
+			logger.Info("Updated VirtualMachine in place",
+				zap.Bool("restart_required", vmRestartRequired(updated)))
+			return nil
+		}
+		logger.Info("DataVolume templates changed, falling back to delete-and-recreate")
+	}
+
+	// Recreating a VM destroys its DataVolumes, so snapshot persistent disks
+	// first (when enabled) and abort the update if the snapshot fails
+	snapshotName, err := v.snapshotBeforeUpdate(ctx, id, namespace, logger)
+	if err != nil {
+		return err
+	}
+
+	if err := v.DeleteVM(ctx, id, namespace); err != nil {
+		logger.Warn("Failed to delete existing VM during update", zap.Error(err))
+	}
+
+	if snapshotName != "" {
+		annotations[models.AnnotationPreUpdateSnapshot] = snapshotName
+	}
+	return v.createVM(ctx, req, id, annotations)
+}
+
+// snapshotBeforeUpdate snapshots the persistent disks of the VM backing the
+// deployment before it is deleted and recreated. It returns the snapshot name
+// once the snapshot is ready to use, or an empty name when there is nothing
+// to snapshot: snapshotting disabled, no persistent disks, or the snapshot
+// CRDs not installed.
+func (v *VMService) snapshotBeforeUpdate(ctx context.Context, id, namespace string, logger *zap.Logger) (string, error) {
+	if !v.shape.SnapshotBeforeUpdate {
+		return "", nil
+	}
+
+	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil || len(vms.Items) == 0 {
+		// Nothing to snapshot; the update surfaces its own lookup errors
+		return "", nil
+	}
+	vm := vms.Items[0]
+	if len(vm.Spec.DataVolumeTemplates) == 0 {
+		// containerDisk-only VMs carry no persistent data
+		return "", nil
+	}
+
+	apiGroup := kubevirtGroupName
+	name := fmt.Sprintf("%s-preupdate-%d", vm.Name, time.Now().Unix())
+	snapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    models.BuildDeploymentLabels(id, vm.Name),
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     "VirtualMachine",
+				Name:     vm.Name,
+			},
+		},
+	}
+
+	if _, err := v.kubevirtClient.VirtualMachineSnapshot(namespace).Create(ctx, snapshot, metav1.CreateOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Warn("Snapshot CRDs not installed, updating without a pre-update snapshot")
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to create pre-update snapshot: %w", err)
+	}
+
+	logger.Info("Waiting for pre-update snapshot to become ready", zap.String("snapshot", name))
+	if err := v.waitForSnapshotReady(ctx, namespace, name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// waitForSnapshotReady polls a VirtualMachineSnapshot until it reports
+// readyToUse, the timeout elapses, or the context is cancelled
+func (v *VMService) waitForSnapshotReady(ctx context.Context, namespace, name string) error {
+	deadline := time.After(snapshotReadyTimeout)
+	ticker := time.NewTicker(snapshotPollInterval)
+	defer ticker.Stop()
+
+	for {
+		snapshot, err := v.kubevirtClient.VirtualMachineSnapshot(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil && snapshot.Status != nil && snapshot.Status.ReadyToUse != nil && *snapshot.Status.ReadyToUse {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("pre-update snapshot %s did not become ready: %w", name, ctx.Err())
+		case <-deadline:
+			return fmt.Errorf("pre-update snapshot %s did not become ready within %v", name, snapshotReadyTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteVM deletes a virtual machine deployment
+func (v *VMService) DeleteVM(ctx context.Context, id, namespace string) error {
+	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
+	logger.Info("Deleting VM deployment")
+
+	if err := v.ensureAvailable(ctx); err != nil {
+		return err
+	}
+
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Sweep auto-created secrets explicitly, a backstop for secrets created
+	// before ownership was introduced; owned secrets are garbage collected
+	// with the VirtualMachine either way
+	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err == nil && len(vms.Items) > 0 {
+		vm := vms.Items[0]
+		// Only delete secrets if we created them (indicated by label)
+		if vm.Labels[models.LabelSSHSecretCreated] == "true" || vm.Labels[models.LabelCredentialsSecretCreated] == "true" {
+			err := v.k8sClient.CoreV1().Secrets(namespace).DeleteCollection(ctx,
+				metav1.DeleteOptions{},
+				metav1.ListOptions{
+					LabelSelector: models.BuildDeploymentSelector(id),
+				})
+			if err != nil {
+				logger.Warn("Failed to delete associated secrets", zap.Error(err))
+			} else {
+				logger.Info("Deleted auto-created secrets")
+			}
+		}
+	}
+
+	// Delete VirtualMachines; garbage collection cascades to the owned
+	// secrets
+	propagation := metav1.DeletePropagationBackground
+	err = v.kubevirtClient.VirtualMachine(namespace).DeleteCollection(ctx, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	}, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete VirtualMachine: %w", err)
+	}
+
+	logger.Info("Successfully deleted VM deployment")
+	return nil
+}
+
+// listVMIDs returns the deployment IDs of managed VirtualMachines across all
+// namespaces, used by the orphan sweeper to tell live VM secrets apart from
+// leftovers
+func (v *VMService) listVMIDs(ctx context.Context) (map[string]bool, error) {
+	if err := v.ensureAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	vms, err := v.kubevirtClient.VirtualMachine(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildManagedResourceSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virtual machines: %w", err)
+	}
+
+	ids := make(map[string]bool, len(vms.Items))
+	for i := range vms.Items {
+		if id := vms.Items[i].Labels[models.LabelAppID]; id != "" {
+			ids[id] = true
+		}
+	}
+	return ids, nil
+}
+
+// ListVMs lists all VM deployments
+func (v *VMService) ListVMs(ctx context.Context, namespace string, limit, offset int) ([]models.DeploymentResponse, error) {
+	logger := v.logger.Named("vm_service")
+
+	if err := v.ensureAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	// Use empty string to search all namespaces if namespace is not specified
+	// Filter only resources managed by this service, served from the informer
+	// cache once it has synced
+	items, ok := v.cache.VirtualMachines(namespace, models.BuildManagedResourceSelector())
+	if !ok {
+		vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: models.BuildManagedResourceSelector(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list virtual machines: %w", err)
+		}
+		items = vms.Items
+	}
+
+	var responses []models.DeploymentResponse
+	for i, vm := range items {
+		if i < offset {
+			continue
+		}
+		if len(responses) >= limit {
+			break
+		}
+
+		appID := vm.Labels[models.LabelAppID]
+		// This should always exist since we filter by managed-by, but keeping as safety check
+
+		createdAt, updatedAt := models.TimestampsFromAnnotations(vm.Annotations, vm.CreationTimestamp.Time)
+		response := models.DeploymentResponse{
+			ID:   appID,
+			Kind: models.DeploymentKindVM,
+			Metadata: models.Metadata{
+				Name:        vm.Name,
+				Namespace:   vm.Namespace,
+				Labels:      vm.Labels,
+				Annotations: models.UserAnnotations(vm.Annotations),
+				Notes:       vm.Annotations[models.AnnotationNotes],
+				Tags:        models.TagsFromAnnotations(vm.Annotations),
+			},
+			Status: models.DeploymentStatus{
+				Phase:   v.getVMPhase(&vm),
+				Message: vmStatusMessage(&vm),
+			},
+			CreatedBy: models.CreatorFromAnnotations(vm.Annotations),
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+		}
+		responses = append(responses, response)
+	}
+
+	logger.Info("Successfully listed VM deployments", zap.Int("count", len(responses)))
+	return responses, nil
+}
+
+// GetVMConsoleLog streams the serial console output of a VM deployment.
+// KubeVirt mirrors the guest's serial console into the virt-launcher pod's
+// guest-console-log container, so boot failures can be read as pod logs.
+func (v *VMService) GetVMConsoleLog(ctx context.Context, id, namespace string, req *models.DeploymentLogsRequest) (io.ReadCloser, error) {
+	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
+
+	if err := v.ensureAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	// Resolve the VM name so we can locate its virt-launcher pod
+	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get virtual machine: %w", err)
+	}
+	if len(vms.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id, namespace)
+	}
+	vmName := vms.Items[0].Name
+
+	pods, err := v.readClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", kubevirtVMNameLabel, vmName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virt-launcher pods: %w", err)
+	}
+	if len(pods.Items) == 0 {
+		return nil, fmt.Errorf("no virt-launcher pod found for VM %s: the VM may not be running", vmName)
+	}
+
+	// Prefer a running virt-launcher pod; fall back to the first one
+	pod := pods.Items[0]
+	for _, candidate := range pods.Items {
+		if candidate.Status.Phase == corev1.PodRunning {
+			pod = candidate
+			break
+		}
+	}
+
+	logOptions := &corev1.PodLogOptions{
+		Container: guestConsoleLogContainer,
+		Follow:    req.Follow,
+	}
+	if req.Tail > 0 {
+		logOptions.TailLines = &req.Tail
+	}
+	if req.Since != "" {
+		duration, err := time.ParseDuration(req.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since duration %q: %w", req.Since, err)
+		}
+		sinceSeconds := int64(duration.Seconds())
+		logOptions.SinceSeconds = &sinceSeconds
+	}
+
+	stream, err := v.readClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream console log for pod %s: %w", pod.Name, err)
+	}
+
+	logger.Info("Streaming VM console log",
+		zap.String("pod", pod.Name),
+		zap.Bool("follow", req.Follow))
+	return stream, nil
+}
+
+// StreamVMConsole opens a VNC or serial console stream to a VM deployment
+// through the KubeVirt subresource API
+func (v *VMService) StreamVMConsole(ctx context.Context, id, namespace, consoleType string) (kvcorev1.StreamInterface, error) {
+	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
+
+	if err := v.ensureAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	// Resolve the VM name; the VMI shares it
+	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get virtual machine: %w", err)
+	}
+	if len(vms.Items) == 0 {
+		return nil, models.NewErrDeploymentNotFound(id, namespace)
+	}
+	vmName := vms.Items[0].Name
+
+	var stream kvcorev1.StreamInterface
+	switch consoleType {
+	case ConsoleTypeVNC:
+		stream, err = v.kubevirtClient.VirtualMachineInstance(namespace).VNC(vmName)
+	case ConsoleTypeSerial:
+		stream, err = v.kubevirtClient.VirtualMachineInstance(namespace).SerialConsole(vmName, &kvcorev1.SerialConsoleOptions{
+			ConnectionTimeout: serialConsoleTimeout,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported console type: %s", consoleType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s console for VM %s: %w", consoleType, vmName, err)
+	}
+
+	logger.Info("Opened VM console stream",
+		zap.String("vm", vmName),
+		zap.String("console_type", consoleType))
+	return stream, nil
+}
+
+// PerformVMAction starts, stops, restarts, pauses, unpauses or live-migrates
+// a VM deployment through the KubeVirt API and returns the phase observed
+// right after the action. Start/stop/restart target the VirtualMachine; pause
+// and unpause freeze the running VMI in place, preserving guest memory state;
+// migrate moves the running VMI to another node without restarting the guest.
+func (v *VMService) PerformVMAction(ctx context.Context, id, namespace, action string) (models.DeploymentPhase, error) {
+	logger := v.logger.Named("vm_service").With(
+		zap.String("deployment_id", id),
+		zap.String("action", action))
+
+	if err := v.ensureAvailable(ctx); err != nil {
+		return "", err
+	}
+
+	// Resolve the VM name from the deployment ID
+	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get virtual machine: %w", err)
+	}
+	if len(vms.Items) == 0 {
+		return "", models.NewErrDeploymentNotFound(id, namespace)
+	}
+	vmName := vms.Items[0].Name
+
+	switch action {
+	case models.ActionStart:
+		err = v.kubevirtClient.VirtualMachine(namespace).Start(ctx, vmName, &kubevirtv1.StartOptions{})
+	case models.ActionStop:
+		err = v.kubevirtClient.VirtualMachine(namespace).Stop(ctx, vmName, &kubevirtv1.StopOptions{})
+	case models.ActionRestart:
+		err = v.kubevirtClient.VirtualMachine(namespace).Restart(ctx, vmName, &kubevirtv1.RestartOptions{})
+	case models.ActionPause:
+		err = v.kubevirtClient.VirtualMachineInstance(namespace).Pause(ctx, vmName, &kubevirtv1.PauseOptions{})
+	case models.ActionUnpause:
+		err = v.kubevirtClient.VirtualMachineInstance(namespace).Unpause(ctx, vmName, &kubevirtv1.UnpauseOptions{})
+	case models.ActionMigrate:
+		// Live-migrate the running VMI to another node; KubeVirt picks the
+		// target and reports progress on the migration object
+		migration := &kubevirtv1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: fmt.Sprintf("%s-migration-", vmName),
+				Labels:       models.BuildDeploymentLabels(id, vmName),
+			},
+			Spec: kubevirtv1.VirtualMachineInstanceMigrationSpec{
+				VMIName: vmName,
+			},
+		}
+		_, err = v.kubevirtClient.VirtualMachineInstanceMigration(namespace).Create(ctx, migration, metav1.CreateOptions{})
+	case models.ActionRestore:
+		// Roll the persistent disks back to the snapshot taken before the
+		// last destructive update; KubeVirt performs the restore once the
+		// VM is stopped
+		snapshotName := vms.Items[0].Annotations[models.AnnotationPreUpdateSnapshot]
+		if snapshotName == "" {
+			return "", fmt.Errorf("no pre-update snapshot recorded for deployment %s", id)
+		}
+		apiGroup := kubevirtGroupName
+		restore := &snapshotv1.VirtualMachineRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: fmt.Sprintf("%s-restore-", vmName),
+				Labels:       models.BuildDeploymentLabels(id, vmName),
+			},
+			Spec: snapshotv1.VirtualMachineRestoreSpec{
+				Target: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+				VirtualMachineSnapshotName: snapshotName,
+			},
+		}
+		_, err = v.kubevirtClient.VirtualMachineRestore(namespace).Create(ctx, restore, metav1.CreateOptions{})
+	default:
+		return "", fmt.Errorf("unsupported action: %s", action)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to %s VM %s: %w", action, vmName, err)
+	}
+
+	// Re-read the VM to report the phase observed after the action
+	phase := models.DeploymentPhaseUnknown
+	if vm, err := v.kubevirtClient.VirtualMachine(namespace).Get(ctx, vmName, metav1.GetOptions{}); err == nil {
+		phase = v.getVMPhase(vm)
+	}
+
+	logger.Info("Performed VM power action",
+		zap.String("vm", vmName),
+		zap.String("phase", string(phase)))
+	return phase, nil
+}
+
+// migrationStatus returns the progress of the most recent live migration for
+// a VM deployment, or nil when no migration has been triggered. Lookup
+// failures are swallowed: migration progress is supplementary status and must
+// not break retrieval of the deployment itself.
+func (v *VMService) migrationStatus(ctx context.Context, id, namespace string) *models.MigrationStatus {
+	migrations, err := v.kubevirtClient.VirtualMachineInstanceMigration(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil || len(migrations.Items) == 0 {
+		return nil
+	}
+
+	latest := migrations.Items[0]
+	for _, migration := range migrations.Items[1:] {
+		if migration.CreationTimestamp.After(latest.CreationTimestamp.Time) {
+			latest = migration
+		}
 	}
 
-	return &VMService{
-		k8sClient:      k8sClient,
-		kubevirtClient: virtClient,
-		logger:         logger,
+	return &models.MigrationStatus{
+		Name:      latest.Name,
+		Phase:     string(latest.Status.Phase),
+		Completed: latest.Status.Phase == kubevirtv1.MigrationSucceeded,
+		Failed:    latest.Status.Phase == kubevirtv1.MigrationFailed,
 	}
 }
 
-// CreateVM creates a new virtual machine deployment using KubeVirt
-func (v *VMService) CreateVM(ctx context.Context, req *models.DeploymentRequest, id string) error {
-	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
-	logger.Info("Starting VM deployment")
-
-	vmSpec, ok := req.Spec.(models.VMSpec)
-	if !ok {
-		return fmt.Errorf("invalid VM spec format")
+// vmRuntimeStatus reports the node, interface addresses, guest OS details and
+// ready conditions of the backing VirtualMachineInstance, or nil when the VM
+// has no instance. Lookup failures are swallowed for the same reason as
+// migrationStatus: the runtime view is supplementary and must not break
+// retrieval of the deployment itself. The VMI shares the VM's name.
+func (v *VMService) vmRuntimeStatus(ctx context.Context, namespace, vmName string) (*models.VMRuntimeStatus, []models.Condition) {
+	vmi, err := v.kubevirtClient.VirtualMachineInstance(namespace).Get(ctx, vmName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil
 	}
+	return vmiRuntimeStatus(vmi)
+}
 
-	namespace := req.Metadata.Namespace
-	if namespace == "" {
-		namespace = "default"
+// vmiRuntimeStatus maps a VirtualMachineInstance's status into the response
+// model. Of the VMI conditions only AgentConnected is surfaced: Ready is
+// already mirrored onto the VirtualMachine, and the rest are KubeVirt
+// scheduling internals.
+func vmiRuntimeStatus(vmi *kubevirtv1.VirtualMachineInstance) (*models.VMRuntimeStatus, []models.Condition) {
+	runtime := &models.VMRuntimeStatus{Node: vmi.Status.NodeName}
+
+	for _, iface := range vmi.Status.Interfaces {
+		runtime.Interfaces = append(runtime.Interfaces, models.VMInterfaceStatus{
+			Name: iface.Name,
+			IP:   iface.IP,
+			IPs:  iface.IPs,
+			Mac:  iface.MAC,
+		})
 	}
 
-	// Create namespace if it doesn't exist
-	if err := v.ensureNamespace(ctx, namespace); err != nil {
-		return fmt.Errorf("failed to ensure namespace: %w", err)
+	guest := vmi.Status.GuestOSInfo
+	runtime.GuestOS = guest.PrettyName
+	if runtime.GuestOS == "" {
+		runtime.GuestOS = strings.TrimSpace(guest.Name + " " + guest.Version)
 	}
+	runtime.KernelVersion = guest.KernelRelease
 
-	// Handle SSH key secret if needed
-	sshSecretName, secretWasCreated, err := v.ensureSSHKeySecret(ctx, namespace, &vmSpec.VM, id)
-	if err != nil {
-		return fmt.Errorf("failed to ensure SSH key secret: %w", err)
+	var conditions []models.Condition
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type != kubevirtv1.VirtualMachineInstanceAgentConnected {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			runtime.GuestAgentConnected = true
+		}
+		conditions = append(conditions, models.Condition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: condition.LastTransitionTime.Time,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
 	}
 
-	// Create the VirtualMachine object
-	memory := resource.MustParse(fmt.Sprintf("%dGi", vmSpec.VM.Ram))
-	labels := models.BuildDeploymentLabels(id, req.Metadata.Name)
-	// Store in VM labels if we created a secret with random name (for cleanup tracking)
-	if secretWasCreated {
-		labels[models.LabelSSHSecretCreated] = "true"
+	return runtime, conditions
+}
+
+// GetVMEvents returns the Kubernetes events related to a VM deployment's
+// VirtualMachine, its instance and the virt-launcher pods. The VMI shares the
+// VM's name, so matching on that name covers both objects.
+func (v *VMService) GetVMEvents(ctx context.Context, id, namespace string) ([]models.DeploymentEvent, error) {
+	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
+
+	if err := v.ensureAvailable(ctx); err != nil {
+		return nil, err
 	}
 
-	virtualMachine := &kubevirtv1.VirtualMachine{
-		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: fmt.Sprintf("%s-", req.Metadata.Name),
-			Namespace:    namespace,
-			Labels:       labels,
-		},
-		Spec: kubevirtv1.VirtualMachineSpec{
-			RunStrategy: &[]kubevirtv1.VirtualMachineRunStrategy{kubevirtv1.RunStrategyRerunOnFailure}[0],
-			Template: &kubevirtv1.VirtualMachineInstanceTemplateSpec{
-				Spec: kubevirtv1.VirtualMachineInstanceSpec{
-					Architecture: "amd64",
-					Domain: kubevirtv1.DomainSpec{
-						CPU: &kubevirtv1.CPU{
-							Cores: uint32(vmSpec.VM.Cpu), // #nosec G115
-						},
-						Memory: &kubevirtv1.Memory{
-							Guest: &memory,
-						},
-						Devices: kubevirtv1.Devices{
-							Disks: []kubevirtv1.Disk{
-								{
-									Name:      fmt.Sprintf("%s-disk", req.Metadata.Name),
-									BootOrder: &[]uint{1}[0],
-									DiskDevice: kubevirtv1.DiskDevice{
-										Disk: &kubevirtv1.DiskTarget{
-											Bus: kubevirtv1.DiskBusVirtio,
-										},
-									},
-								},
-								{
-									Name:      "cloudinitdisk",
-									BootOrder: &[]uint{2}[0],
-									DiskDevice: kubevirtv1.DiskDevice{
-										Disk: &kubevirtv1.DiskTarget{
-											Bus: kubevirtv1.DiskBusVirtio,
-										},
-									},
-								},
-							},
-							Interfaces: []kubevirtv1.Interface{
-								{
-									Name: "myvmnic",
-									InterfaceBindingMethod: kubevirtv1.InterfaceBindingMethod{
-										Bridge: &kubevirtv1.InterfaceBridge{},
-									},
-								},
-							},
-							Rng: &kubevirtv1.Rng{},
-						},
-						Features: &kubevirtv1.Features{
-							ACPI: kubevirtv1.FeatureState{},
-							SMM: &kubevirtv1.FeatureState{
-								Enabled: &[]bool{true}[0],
-							},
-						},
-						Machine: &kubevirtv1.Machine{
-							Type: "pc-q35-rhel9.4.0",
-						},
-					},
-					Networks: []kubevirtv1.Network{
-						{
-							Name: "myvmnic",
-							NetworkSource: kubevirtv1.NetworkSource{
-								Pod: &kubevirtv1.PodNetwork{},
-							},
-						},
-					},
-					TerminationGracePeriodSeconds: &[]int64{180}[0],
-					Volumes: []kubevirtv1.Volume{
-						{
-							Name: fmt.Sprintf("%s-disk", req.Metadata.Name),
-							VolumeSource: kubevirtv1.VolumeSource{
-								ContainerDisk: &kubevirtv1.ContainerDiskSource{
-									Image: v.getOSImage(vmSpec.VM.Os),
-								},
-							},
-						},
-						{
-							Name: "cloudinitdisk",
-							VolumeSource: kubevirtv1.VolumeSource{
-								CloudInitNoCloud: &kubevirtv1.CloudInitNoCloudSource{
-									UserData: v.generateCloudInitUserData(req.Metadata.Name, &vmSpec.VM),
-								},
-							},
-						},
-					},
-				},
-			},
-		},
+	names := make(map[string]bool)
+
+	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildDeploymentSelector(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virtual machines: %w", err)
 	}
+	for _, vm := range vms.Items {
+		names[vm.Name] = true
 
-	// Add SSH AccessCredentials if configured
-	if sshSecretName != "" {
-		virtualMachine.Spec.Template.Spec.AccessCredentials = []kubevirtv1.AccessCredential{
-			{
-				SSHPublicKey: &kubevirtv1.SSHPublicKeyAccessCredential{
-					Source: kubevirtv1.SSHPublicKeyAccessCredentialSource{
-						Secret: &kubevirtv1.AccessCredentialSecretSource{
-							SecretName: sshSecretName,
-						},
-					},
-					PropagationMethod: kubevirtv1.SSHPublicKeyAccessCredentialPropagationMethod{
-						NoCloud: &kubevirtv1.NoCloudSSHPublicKeyAccessCredentialPropagation{},
-					},
-				},
-			},
+		pods, err := v.readClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", kubevirtVMNameLabel, vm.Name),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list virt-launcher pods: %w", err)
+		}
+		for _, pod := range pods.Items {
+			names[pod.Name] = true
 		}
 	}
 
-	// Create the VirtualMachine in the cluster
-	_, err = v.kubevirtClient.VirtualMachine(namespace).Create(ctx, virtualMachine, metav1.CreateOptions{})
+	events, err := listEventsForObjects(ctx, v.readClient, namespace, names)
 	if err != nil {
-		return fmt.Errorf("failed to create VirtualMachine: %w", err)
+		return nil, err
 	}
 
-	logger.Info("Successfully created VM deployment")
-	return nil
+	logger.Info("Retrieved VM deployment events", zap.Int("count", len(events)))
+	return events, nil
 }
 
-// GetVM retrieves VM deployment information
-func (v *VMService) GetVM(ctx context.Context, id string) (*models.DeploymentResponse, error) {
+// WatchVM streams status updates for a VM deployment using a Kubernetes watch
+func (v *VMService) WatchVM(ctx context.Context, id, namespace string) (<-chan models.DeploymentResponse, error) {
 	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
 
-	// Search across all namespaces using label selector
-	vms, err := v.kubevirtClient.VirtualMachine("").List(ctx, metav1.ListOptions{
+	if err := v.ensureAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	watcher, err := v.kubevirtClient.VirtualMachine(namespace).Watch(ctx, metav1.ListOptions{
 		LabelSelector: models.BuildDeploymentSelector(id),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get virtual machine: %w", err)
+		return nil, fmt.Errorf("failed to watch virtual machine: %w", err)
 	}
 
-	if len(vms.Items) == 0 {
-		return nil, models.NewErrDeploymentNotFound(id)
+	updates := make(chan models.DeploymentResponse)
+	go func() {
+		defer close(updates)
+		defer watcher.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					logger.Debug("VirtualMachine watch channel closed")
+					return
+				}
+
+				vm, ok := event.Object.(*kubevirtv1.VirtualMachine)
+				if !ok {
+					continue
+				}
+
+				createdAt, updatedAt := models.TimestampsFromAnnotations(vm.Annotations, vm.CreationTimestamp.Time)
+				response := models.DeploymentResponse{
+					ID:   id,
+					Kind: models.DeploymentKindVM,
+					Metadata: models.Metadata{
+						Name:        vm.Name,
+						Namespace:   vm.Namespace,
+						Labels:      vm.Labels,
+						Annotations: models.UserAnnotations(vm.Annotations),
+						Notes:       vm.Annotations[models.AnnotationNotes],
+						Tags:        models.TagsFromAnnotations(vm.Annotations),
+					},
+					Status: models.DeploymentStatus{
+						Phase: v.getVMPhase(vm),
+					},
+					CreatedBy: models.CreatorFromAnnotations(vm.Annotations),
+					CreatedAt: createdAt,
+					UpdatedAt: updatedAt,
+				}
+
+				select {
+				case updates <- response:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// validateVMShape checks a requested VM shape against the operator-defined
+// limits. The messages tell the caller how to fix the request, since the
+// policy is cluster-specific and not visible from the API schema alone.
+func validateVMShape(shape config.VMShapeConfig, vm *models.VMConfig) error {
+	if shape.MinCPU > 0 && vm.Cpu < shape.MinCPU {
+		return models.NewErrInvalidVMShape(fmt.Sprintf(
+			"cpu %d is below the minimum of %d cores allowed on this cluster", vm.Cpu, shape.MinCPU))
+	}
+	if shape.MaxCPU > 0 && vm.Cpu > shape.MaxCPU {
+		return models.NewErrInvalidVMShape(fmt.Sprintf(
+			"cpu %d exceeds the maximum of %d cores allowed on this cluster", vm.Cpu, shape.MaxCPU))
 	}
 
-	vm := vms.Items[0]
+	if shape.MinRAM > 0 && vm.Ram < shape.MinRAM {
+		return models.NewErrInvalidVMShape(fmt.Sprintf(
+			"ram %d GB is below the minimum of %d GB allowed on this cluster", vm.Ram, shape.MinRAM))
+	}
+	if shape.MaxRAM > 0 && vm.Ram > shape.MaxRAM {
+		return models.NewErrInvalidVMShape(fmt.Sprintf(
+			"ram %d GB exceeds the maximum of %d GB allowed on this cluster", vm.Ram, shape.MaxRAM))
+	}
 
-	// Convert VirtualMachine to our response model
-	response := &models.DeploymentResponse{
-		ID:   id,
-		Kind: models.DeploymentKindVM,
-		Metadata: models.Metadata{
-			Name:      vm.Name,
-			Namespace: vm.Namespace,
-			Labels:    vm.Labels,
-		},
-		Status: models.DeploymentStatus{
-			Phase: v.getVMPhase(&vm),
-		},
-		CreatedAt: vm.CreationTimestamp.Time,
-		UpdatedAt: vm.CreationTimestamp.Time,
+	if shape.RAMGranularity > 1 && vm.Ram%shape.RAMGranularity != 0 {
+		return models.NewErrInvalidVMShape(fmt.Sprintf(
+			"ram %d GB is not aligned to the node allocatable granularity of %d GB; round up to %d GB",
+			vm.Ram, shape.RAMGranularity, ((vm.Ram/shape.RAMGranularity)+1)*shape.RAMGranularity))
 	}
 
-	logger.Info("Successfully retrieved VM deployment")
-	return response, nil
-}
+	if vm.Cpu > 0 {
+		ratio := float64(vm.Ram) / float64(vm.Cpu)
+		if shape.MinRAMPerCPU > 0 && ratio < shape.MinRAMPerCPU {
+			return models.NewErrInvalidVMShape(fmt.Sprintf(
+				"ram:cpu ratio %.2f is below the minimum of %v GB per core; increase ram or reduce cpu",
+				ratio, shape.MinRAMPerCPU))
+		}
+		if shape.MaxRAMPerCPU > 0 && ratio > shape.MaxRAMPerCPU {
+			return models.NewErrInvalidVMShape(fmt.Sprintf(
+				"ram:cpu ratio %.2f exceeds the maximum of %v GB per core; reduce ram or increase cpu",
+				ratio, shape.MaxRAMPerCPU))
+		}
+	}
 
-// UpdateVM updates an existing VM deployment
-func (v *VMService) UpdateVM(ctx context.Context, req *models.DeploymentRequest, id string) error {
-	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
-	logger.Info("Updating VM deployment")
+	return nil
+}
 
-	namespace := req.Metadata.Namespace
-	if namespace == "" {
-		namespace = "default"
+// validateVMDisks checks the persistent disk configuration. Sizes must be
+// valid positive Kubernetes quantities since they become DataVolume storage
+// requests, and data disk names must be unique within the VM.
+func validateVMDisks(vm *models.VMConfig) error {
+	if vm.RootDisk != nil {
+		if err := validateDiskSize("root disk", vm.RootDisk.Size); err != nil {
+			return err
+		}
 	}
 
-	// For simplicity, we'll delete and recreate the VM
-	if err := v.DeleteVM(ctx, id, namespace); err != nil {
-		logger.Warn("Failed to delete existing VM during update", zap.Error(err))
+	seen := make(map[string]bool)
+	for i, disk := range vm.DataDisks {
+		label := fmt.Sprintf("data disk %d", i+1)
+		if disk.Name != "" {
+			label = fmt.Sprintf("data disk %q", disk.Name)
+			if seen[disk.Name] {
+				return models.NewErrInvalidVMShape(fmt.Sprintf("duplicate data disk name %q", disk.Name))
+			}
+			seen[disk.Name] = true
+		}
+		if err := validateDiskSize(label, disk.Size); err != nil {
+			return err
+		}
+		if disk.SourceImage != "" {
+			return models.NewErrInvalidVMShape(fmt.Sprintf("%s: sourceImage is only supported on the root disk", label))
+		}
 	}
 
-	return v.CreateVM(ctx, req, id)
+	return nil
 }
 
-// DeleteVM deletes a virtual machine deployment
-func (v *VMService) DeleteVM(ctx context.Context, id, namespace string) error {
-	logger := v.logger.Named("vm_service").With(zap.String("deployment_id", id))
-	logger.Info("Deleting VM deployment")
+// validateDiskSize checks that a disk size is a positive Kubernetes quantity
+func validateDiskSize(label, size string) error {
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return models.NewErrInvalidVMShape(fmt.Sprintf("%s size %q is not a valid quantity (e.g. \"10Gi\")", label, size))
+	}
+	if quantity.Sign() <= 0 {
+		return models.NewErrInvalidVMShape(fmt.Sprintf("%s size %q must be positive", label, size))
+	}
+	return nil
+}
 
-	if namespace == "" {
-		namespace = "default"
+// resolveCloudInitUserData resolves the cloud-init user data for a VM. A
+// referenced Secret stays a reference on the VirtualMachine (KubeVirt reads it
+// at boot), so only its existence is verified here. A referenced ConfigMap has
+// its userdata key inlined at create time, since CloudInitNoCloud cannot
+// reference ConfigMaps directly. With no reference, the empty return lets the
+// manifest builder generate the default user data.
+func (v *VMService) resolveCloudInitUserData(ctx context.Context, namespace, name string, vmConfig *models.VMConfig) (string, error) {
+	sources := 0
+	if vmConfig.CloudInitUserData != nil {
+		sources++
+	}
+	if vmConfig.CloudInitSecret != nil {
+		sources++
+	}
+	if vmConfig.CloudInitConfigMap != nil {
+		sources++
+	}
+	if sources > 1 {
+		return "", fmt.Errorf("only one of cloudInitUserData, cloudInitSecret and cloudInitConfigMap may be set")
 	}
 
-	// First, check if this VM created a secret with a random name
-	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: models.BuildDeploymentSelector(id),
-	})
-	if err == nil && len(vms.Items) > 0 {
-		vm := vms.Items[0]
-		// Only delete secrets if we created them (indicated by label)
-		if vm.Labels[models.LabelSSHSecretCreated] == "true" {
-			err := v.k8sClient.CoreV1().Secrets(namespace).DeleteCollection(ctx,
-				metav1.DeleteOptions{},
-				metav1.ListOptions{
-					LabelSelector: models.BuildDeploymentSelector(id),
-				})
-			if err != nil {
-				logger.Warn("Failed to delete associated secrets", zap.Error(err))
-			} else {
-				logger.Info("Deleted auto-created SSH secrets")
-			}
+	if vmConfig.CloudInitUserData != nil {
+		if err := validateCloudInitUserData(*vmConfig.CloudInitUserData); err != nil {
+			return "", err
 		}
+		return *vmConfig.CloudInitUserData, nil
 	}
 
-	// Delete VirtualMachines
-	err = v.kubevirtClient.VirtualMachine(namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
-		LabelSelector: models.BuildDeploymentSelector(id),
-	})
-	if err != nil {
-		return fmt.Errorf("failed to delete VirtualMachine: %w", err)
+	if vmConfig.CloudInitSecret != nil {
+		if err := v.validateSecretName(*vmConfig.CloudInitSecret); err != nil {
+			return "", fmt.Errorf("invalid cloud-init secret name: %w", err)
+		}
+		secret, err := v.readClient.CoreV1().Secrets(namespace).Get(ctx, *vmConfig.CloudInitSecret, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get cloud-init secret %s in namespace %s: %w", *vmConfig.CloudInitSecret, namespace, err)
+		}
+		if _, ok := secret.Data[cloudInitUserDataKey]; !ok {
+			return "", fmt.Errorf("cloud-init secret %s is missing the %q key", *vmConfig.CloudInitSecret, cloudInitUserDataKey)
+		}
+		return "", nil
 	}
 
-	logger.Info("Successfully deleted VM deployment")
-	return nil
+	if vmConfig.CloudInitConfigMap != nil {
+		if err := v.validateSecretName(*vmConfig.CloudInitConfigMap); err != nil {
+			return "", fmt.Errorf("invalid cloud-init config map name: %w", err)
+		}
+		configMap, err := v.readClient.CoreV1().ConfigMaps(namespace).Get(ctx, *vmConfig.CloudInitConfigMap, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get cloud-init config map %s in namespace %s: %w", *vmConfig.CloudInitConfigMap, namespace, err)
+		}
+		userData, ok := configMap.Data[cloudInitUserDataKey]
+		if !ok {
+			return "", fmt.Errorf("cloud-init config map %s is missing the %q key", *vmConfig.CloudInitConfigMap, cloudInitUserDataKey)
+		}
+		return userData, nil
+	}
+
+	return "", nil
 }
 
-// ListVMs lists all VM deployments
-func (v *VMService) ListVMs(ctx context.Context, namespace string, limit, offset int) ([]models.DeploymentResponse, error) {
-	logger := v.logger.Named("vm_service")
+// generatedPasswordLength is the length of server-generated guest passwords
+const generatedPasswordLength = 16
 
-	// Use empty string to search all namespaces if namespace is not specified
-	// Filter only resources managed by this service
-	vms, err := v.kubevirtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: models.BuildManagedResourceSelector(),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list virtual machines: %w", err)
+// dryRunPasswordPlaceholder stands in for the generated password in dry-run
+// previews, where nothing may be persisted
+const dryRunPasswordPlaceholder = "generated-at-create-time"
+
+// credentialsSecretKeys name the entries of a generated credentials Secret
+const (
+	credentialsUsernameKey = "username"
+	credentialsPasswordKey = "password"
+)
+
+// validateVMCredentials checks the guest credential fields. Credentials are
+// applied through the generated cloud-init, so they conflict with custom
+// cloud-init sources which carry their own.
+func validateVMCredentials(vm *models.VMConfig) error {
+	hasCredentials := vm.Username != "" || vm.Password != nil || vm.DisablePasswordAuth
+	hasCustomCloudInit := vm.CloudInitUserData != nil || vm.CloudInitSecret != nil || vm.CloudInitConfigMap != nil
+	if hasCredentials && hasCustomCloudInit {
+		return fmt.Errorf("guest credentials cannot be combined with a custom cloud-init source")
 	}
 
-	var responses []models.DeploymentResponse
-	for i, vm := range vms.Items {
-		if i < offset {
-			continue
+	if vm.Password != nil {
+		if vm.DisablePasswordAuth {
+			return fmt.Errorf("password cannot be set when password auth is disabled")
 		}
-		if len(responses) >= limit {
-			break
+		if len(*vm.Password) < 8 {
+			return fmt.Errorf("password must be at least 8 characters")
 		}
+	}
 
-		appID := vm.Labels[models.LabelAppID]
-		// This should always exist since we filter by managed-by, but keeping as safety check
+	return nil
+}
 
-		response := models.DeploymentResponse{
-			ID:   appID,
-			Kind: models.DeploymentKindVM,
-			Metadata: models.Metadata{
-				Name:      vm.Name,
-				Namespace: vm.Namespace,
-				Labels:    vm.Labels,
-			},
-			Status: models.DeploymentStatus{
-				Phase: v.getVMPhase(&vm),
-			},
-			CreatedAt: vm.CreationTimestamp.Time,
-			UpdatedAt: vm.CreationTimestamp.Time,
+// resolveGuestCredentials builds the default cloud-init document for a VM
+// without a custom cloud-init source. When no password is supplied and
+// password auth is enabled, one is generated and stored in a Secret labeled
+// for the deployment; the returned bool reports whether such a Secret was
+// created.
+func (v *VMService) resolveGuestCredentials(ctx context.Context, namespace, name, id string, vm *models.VMConfig) (string, bool, error) {
+	username := vm.Username
+	if username == "" {
+		username = vm.Os
+	}
+
+	if vm.DisablePasswordAuth {
+		return buildDefaultCloudInitUserData(username, name, "", true), false, nil
+	}
+
+	if vm.Password != nil {
+		return buildDefaultCloudInitUserData(username, name, *vm.Password, false), false, nil
+	}
+
+	secretName := fmt.Sprintf("%s-credentials-%s", name, id[:8])
+
+	// In-place updates reuse the stored credentials so the guest keeps a
+	// stable generated password across spec changes
+	if existing, err := v.k8sClient.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{}); err == nil {
+		storedUsername := string(existing.Data[credentialsUsernameKey])
+		if storedUsername == "" {
+			storedUsername = username
 		}
-		responses = append(responses, response)
+		return buildDefaultCloudInitUserData(storedUsername, name, string(existing.Data[credentialsPasswordKey]), false), false, nil
 	}
 
-	logger.Info("Successfully listed VM deployments", zap.Int("count", len(responses)))
-	return responses, nil
+	password, err := generateRandomString(generatedPasswordLength)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to generate guest password: %w", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: namespace,
+			Labels:    models.BuildDeploymentLabels(id, name),
+		},
+		StringData: map[string]string{
+			credentialsUsernameKey: username,
+			credentialsPasswordKey: password,
+		},
+	}
+	if _, err := v.k8sClient.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return "", false, fmt.Errorf("failed to store generated guest credentials: %w", err)
+	}
+
+	v.logger.Named("vm_service").Info("Stored generated guest credentials",
+		zap.String("deployment_id", id),
+		zap.String("secret", secretName))
+	return buildDefaultCloudInitUserData(username, name, password, false), true, nil
+}
+
+// maxCloudInitUserDataBytes caps inline cloud-init documents so VirtualMachine
+// objects stay comfortably below the apiserver object size limits; larger
+// documents belong in a referenced Secret or ConfigMap
+const maxCloudInitUserDataBytes = 32 * 1024
+
+// validateCloudInitUserData checks an inline cloud-init document before it is
+// embedded in the VirtualMachine
+func validateCloudInitUserData(userData string) error {
+	if strings.TrimSpace(userData) == "" {
+		return fmt.Errorf("cloud-init user data must not be empty")
+	}
+	if len(userData) > maxCloudInitUserDataBytes {
+		return fmt.Errorf("cloud-init user data is %d bytes, exceeding the %d byte limit; use cloudInitSecret or cloudInitConfigMap for larger documents",
+			len(userData), maxCloudInitUserDataBytes)
+	}
+	if !strings.HasPrefix(userData, "#cloud-config") && !strings.HasPrefix(userData, "#!") {
+		return fmt.Errorf("cloud-init user data must start with #cloud-config or a #! script header")
+	}
+	return nil
 }
 
 // generateRandomString generates a random hex string of specified length
@@ -359,30 +1547,9 @@ func (v *VMService) generateSecretName(deploymentName string) string {
 }
 
 // validateSSHPublicKey validates that the provided string is a valid SSH public key
+// The format check lives in the sshkeys package, shared with the key registry API
 func (v *VMService) validateSSHPublicKey(publicKey string) error {
-	publicKey = strings.TrimSpace(publicKey)
-
-	if publicKey == "" {
-		return fmt.Errorf("SSH public key cannot be empty")
-	}
-
-	// Valid SSH key types
-	validPrefixes := []string{
-		"ssh-rsa ",
-		"ssh-ed25519 ",
-		"ecdsa-sha2-nistp256 ",
-		"ecdsa-sha2-nistp384 ",
-		"ecdsa-sha2-nistp521 ",
-		"ssh-dss ", // Legacy, but still valid
-	}
-
-	for _, prefix := range validPrefixes {
-		if strings.HasPrefix(publicKey, prefix) {
-			return nil
-		}
-	}
-
-	return fmt.Errorf("invalid SSH public key format: must start with ssh-rsa, ssh-ed25519, ecdsa-sha2-*, or ssh-dss")
+	return sshkeys.ValidatePublicKey(publicKey)
 }
 
 // validateSecretName validates Kubernetes secret name follows DNS-1123 subdomain rules
@@ -465,6 +1632,15 @@ func (v *VMService) ensureSSHKeySecret(ctx context.Context, namespace string, vm
 
 		// Secret doesn't exist - need to create it
 		if vmConfig.SshPublicKey == nil {
+			// Fall back to the cross-namespace key registry before failing
+			found, err := v.resolveRegisteredSSHKey(ctx, namespace, secretName, deploymentID)
+			if err != nil {
+				return "", false, err
+			}
+			if found {
+				return secretName, false, nil
+			}
+
 			// FAIL: ssh_key_name set but no public key and secret doesn't exist
 			return "", false, fmt.Errorf("secret %s not found and no ssh_public_key provided", secretName)
 		}
@@ -482,7 +1658,6 @@ func (v *VMService) ensureSSHKeySecret(ctx context.Context, namespace string, vm
 	return secretName, useRandomName, nil
 }
 
-
 // ensureNamespace creates namespace if it doesn't exist
 func (v *VMService) ensureNamespace(ctx context.Context, namespace string) error {
 	_, err := v.k8sClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
@@ -500,34 +1675,42 @@ func (v *VMService) ensureNamespace(ctx context.Context, namespace string) error
 	return nil
 }
 
-// getOSImage returns the container image for the specified OS
-func (v *VMService) getOSImage(os string) string {
-	images := map[string]string{
-		"fedora": "quay.io/containerdisks/fedora:latest",
-		"ubuntu": "quay.io/containerdisks/ubuntu:latest",
-		"centos": "quay.io/containerdisks/centos:latest",
-		"rhel":   "quay.io/containerdisks/rhel:latest",
+// getVMPhase converts KubeVirt VM status to our deployment phase. KubeVirt's
+// printable status carries the lifecycle detail — provisioning, starting,
+// migrating, stopping — so a VM that isn't ready yet reports what it is
+// actually doing; the conditions remain the fallback for objects whose
+// controller has not stamped a printable status yet.
+func (v *VMService) getVMPhase(vm *kubevirtv1.VirtualMachine) models.DeploymentPhase {
+	// A paused VMI keeps its memory state but is frozen; report it distinctly
+	for _, condition := range vm.Status.Conditions {
+		if condition.Type == kubevirtv1.VirtualMachinePaused && condition.Status == corev1.ConditionTrue {
+			return models.DeploymentPhasePaused
+		}
 	}
 
-	if image, exists := images[os]; exists {
-		return image
+	switch vm.Status.PrintableStatus {
+	case kubevirtv1.VirtualMachineStatusRunning:
+		return models.DeploymentPhaseRunning
+	case kubevirtv1.VirtualMachineStatusPaused:
+		return models.DeploymentPhasePaused
+	case kubevirtv1.VirtualMachineStatusProvisioning, kubevirtv1.VirtualMachineStatusWaitingForVolumeBinding:
+		return models.DeploymentPhaseProvisioning
+	case kubevirtv1.VirtualMachineStatusStarting:
+		return models.DeploymentPhaseStarting
+	case kubevirtv1.VirtualMachineStatusMigrating:
+		return models.DeploymentPhaseMigrating
+	case kubevirtv1.VirtualMachineStatusStopping, kubevirtv1.VirtualMachineStatusTerminating:
+		return models.DeploymentPhaseStopping
+	case kubevirtv1.VirtualMachineStatusStopped:
+		return models.DeploymentPhaseStopped
+	case kubevirtv1.VirtualMachineStatusCrashLoopBackOff:
+		return models.DeploymentPhaseCrashLoop
+	case kubevirtv1.VirtualMachineStatusUnschedulable, kubevirtv1.VirtualMachineStatusErrImagePull,
+		kubevirtv1.VirtualMachineStatusImagePullBackOff, kubevirtv1.VirtualMachineStatusPvcNotFound,
+		kubevirtv1.VirtualMachineStatusDataVolumeError:
+		return models.DeploymentPhaseFailed
 	}
-	// Default to fedora if OS not found
-	return "quay.io/containerdisks/fedora:latest"
-}
 
-// generateCloudInitUserData generates cloud-init user data for the VM
-func (v *VMService) generateCloudInitUserData(appName string, vm *models.VMConfig) string {
-	return fmt.Sprintf(`#cloud-config
-user: %s
-password: auto-generated-pass
-chpasswd: { expire: False }
-hostname: %s
-`, vm.Os, appName)
-}
-
-// getVMPhase converts KubeVirt VM status to our deployment phase
-func (v *VMService) getVMPhase(vm *kubevirtv1.VirtualMachine) models.DeploymentPhase {
 	if vm.Status.Ready {
 		return models.DeploymentPhaseRunning
 	}
@@ -547,3 +1730,48 @@ func (v *VMService) getVMPhase(vm *kubevirtv1.VirtualMachine) models.DeploymentP
 
 	return models.DeploymentPhasePending
 }
+
+// vmStatusMessage explains why a VM is not ready, taken from the Ready or
+// Failure condition the KubeVirt controller maintains. Empty for healthy VMs.
+func vmStatusMessage(vm *kubevirtv1.VirtualMachine) string {
+	for _, condition := range vm.Status.Conditions {
+		if condition.Type == kubevirtv1.VirtualMachineFailure && condition.Status == corev1.ConditionTrue {
+			return condition.Message
+		}
+	}
+	for _, condition := range vm.Status.Conditions {
+		if condition.Type == kubevirtv1.VirtualMachineReady && condition.Status != corev1.ConditionTrue {
+			return condition.Message
+		}
+	}
+	return ""
+}
+
+// vmConditions translates the VirtualMachine's conditions (Ready, Failure,
+// Paused, RestartRequired, ...) into the response model, keeping the
+// controller's transition times and reasons
+func vmConditions(vm *kubevirtv1.VirtualMachine) []models.Condition {
+	var conditions []models.Condition
+	for _, condition := range vm.Status.Conditions {
+		conditions = append(conditions, models.Condition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: condition.LastTransitionTime.Time,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+	return conditions
+}
+
+// vmRestartRequired reports whether KubeVirt has flagged the VM with the
+// RestartRequired condition: spec changes were applied in place but could not
+// all be hotplugged, so they wait on a guest restart
+func vmRestartRequired(vm *kubevirtv1.VirtualMachine) bool {
+	for _, condition := range vm.Status.Conditions {
+		if condition.Type == kubevirtv1.VirtualMachineRestartRequired {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,503 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+)
+
+func TestValidateVMShape(t *testing.T) {
+	defaultShape := config.VMShapeConfig{
+		MinCPU:         1,
+		MaxCPU:         32,
+		MinRAM:         1,
+		MaxRAM:         32,
+		MinRAMPerCPU:   1,
+		MaxRAMPerCPU:   8,
+		RAMGranularity: 1,
+	}
+
+	tests := []struct {
+		name        string
+		shape       config.VMShapeConfig
+		vm          models.VMConfig
+		expectError bool
+		contains    string
+	}{
+		{
+			name:  "valid shape within defaults",
+			shape: defaultShape,
+			vm:    models.VMConfig{Cpu: 2, Ram: 4, Os: "fedora"},
+		},
+		{
+			name:  "valid shape at limits",
+			shape: defaultShape,
+			vm:    models.VMConfig{Cpu: 4, Ram: 32, Os: "fedora"},
+		},
+		{
+			name:        "cpu below minimum",
+			shape:       config.VMShapeConfig{MinCPU: 2, MaxCPU: 32},
+			vm:          models.VMConfig{Cpu: 1, Ram: 4, Os: "fedora"},
+			expectError: true,
+			contains:    "below the minimum of 2 cores",
+		},
+		{
+			name:        "cpu above maximum",
+			shape:       config.VMShapeConfig{MinCPU: 1, MaxCPU: 8},
+			vm:          models.VMConfig{Cpu: 16, Ram: 32, Os: "fedora"},
+			expectError: true,
+			contains:    "exceeds the maximum of 8 cores",
+		},
+		{
+			name:        "ram below minimum",
+			shape:       config.VMShapeConfig{MinRAM: 2, MaxRAM: 32},
+			vm:          models.VMConfig{Cpu: 1, Ram: 1, Os: "fedora"},
+			expectError: true,
+			contains:    "below the minimum of 2 GB",
+		},
+		{
+			name:        "ram above maximum",
+			shape:       config.VMShapeConfig{MinRAM: 1, MaxRAM: 16},
+			vm:          models.VMConfig{Cpu: 4, Ram: 24, Os: "fedora"},
+			expectError: true,
+			contains:    "exceeds the maximum of 16 GB",
+		},
+		{
+			name:        "ram not aligned to granularity",
+			shape:       config.VMShapeConfig{RAMGranularity: 2},
+			vm:          models.VMConfig{Cpu: 2, Ram: 5, Os: "fedora"},
+			expectError: true,
+			contains:    "round up to 6 GB",
+		},
+		{
+			name:  "ram aligned to granularity",
+			shape: config.VMShapeConfig{RAMGranularity: 2},
+			vm:    models.VMConfig{Cpu: 2, Ram: 6, Os: "fedora"},
+		},
+		{
+			name:        "ratio below minimum",
+			shape:       defaultShape,
+			vm:          models.VMConfig{Cpu: 8, Ram: 4, Os: "fedora"},
+			expectError: true,
+			contains:    "increase ram or reduce cpu",
+		},
+		{
+			name:        "ratio above maximum",
+			shape:       defaultShape,
+			vm:          models.VMConfig{Cpu: 2, Ram: 32, Os: "fedora"},
+			expectError: true,
+			contains:    "reduce ram or increase cpu",
+		},
+		{
+			name: "zero value shape disables all checks",
+			vm:   models.VMConfig{Cpu: 64, Ram: 512, Os: "fedora"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateVMShape(tt.shape, &tt.vm)
+
+			if !tt.expectError {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.True(t, models.IsInvalidVMShapeError(err), "expected ErrInvalidVMShape, got %T", err)
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+}
+
+func TestValidateVMDisks(t *testing.T) {
+	tests := []struct {
+		name        string
+		vm          models.VMConfig
+		expectError bool
+		contains    string
+	}{
+		{
+			name: "no persistent disks",
+			vm:   models.VMConfig{Cpu: 2, Ram: 4, Os: "fedora"},
+		},
+		{
+			name: "valid root and data disks",
+			vm: models.VMConfig{
+				Cpu: 2, Ram: 4, Os: "fedora",
+				RootDisk:  &models.VMDiskConfig{Size: "20Gi"},
+				DataDisks: []models.VMDiskConfig{{Name: "scratch", Size: "50Gi"}},
+			},
+		},
+		{
+			name: "invalid root disk size",
+			vm: models.VMConfig{
+				Cpu: 2, Ram: 4, Os: "fedora",
+				RootDisk: &models.VMDiskConfig{Size: "twenty"},
+			},
+			expectError: true,
+			contains:    "not a valid quantity",
+		},
+		{
+			name: "negative data disk size",
+			vm: models.VMConfig{
+				Cpu: 2, Ram: 4, Os: "fedora",
+				DataDisks: []models.VMDiskConfig{{Size: "-1Gi"}},
+			},
+			expectError: true,
+			contains:    "must be positive",
+		},
+		{
+			name: "duplicate data disk names",
+			vm: models.VMConfig{
+				Cpu: 2, Ram: 4, Os: "fedora",
+				DataDisks: []models.VMDiskConfig{
+					{Name: "scratch", Size: "10Gi"},
+					{Name: "scratch", Size: "20Gi"},
+				},
+			},
+			expectError: true,
+			contains:    "duplicate data disk name",
+		},
+		{
+			name: "source image on data disk",
+			vm: models.VMConfig{
+				Cpu: 2, Ram: 4, Os: "fedora",
+				DataDisks: []models.VMDiskConfig{{Name: "scratch", Size: "10Gi", SourceImage: "quay.io/foo:latest"}},
+			},
+			expectError: true,
+			contains:    "only supported on the root disk",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateVMDisks(&tt.vm)
+
+			if !tt.expectError {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.True(t, models.IsInvalidVMShapeError(err), "expected ErrInvalidVMShape, got %T", err)
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+}
+
+func TestValidateCloudInitUserData(t *testing.T) {
+	tests := []struct {
+		name        string
+		userData    string
+		expectError bool
+		contains    string
+	}{
+		{
+			name:     "valid cloud-config document",
+			userData: "#cloud-config\npackages:\n  - nginx\n",
+		},
+		{
+			name:     "valid script",
+			userData: "#!/bin/bash\necho hello\n",
+		},
+		{
+			name:        "empty document",
+			userData:    "   \n",
+			expectError: true,
+			contains:    "must not be empty",
+		},
+		{
+			name:        "missing header",
+			userData:    "packages:\n  - nginx\n",
+			expectError: true,
+			contains:    "must start with",
+		},
+		{
+			name:        "oversized document",
+			userData:    "#cloud-config\n" + strings.Repeat("x", maxCloudInitUserDataBytes),
+			expectError: true,
+			contains:    "exceeding",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCloudInitUserData(tt.userData)
+
+			if !tt.expectError {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+}
+
+func TestValidateVMCredentials(t *testing.T) {
+	password := "longenough"
+	short := "short"
+	inline := "#cloud-config\n"
+
+	tests := []struct {
+		name        string
+		vm          models.VMConfig
+		expectError bool
+		contains    string
+	}{
+		{
+			name: "no credentials",
+			vm:   models.VMConfig{Cpu: 2, Ram: 4, Os: "fedora"},
+		},
+		{
+			name: "username and password",
+			vm:   models.VMConfig{Cpu: 2, Ram: 4, Os: "fedora", Username: "admin", Password: &password},
+		},
+		{
+			name: "password auth disabled",
+			vm:   models.VMConfig{Cpu: 2, Ram: 4, Os: "fedora", DisablePasswordAuth: true},
+		},
+		{
+			name:        "password with auth disabled",
+			vm:          models.VMConfig{Cpu: 2, Ram: 4, Os: "fedora", Password: &password, DisablePasswordAuth: true},
+			expectError: true,
+			contains:    "password auth is disabled",
+		},
+		{
+			name:        "short password",
+			vm:          models.VMConfig{Cpu: 2, Ram: 4, Os: "fedora", Password: &short},
+			expectError: true,
+			contains:    "at least 8 characters",
+		},
+		{
+			name:        "credentials with custom cloud-init",
+			vm:          models.VMConfig{Cpu: 2, Ram: 4, Os: "fedora", Username: "admin", CloudInitUserData: &inline},
+			expectError: true,
+			contains:    "cannot be combined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateVMCredentials(&tt.vm)
+
+			if !tt.expectError {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+}
+
+func TestVMGuestVCPUs(t *testing.T) {
+	// Legacy cores-only topology and the hotpluggable sockets topology both
+	// count the same guest vCPUs
+	assert.Equal(t, 2, vmGuestVCPUs(&kubevirtv1.CPU{Cores: 2}))
+	assert.Equal(t, 2, vmGuestVCPUs(&kubevirtv1.CPU{Sockets: 2, Cores: 1, Threads: 1}))
+	assert.Equal(t, 8, vmGuestVCPUs(&kubevirtv1.CPU{Sockets: 4, Cores: 2}))
+}
+
+func TestVMRestartRequired(t *testing.T) {
+	vm := &kubevirtv1.VirtualMachine{}
+	assert.False(t, vmRestartRequired(vm))
+
+	vm.Status.Conditions = []kubevirtv1.VirtualMachineCondition{
+		{Type: kubevirtv1.VirtualMachineRestartRequired, Status: corev1.ConditionTrue},
+	}
+	assert.True(t, vmRestartRequired(vm))
+
+	vm.Status.Conditions[0].Status = corev1.ConditionFalse
+	assert.False(t, vmRestartRequired(vm))
+}
+
+func TestVMIRuntimeStatus(t *testing.T) {
+	vmi := &kubevirtv1.VirtualMachineInstance{
+		Status: kubevirtv1.VirtualMachineInstanceStatus{
+			NodeName: "worker-1",
+			Interfaces: []kubevirtv1.VirtualMachineInstanceNetworkInterface{
+				{Name: "default", IP: "10.244.1.5", IPs: []string{"10.244.1.5"}, MAC: "52:54:00:aa:bb:cc"},
+				{Name: "net1", IP: "192.168.10.20"},
+			},
+			GuestOSInfo: kubevirtv1.VirtualMachineInstanceGuestOSInfo{
+				Name:          "Fedora Linux",
+				Version:       "40",
+				PrettyName:    "Fedora Linux 40 (Cloud Edition)",
+				KernelRelease: "6.8.5-301.fc40.x86_64",
+			},
+			Conditions: []kubevirtv1.VirtualMachineInstanceCondition{
+				{Type: kubevirtv1.VirtualMachineInstanceIsMigratable, Status: corev1.ConditionTrue},
+				{Type: kubevirtv1.VirtualMachineInstanceReady, Status: corev1.ConditionTrue},
+				{Type: kubevirtv1.VirtualMachineInstanceAgentConnected, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+
+	runtime, conditions := vmiRuntimeStatus(vmi)
+
+	assert.Equal(t, "worker-1", runtime.Node)
+	assert.Len(t, runtime.Interfaces, 2)
+	assert.Equal(t, "10.244.1.5", runtime.Interfaces[0].IP)
+	assert.Equal(t, "52:54:00:aa:bb:cc", runtime.Interfaces[0].Mac)
+	assert.Equal(t, "net1", runtime.Interfaces[1].Name)
+	assert.Equal(t, "Fedora Linux 40 (Cloud Edition)", runtime.GuestOS)
+	assert.Equal(t, "6.8.5-301.fc40.x86_64", runtime.KernelVersion)
+	assert.True(t, runtime.GuestAgentConnected)
+
+	// Only AgentConnected is surfaced; Ready is mirrored onto the VM and the
+	// scheduling internals like migratability stay hidden
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, "AgentConnected", conditions[0].Type)
+
+	// Without the guest agent only the name/version pair is known
+	vmi.Status.GuestOSInfo = kubevirtv1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux", Version: "40"}
+	runtime, _ = vmiRuntimeStatus(vmi)
+	assert.Equal(t, "Fedora Linux 40", runtime.GuestOS)
+}
+
+func TestGetVMPhase(t *testing.T) {
+	service := &VMService{}
+
+	tests := []struct {
+		name     string
+		status   kubevirtv1.VirtualMachineStatus
+		expected models.DeploymentPhase
+	}{
+		{
+			name:     "provisioning",
+			status:   kubevirtv1.VirtualMachineStatus{PrintableStatus: kubevirtv1.VirtualMachineStatusProvisioning},
+			expected: models.DeploymentPhaseProvisioning,
+		},
+		{
+			name:     "volume binding counts as provisioning",
+			status:   kubevirtv1.VirtualMachineStatus{PrintableStatus: kubevirtv1.VirtualMachineStatusWaitingForVolumeBinding},
+			expected: models.DeploymentPhaseProvisioning,
+		},
+		{
+			name:     "starting",
+			status:   kubevirtv1.VirtualMachineStatus{PrintableStatus: kubevirtv1.VirtualMachineStatusStarting},
+			expected: models.DeploymentPhaseStarting,
+		},
+		{
+			name:     "migrating",
+			status:   kubevirtv1.VirtualMachineStatus{PrintableStatus: kubevirtv1.VirtualMachineStatusMigrating},
+			expected: models.DeploymentPhaseMigrating,
+		},
+		{
+			name:     "stopping",
+			status:   kubevirtv1.VirtualMachineStatus{PrintableStatus: kubevirtv1.VirtualMachineStatusStopping},
+			expected: models.DeploymentPhaseStopping,
+		},
+		{
+			name:     "stopped",
+			status:   kubevirtv1.VirtualMachineStatus{PrintableStatus: kubevirtv1.VirtualMachineStatusStopped},
+			expected: models.DeploymentPhaseStopped,
+		},
+		{
+			name:     "crash looping virt-launcher",
+			status:   kubevirtv1.VirtualMachineStatus{PrintableStatus: kubevirtv1.VirtualMachineStatusCrashLoopBackOff},
+			expected: models.DeploymentPhaseCrashLoop,
+		},
+		{
+			name:     "unschedulable is failed",
+			status:   kubevirtv1.VirtualMachineStatus{PrintableStatus: kubevirtv1.VirtualMachineStatusUnschedulable},
+			expected: models.DeploymentPhaseFailed,
+		},
+		{
+			name:     "running",
+			status:   kubevirtv1.VirtualMachineStatus{PrintableStatus: kubevirtv1.VirtualMachineStatusRunning},
+			expected: models.DeploymentPhaseRunning,
+		},
+		{
+			name: "paused condition wins over printable status",
+			status: kubevirtv1.VirtualMachineStatus{
+				PrintableStatus: kubevirtv1.VirtualMachineStatusRunning,
+				Conditions: []kubevirtv1.VirtualMachineCondition{
+					{Type: kubevirtv1.VirtualMachinePaused, Status: corev1.ConditionTrue},
+				},
+			},
+			expected: models.DeploymentPhasePaused,
+		},
+		{
+			name:     "no printable status falls back to readiness",
+			status:   kubevirtv1.VirtualMachineStatus{Ready: true},
+			expected: models.DeploymentPhaseRunning,
+		},
+		{
+			name:     "no status at all is pending",
+			status:   kubevirtv1.VirtualMachineStatus{},
+			expected: models.DeploymentPhasePending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vm := &kubevirtv1.VirtualMachine{Status: tt.status}
+			assert.Equal(t, tt.expected, service.getVMPhase(vm))
+		})
+	}
+}
+
+func TestVMStatusMessage(t *testing.T) {
+	vm := &kubevirtv1.VirtualMachine{}
+	assert.Empty(t, vmStatusMessage(vm))
+
+	vm.Status.Conditions = []kubevirtv1.VirtualMachineCondition{
+		{Type: kubevirtv1.VirtualMachineReady, Status: corev1.ConditionFalse, Message: "Guest VM is not reported as running"},
+	}
+	assert.Equal(t, "Guest VM is not reported as running", vmStatusMessage(vm))
+
+	// A failure condition explains more than an unready one
+	vm.Status.Conditions = append(vm.Status.Conditions, kubevirtv1.VirtualMachineCondition{
+		Type: kubevirtv1.VirtualMachineFailure, Status: corev1.ConditionTrue, Message: "virt-launcher pod failed",
+	})
+	assert.Equal(t, "virt-launcher pod failed", vmStatusMessage(vm))
+
+	vm.Status.Conditions = []kubevirtv1.VirtualMachineCondition{
+		{Type: kubevirtv1.VirtualMachineReady, Status: corev1.ConditionTrue},
+	}
+	assert.Empty(t, vmStatusMessage(vm))
+}
+
+func TestVMConditions(t *testing.T) {
+	now := metav1.Now()
+	vm := &kubevirtv1.VirtualMachine{
+		Status: kubevirtv1.VirtualMachineStatus{
+			Conditions: []kubevirtv1.VirtualMachineCondition{
+				{
+					Type:               kubevirtv1.VirtualMachineReady,
+					Status:             corev1.ConditionFalse,
+					LastTransitionTime: now,
+					Reason:             "GuestNotRunning",
+					Message:            "Guest VM is not reported as running",
+				},
+				{
+					Type:   kubevirtv1.VirtualMachineRestartRequired,
+					Status: corev1.ConditionTrue,
+					Reason: "CPU",
+				},
+			},
+		},
+	}
+
+	conditions := vmConditions(vm)
+	assert.Len(t, conditions, 2)
+	assert.Equal(t, "Ready", conditions[0].Type)
+	assert.Equal(t, "False", conditions[0].Status)
+	assert.Equal(t, now.Time, conditions[0].LastTransitionTime)
+	assert.Equal(t, "GuestNotRunning", conditions[0].Reason)
+	assert.Equal(t, "Guest VM is not reported as running", conditions[0].Message)
+	assert.Equal(t, "RestartRequired", conditions[1].Type)
+
+	assert.Empty(t, vmConditions(&kubevirtv1.VirtualMachine{}))
+}
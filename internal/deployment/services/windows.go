@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// virtioWinImage carries the virtio-win driver ISO attached to Windows
+// guests so they can install the paravirtual disk and network drivers
+const virtioWinImage = "quay.io/kubevirt/virtio-container-disk:latest"
+
+// rdpPort is the standard Remote Desktop port exposed for Windows guests
+const rdpPort = 3389
+
+// isWindowsGuest reports whether the OS names a Windows guest. Versioned
+// names like "windows2022" select the same treatment.
+func isWindowsGuest(os string) bool {
+	return strings.HasPrefix(strings.ToLower(os), "windows")
+}
+
+// validateWindowsConfig rejects spec combinations the Windows path cannot
+// honor. Windows guests read no cloud-init, so the Linux-only credential and
+// cloud-init fields are refused, and they boot from a prepared image rather
+// than a public containerdisk, so a sourced root disk is required.
+func validateWindowsConfig(vm *models.VMConfig) error {
+	if !isWindowsGuest(vm.Os) {
+		if vm.SysprepConfigMap != nil {
+			return fmt.Errorf("sysprepConfigMap is only supported for Windows guests")
+		}
+		return nil
+	}
+
+	if vm.CloudInitUserData != nil || vm.CloudInitSecret != nil || vm.CloudInitConfigMap != nil {
+		return fmt.Errorf("cloud-init sources are not supported for Windows guests, use sysprepConfigMap")
+	}
+	if vm.Username != "" || vm.Password != nil || vm.DisablePasswordAuth {
+		return fmt.Errorf("guest credentials are not supported for Windows guests, set them through sysprepConfigMap")
+	}
+	if vm.SshPublicKey != nil || vm.SshKeyName != nil {
+		return fmt.Errorf("SSH key injection is not supported for Windows guests")
+	}
+	if vm.RootDisk == nil || vm.RootDisk.SourceImage == "" {
+		return fmt.Errorf("Windows guests require a rootDisk with a sourceImage pointing at a prepared Windows image")
+	}
+	return nil
+}
+
+// applyWindowsGuest reshapes a built VirtualMachine for a Windows guest:
+// EFI firmware with SecureBoot, Hyper-V enlightenments and Windows clock
+// sources, a virtio-win driver disk, and an optional Sysprep answer disk in
+// place of the Linux cloud-init volume. The deployment labels are mirrored
+// onto the instance template so the RDP Service can select the guest's pod.
+func applyWindowsGuest(vm *kubevirtv1.VirtualMachine, vmConfig *models.VMConfig) {
+	spec := &vm.Spec.Template.Spec
+	spec.Domain.Devices.Disks = spec.Domain.Devices.Disks[:1]
+	spec.Volumes = spec.Volumes[:1]
+
+	vm.Spec.Template.ObjectMeta.Labels = vm.Labels
+
+	spec.Domain.Firmware = &kubevirtv1.Firmware{
+		Bootloader: &kubevirtv1.Bootloader{
+			EFI: &kubevirtv1.EFI{SecureBoot: ptr.To(true)},
+		},
+	}
+	spec.Domain.Features.Hyperv = &kubevirtv1.FeatureHyperv{
+		Relaxed: &kubevirtv1.FeatureState{Enabled: ptr.To(true)},
+		VAPIC:   &kubevirtv1.FeatureState{Enabled: ptr.To(true)},
+		Spinlocks: &kubevirtv1.FeatureSpinlocks{
+			Enabled: ptr.To(true),
+			Retries: ptr.To(uint32(8191)),
+		},
+	}
+	spec.Domain.Clock = &kubevirtv1.Clock{
+		ClockOffset: kubevirtv1.ClockOffset{UTC: &kubevirtv1.ClockOffsetUTC{}},
+		Timer: &kubevirtv1.Timer{
+			HPET:   &kubevirtv1.HPETTimer{Enabled: ptr.To(false)},
+			PIT:    &kubevirtv1.PITTimer{TickPolicy: kubevirtv1.PITTickPolicyDelay},
+			RTC:    &kubevirtv1.RTCTimer{TickPolicy: kubevirtv1.RTCTickPolicyCatchup},
+			Hyperv: &kubevirtv1.HypervTimer{},
+		},
+	}
+
+	spec.Domain.Devices.Disks = append(spec.Domain.Devices.Disks, kubevirtv1.Disk{
+		Name: "virtiocontainerdisk",
+		DiskDevice: kubevirtv1.DiskDevice{
+			CDRom: &kubevirtv1.CDRomTarget{Bus: kubevirtv1.DiskBusSATA},
+		},
+	})
+	spec.Volumes = append(spec.Volumes, kubevirtv1.Volume{
+		Name: "virtiocontainerdisk",
+		VolumeSource: kubevirtv1.VolumeSource{
+			ContainerDisk: &kubevirtv1.ContainerDiskSource{Image: virtioWinImage},
+		},
+	})
+
+	if vmConfig.SysprepConfigMap != nil {
+		spec.Domain.Devices.Disks = append(spec.Domain.Devices.Disks, kubevirtv1.Disk{
+			Name: "sysprepdisk",
+			DiskDevice: kubevirtv1.DiskDevice{
+				CDRom: &kubevirtv1.CDRomTarget{Bus: kubevirtv1.DiskBusSATA},
+			},
+		})
+		spec.Volumes = append(spec.Volumes, kubevirtv1.Volume{
+			Name: "sysprepdisk",
+			VolumeSource: kubevirtv1.VolumeSource{
+				Sysprep: &kubevirtv1.SysprepSource{
+					ConfigMap: &corev1.LocalObjectReference{Name: *vmConfig.SysprepConfigMap},
+				},
+			},
+		})
+	}
+}
+
+// createRDPService exposes a Windows guest's RDP port through a NodePort
+// Service owned by the VirtualMachine, so it is garbage-collected with it
+func (v *VMService) createRDPService(ctx context.Context, namespace, name, id string, vm *kubevirtv1.VirtualMachine) error {
+	return v.createVMAccessService(ctx, namespace, name, id, "rdp", rdpPort, corev1.ServiceTypeNodePort, vm)
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+func TestValidateWindowsConfig(t *testing.T) {
+	windowsDisk := &models.VMDiskConfig{Size: "60Gi", SourceImage: "registry.example.com/images/windows2022:latest"}
+
+	tests := []struct {
+		name    string
+		vm      models.VMConfig
+		wantErr string
+	}{
+		{
+			name: "valid windows guest",
+			vm:   models.VMConfig{Os: "windows2022", Ram: 8, Cpu: 4, RootDisk: windowsDisk},
+		},
+		{
+			name:    "windows requires a sourced root disk",
+			vm:      models.VMConfig{Os: "windows2022", Ram: 8, Cpu: 4},
+			wantErr: "rootDisk with a sourceImage",
+		},
+		{
+			name:    "windows rejects cloud-init",
+			vm:      models.VMConfig{Os: "windows2022", Ram: 8, Cpu: 4, RootDisk: windowsDisk, CloudInitUserData: ptr.To("#cloud-config")},
+			wantErr: "cloud-init sources are not supported",
+		},
+		{
+			name:    "windows rejects guest credentials",
+			vm:      models.VMConfig{Os: "windows2022", Ram: 8, Cpu: 4, RootDisk: windowsDisk, Username: "admin"},
+			wantErr: "guest credentials are not supported",
+		},
+		{
+			name:    "windows rejects SSH keys",
+			vm:      models.VMConfig{Os: "windows2022", Ram: 8, Cpu: 4, RootDisk: windowsDisk, SshPublicKey: ptr.To("ssh-ed25519 AAAA")},
+			wantErr: "SSH key injection is not supported",
+		},
+		{
+			name:    "sysprep is windows-only",
+			vm:      models.VMConfig{Os: "fedora", Ram: 2, Cpu: 1, SysprepConfigMap: ptr.To("answers")},
+			wantErr: "only supported for Windows guests",
+		},
+		{
+			name: "linux guest untouched",
+			vm:   models.VMConfig{Os: "fedora", Ram: 2, Cpu: 1, Username: "admin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateWindowsConfig(&tt.vm)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildVirtualMachineWindows(t *testing.T) {
+	vmConfig := &models.VMConfig{
+		Os:  "windows2022",
+		Ram: 8,
+		Cpu: 4,
+		RootDisk: &models.VMDiskConfig{
+			Size:        "60Gi",
+			SourceImage: "registry.example.com/images/windows2022:latest",
+		},
+		SysprepConfigMap: ptr.To("win-answers"),
+	}
+	labels := models.BuildDeploymentLabels("test-id", "winbox")
+
+	vm := buildVirtualMachine("winbox", "default", vmConfig, labels, map[string]string{}, "", "")
+	spec := vm.Spec.Template.Spec
+
+	// The Linux cloud-init disk is replaced by virtio-win drivers and Sysprep
+	require.Len(t, spec.Domain.Devices.Disks, 3)
+	assert.Equal(t, "winbox-disk", spec.Domain.Devices.Disks[0].Name)
+	assert.Equal(t, "virtiocontainerdisk", spec.Domain.Devices.Disks[1].Name)
+	require.NotNil(t, spec.Domain.Devices.Disks[1].CDRom)
+	assert.Equal(t, "sysprepdisk", spec.Domain.Devices.Disks[2].Name)
+
+	require.Len(t, spec.Volumes, 3)
+	require.NotNil(t, spec.Volumes[0].DataVolume, "root disk must come from the sourced DataVolume")
+	assert.Equal(t, virtioWinImage, spec.Volumes[1].ContainerDisk.Image)
+	require.NotNil(t, spec.Volumes[2].Sysprep)
+	assert.Equal(t, "win-answers", spec.Volumes[2].Sysprep.ConfigMap.Name)
+
+	// EFI SecureBoot firmware and Hyper-V enlightenments
+	require.NotNil(t, spec.Domain.Firmware)
+	require.NotNil(t, spec.Domain.Firmware.Bootloader.EFI)
+	assert.True(t, *spec.Domain.Firmware.Bootloader.EFI.SecureBoot)
+	require.NotNil(t, spec.Domain.Features.Hyperv)
+	assert.True(t, *spec.Domain.Features.Hyperv.Relaxed.Enabled)
+	require.NotNil(t, spec.Domain.Clock)
+	assert.False(t, *spec.Domain.Clock.Timer.HPET.Enabled)
+
+	// The instance template carries the deployment labels for the RDP Service
+	assert.Equal(t, labels, vm.Spec.Template.ObjectMeta.Labels)
+}
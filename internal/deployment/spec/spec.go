@@ -0,0 +1,137 @@
+// Package spec persists deployment specs on their primary Kubernetes objects
+// and migrates older persisted shapes forward as the request models evolve,
+// so changing a spec's shape does not break previously created deployments.
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// CurrentVersion is the schema version new specs are persisted at. Bump it
+// together with a registered migration whenever a persisted spec shape
+// changes incompatibly.
+const CurrentVersion = 1
+
+// Migration upgrades a persisted spec by exactly one schema version. It
+// receives and returns the decoded JSON document so migrations do not depend
+// on the current Go models.
+type Migration func(raw map[string]interface{}) (map[string]interface{}, error)
+
+// Registry holds the migrations that upgrade persisted specs between schema
+// versions. Each migration is registered under the version it upgrades from.
+type Registry struct {
+	migrations map[int]Migration
+}
+
+// NewRegistry creates an empty migration registry
+func NewRegistry() *Registry {
+	return &Registry{migrations: make(map[int]Migration)}
+}
+
+// Register adds the migration from the given version to the next one.
+// Registering two migrations for the same version is a programming error.
+func (r *Registry) Register(from int, migration Migration) error {
+	if _, exists := r.migrations[from]; exists {
+		return fmt.Errorf("migration from spec version %d is already registered", from)
+	}
+	r.migrations[from] = migration
+	return nil
+}
+
+// Migrate upgrades a persisted spec from the given version to CurrentVersion,
+// applying the registered migrations in order. A missing step is an error:
+// the spec cannot be interpreted safely.
+func (r *Registry) Migrate(raw map[string]interface{}, from int) (map[string]interface{}, error) {
+	return r.migrateTo(raw, from, CurrentVersion)
+}
+
+// migrateTo applies the migration chain between two explicit versions
+func (r *Registry) migrateTo(raw map[string]interface{}, from, to int) (map[string]interface{}, error) {
+	if from > to {
+		return nil, fmt.Errorf("persisted spec version %d is newer than the supported version %d", from, to)
+	}
+	for version := from; version < to; version++ {
+		migration, ok := r.migrations[version]
+		if !ok {
+			return nil, fmt.Errorf("no migration registered from spec version %d", version)
+		}
+		migrated, err := migration(raw)
+		if err != nil {
+			return nil, fmt.Errorf("migrating spec from version %d failed: %w", version, err)
+		}
+		raw = migrated
+	}
+	return raw, nil
+}
+
+// Default is the registry consulted by FromAnnotations. Future schema
+// migrations register here in an init function next to the model change.
+var Default = NewRegistry()
+
+// Persist stores the deployment spec and its schema version in the
+// annotations that go onto the primary Kubernetes object. Credential fields
+// are scrubbed first: annotations are world-readable to anyone who can get
+// the object.
+func Persist(annotations map[string]string, deploymentSpec interface{}) error {
+	if deploymentSpec == nil {
+		return nil
+	}
+
+	encoded, err := json.Marshal(deploymentSpec)
+	if err != nil {
+		return fmt.Errorf("failed to encode spec for persistence: %w", err)
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(encoded, &raw); err != nil {
+		return fmt.Errorf("failed to decode spec for persistence: %w", err)
+	}
+	scrubCredentials(raw)
+
+	scrubbed, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("failed to encode spec for persistence: %w", err)
+	}
+
+	annotations[models.AnnotationSpec] = string(scrubbed)
+	annotations[models.AnnotationSpecVersion] = strconv.Itoa(CurrentVersion)
+	return nil
+}
+
+// FromAnnotations reads the persisted spec from resource annotations,
+// migrated to the current schema version. Resources without a persisted spec
+// (created before persistence existed) yield nil without an error.
+func FromAnnotations(annotations map[string]string) (map[string]interface{}, error) {
+	encoded, ok := annotations[models.AnnotationSpec]
+	if !ok {
+		return nil, nil
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(encoded), &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode persisted spec: %w", err)
+	}
+
+	// Specs persisted before versioning are treated as version 1
+	version := 1
+	if value, ok := annotations[models.AnnotationSpecVersion]; ok {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid persisted spec version %q", value)
+		}
+		version = parsed
+	}
+
+	return Default.Migrate(raw, version)
+}
+
+// scrubCredentials removes credential material from a decoded spec before it
+// is persisted as an annotation
+func scrubCredentials(raw map[string]interface{}) {
+	if vm, ok := raw["vm"].(map[string]interface{}); ok {
+		delete(vm, "password")
+	}
+}
@@ -0,0 +1,127 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestRegistryMigratesThroughChain(t *testing.T) {
+	registry := NewRegistry()
+	require.NoError(t, registry.Register(1, func(raw map[string]interface{}) (map[string]interface{}, error) {
+		// v1 -> v2: rename a field the way a real model change would
+		raw["memoryGB"] = raw["ram"]
+		delete(raw, "ram")
+		return raw, nil
+	}))
+
+	migrated, err := registry.migrateTo(map[string]interface{}{"ram": 4.0}, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 4.0, migrated["memoryGB"])
+	assert.NotContains(t, migrated, "ram")
+}
+
+func TestRegistryMigrateIsNoopAtTargetVersion(t *testing.T) {
+	registry := NewRegistry()
+
+	raw := map[string]interface{}{"cpu": 2.0}
+	migrated, err := registry.migrateTo(raw, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, raw, migrated)
+}
+
+func TestRegistryMigrateMissingStep(t *testing.T) {
+	registry := NewRegistry()
+
+	_, err := registry.migrateTo(map[string]interface{}{}, 1, 3)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no migration registered from spec version 1")
+}
+
+func TestRegistryMigrateNewerThanSupported(t *testing.T) {
+	registry := NewRegistry()
+
+	_, err := registry.migrateTo(map[string]interface{}{}, 3, 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "newer than the supported version")
+}
+
+func TestRegistryRejectsDuplicateMigration(t *testing.T) {
+	registry := NewRegistry()
+	migration := func(raw map[string]interface{}) (map[string]interface{}, error) { return raw, nil }
+
+	require.NoError(t, registry.Register(1, migration))
+	assert.Error(t, registry.Register(1, migration))
+}
+
+func TestPersistRoundTrip(t *testing.T) {
+	annotations := map[string]string{}
+	deploymentSpec := models.ContainerSpec{
+		Container: models.ContainerConfig{
+			Image: "nginx:latest",
+			Ports: []models.PortConfig{{ContainerPort: 80}},
+		},
+	}
+
+	require.NoError(t, Persist(annotations, deploymentSpec))
+	assert.Equal(t, "1", annotations[models.AnnotationSpecVersion])
+
+	persisted, err := FromAnnotations(annotations)
+	require.NoError(t, err)
+	require.NotNil(t, persisted)
+
+	container, ok := persisted["container"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "nginx:latest", container["image"])
+}
+
+func TestPersistScrubsVMPassword(t *testing.T) {
+	annotations := map[string]string{}
+	deploymentSpec := models.VMSpec{
+		VM: models.VMConfig{
+			Os:       "fedora",
+			Username: "admin",
+			Password: ptr.To("hunter2"),
+		},
+	}
+
+	require.NoError(t, Persist(annotations, deploymentSpec))
+	assert.NotContains(t, annotations[models.AnnotationSpec], "hunter2")
+
+	persisted, err := FromAnnotations(annotations)
+	require.NoError(t, err)
+
+	vm, ok := persisted["vm"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "admin", vm["username"])
+	assert.NotContains(t, vm, "password")
+}
+
+func TestFromAnnotationsWithoutPersistedSpec(t *testing.T) {
+	persisted, err := FromAnnotations(map[string]string{})
+	require.NoError(t, err)
+	assert.Nil(t, persisted)
+}
+
+func TestFromAnnotationsTreatsUnversionedAsV1(t *testing.T) {
+	annotations := map[string]string{
+		models.AnnotationSpec: `{"container":{"image":"nginx"}}`,
+	}
+
+	persisted, err := FromAnnotations(annotations)
+	require.NoError(t, err)
+	require.NotNil(t, persisted)
+}
+
+func TestFromAnnotationsRejectsBadVersion(t *testing.T) {
+	annotations := map[string]string{
+		models.AnnotationSpec:        `{}`,
+		models.AnnotationSpecVersion: "two",
+	}
+
+	_, err := FromAnnotations(annotations)
+	assert.Error(t, err)
+}
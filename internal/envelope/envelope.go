@@ -0,0 +1,147 @@
+// Package envelope provides an opt-in response envelope that wraps JSON
+// responses with consistent metadata (request ID, warnings, deprecations).
+// Clients negotiate it per request via the X-Envelope header, so existing
+// consumers keep receiving the bare payloads.
+package envelope
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Headers used by the envelope negotiation
+const (
+	// HeaderEnvelope opts a request into the response envelope when set to "true"
+	HeaderEnvelope = "X-Envelope"
+	// HeaderRequestID carries the request ID; incoming values are echoed back
+	HeaderRequestID = "X-Request-Id"
+)
+
+// Envelope is the uniform response wrapper returned when a client opts in
+type Envelope struct {
+	Data         json.RawMessage `json:"data"`
+	RequestID    string          `json:"requestId"`
+	Warnings     []string        `json:"warnings,omitempty"`
+	Deprecations []string        `json:"deprecations,omitempty"`
+}
+
+// Requested reports whether the client opted into the response envelope
+func Requested(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get(HeaderEnvelope), "true")
+}
+
+// Middleware assigns each request an ID and, when the client opted in, wraps
+// JSON responses in the envelope. Non-JSON responses (event streams, log
+// streams, WebSocket upgrades) pass through untouched.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(HeaderRequestID)
+		if requestID == "" {
+			requestID = uuid.New().String()
+		}
+		w.Header().Set(HeaderRequestID, requestID)
+
+		if !Requested(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		recorder := &jsonRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		if recorder.passthrough || !recorder.wroteHeader {
+			return
+		}
+
+		data := recorder.body.Bytes()
+		if len(data) == 0 {
+			data = []byte("null")
+		}
+
+		env := Envelope{
+			Data:      data,
+			RequestID: requestID,
+			Warnings:  w.Header().Values("Warning"),
+		}
+		if deprecation := w.Header().Get("Deprecation"); deprecation != "" {
+			env.Deprecations = append(env.Deprecations, deprecation)
+		}
+		if sunset := w.Header().Get("Sunset"); sunset != "" {
+			env.Deprecations = append(env.Deprecations, fmt.Sprintf("sunset: %s", sunset))
+		}
+
+		payload, err := json.Marshal(env)
+		if err != nil {
+			// Fall back to the original payload rather than failing the request
+			w.WriteHeader(recorder.status)
+			_, _ = w.Write(recorder.body.Bytes())
+			return
+		}
+
+		w.Header().Set("Content-Length", strconv.Itoa(len(payload)))
+		w.WriteHeader(recorder.status)
+		_, _ = w.Write(payload)
+	})
+}
+
+// jsonRecorder buffers JSON response bodies so they can be wrapped after the
+// handler finishes. Responses with other content types switch to passthrough
+// on the first write, preserving streaming semantics.
+type jsonRecorder struct {
+	http.ResponseWriter
+	body        bytes.Buffer
+	status      int
+	wroteHeader bool
+	passthrough bool
+}
+
+func (r *jsonRecorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+	r.status = status
+
+	contentType := r.Header().Get("Content-Type")
+	if !strings.HasPrefix(contentType, "application/json") || status == http.StatusNoContent {
+		r.passthrough = true
+		r.ResponseWriter.WriteHeader(status)
+	}
+	// JSON responses defer the header until the envelope is assembled
+}
+
+func (r *jsonRecorder) Write(data []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	if r.passthrough {
+		return r.ResponseWriter.Write(data)
+	}
+	return r.body.Write(data)
+}
+
+// Flush forwards flushes for streaming responses; buffered JSON bodies are
+// flushed once the envelope is written
+func (r *jsonRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok && r.passthrough {
+		flusher.Flush()
+	}
+}
+
+// Hijack hands the connection over for WebSocket upgrades, bypassing the envelope
+func (r *jsonRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer does not support hijacking")
+	}
+	r.passthrough = true
+	return hijacker.Hijack()
+}
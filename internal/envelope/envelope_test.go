@@ -0,0 +1,110 @@
+package envelope
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func jsonHandler(status int, body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+func TestMiddlewareWrapsJSONWhenRequested(t *testing.T) {
+	handler := Middleware(jsonHandler(http.StatusOK, `{"id":"abc"}`))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments/abc", nil)
+	req.Header.Set(HeaderEnvelope, "true")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var env Envelope
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &env))
+	assert.JSONEq(t, `{"id":"abc"}`, string(env.Data))
+	assert.NotEmpty(t, env.RequestID)
+	assert.Equal(t, env.RequestID, recorder.Header().Get(HeaderRequestID))
+}
+
+func TestMiddlewarePreservesStatusCode(t *testing.T) {
+	handler := Middleware(jsonHandler(http.StatusNotFound, `{"code":"DEPLOYMENT_NOT_FOUND"}`))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments/missing", nil)
+	req.Header.Set(HeaderEnvelope, "true")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var env Envelope
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &env))
+	assert.JSONEq(t, `{"code":"DEPLOYMENT_NOT_FOUND"}`, string(env.Data))
+}
+
+func TestMiddlewareWithoutOptInPassesThrough(t *testing.T) {
+	handler := Middleware(jsonHandler(http.StatusOK, `{"id":"abc"}`))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments/abc", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.JSONEq(t, `{"id":"abc"}`, recorder.Body.String())
+	assert.NotEmpty(t, recorder.Header().Get(HeaderRequestID), "request ID header is always assigned")
+}
+
+func TestMiddlewareEchoesIncomingRequestID(t *testing.T) {
+	handler := Middleware(jsonHandler(http.StatusOK, `{}`))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)
+	req.Header.Set(HeaderEnvelope, "true")
+	req.Header.Set(HeaderRequestID, "client-supplied-id")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	var env Envelope
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &env))
+	assert.Equal(t, "client-supplied-id", env.RequestID)
+}
+
+func TestMiddlewareSkipsNonJSONResponses(t *testing.T) {
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("raw log line\n"))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments/abc/logs", nil)
+	req.Header.Set(HeaderEnvelope, "true")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, "raw log line\n", recorder.Body.String())
+}
+
+func TestMiddlewareCollectsWarningAndDeprecationHeaders(t *testing.T) {
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Add("Warning", `299 - "limit capped to 100"`)
+		w.Header().Set("Deprecation", "true")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+	req.Header.Set(HeaderEnvelope, "true")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	var env Envelope
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &env))
+	assert.Equal(t, []string{`299 - "limit capped to 100"`}, env.Warnings)
+	assert.Equal(t, []string{"true"}, env.Deprecations)
+}
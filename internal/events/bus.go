@@ -0,0 +1,84 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// EventType represents the type of a deployment lifecycle event
+type EventType string
+
+const (
+	EventTypeCreated      EventType = "created"
+	EventTypeUpdated      EventType = "updated"
+	EventTypeDeleted      EventType = "deleted"
+	EventTypePhaseChanged EventType = "phase-changed"
+)
+
+// Event represents a deployment lifecycle event published on the bus
+type Event struct {
+	Type         EventType `json:"type"`
+	DeploymentID string    `json:"deploymentId"`
+	Kind         string    `json:"kind,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	Namespace    string    `json:"namespace,omitempty"`
+	Phase        string    `json:"phase,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// BusInterface defines the interface for the event bus
+type BusInterface interface {
+	Publish(event Event)
+	Subscribe(ctx context.Context) <-chan Event
+}
+
+// Bus is an in-memory publish/subscribe event bus safe for concurrent use.
+// Publishing never blocks; events are dropped for subscribers that cannot keep up.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers map[chan Event]struct{}
+}
+
+// NewBus creates a new in-memory event bus
+func NewBus() *Bus {
+	return &Bus{
+		subscribers: make(map[chan Event]struct{}),
+	}
+}
+
+// Publish sends an event to all current subscribers, stamping the time if unset
+func (b *Bus) Publish(event Event) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for subscriber := range b.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			// Subscriber is not keeping up; drop the event rather than block publishers
+		}
+	}
+}
+
+// Subscribe registers a new subscriber whose channel is closed when the context is cancelled
+func (b *Bus) Subscribe(ctx context.Context) <-chan Event {
+	subscriber := make(chan Event, 64)
+
+	b.mu.Lock()
+	b.subscribers[subscriber] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers, subscriber)
+		b.mu.Unlock()
+		close(subscriber)
+	}()
+
+	return subscriber
+}
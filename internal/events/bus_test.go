@@ -0,0 +1,68 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBusPublishSubscribe(t *testing.T) {
+	bus := NewBus()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subscription := bus.Subscribe(ctx)
+	bus.Publish(Event{Type: EventTypeCreated, DeploymentID: "abc"})
+
+	select {
+	case event := <-subscription:
+		assert.Equal(t, EventTypeCreated, event.Type)
+		assert.Equal(t, "abc", event.DeploymentID)
+		assert.False(t, event.Timestamp.IsZero())
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestBusSubscriptionClosedOnCancel(t *testing.T) {
+	bus := NewBus()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	subscription := bus.Subscribe(ctx)
+	cancel()
+
+	select {
+	case _, ok := <-subscription:
+		assert.False(t, ok, "channel should be closed after context cancellation")
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for channel close")
+	}
+
+	// Publishing after the subscriber is gone must not panic or block
+	bus.Publish(Event{Type: EventTypeDeleted, DeploymentID: "abc"})
+}
+
+func TestBusDropsEventsForSlowSubscribers(t *testing.T) {
+	bus := NewBus()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bus.Subscribe(ctx)
+
+	// Fill well past the subscriber buffer; Publish must never block
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 200; i++ {
+			bus.Publish(Event{Type: EventTypeUpdated, DeploymentID: "abc"})
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publish blocked on a slow subscriber")
+	}
+}
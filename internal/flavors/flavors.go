@@ -0,0 +1,116 @@
+// Package flavors defines the VM flavor catalog: named CPU/RAM/disk shapes a
+// VM spec can reference instead of raw numbers. A built-in small/medium/large
+// catalog applies out of the box; operators replace it with a YAML file of
+// their own definitions.
+package flavors
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Flavor is one named VM shape. RAM is in GB per the VM spec; Disk sizes the
+// root disk in Kubernetes quantity notation and is optional — a flavor
+// without a disk keeps the ephemeral containerDisk.
+type Flavor struct {
+	Name        string `json:"name"`
+	CPU         int    `json:"cpu"`
+	RAM         int    `json:"ram"`
+	Disk        string `json:"disk,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ListResponse wraps the catalog for the flavors endpoint
+type ListResponse struct {
+	Flavors []Flavor `json:"flavors"`
+}
+
+// Catalog holds the loaded flavor definitions, addressable by name
+type Catalog struct {
+	flavors []Flavor
+	byName  map[string]Flavor
+}
+
+// Builtin returns the default small/medium/large catalog used when no
+// operator-provided definitions are configured
+func Builtin() *Catalog {
+	catalog, err := newCatalog([]Flavor{
+		{Name: "small", CPU: 1, RAM: 2, Disk: "10Gi", Description: "1 vCPU, 2 GB RAM, 10 Gi root disk"},
+		{Name: "medium", CPU: 2, RAM: 4, Disk: "20Gi", Description: "2 vCPU, 4 GB RAM, 20 Gi root disk"},
+		{Name: "large", CPU: 4, RAM: 8, Disk: "40Gi", Description: "4 vCPU, 8 GB RAM, 40 Gi root disk"},
+	})
+	if err != nil {
+		// The built-in definitions are static and always valid
+		panic(err)
+	}
+	return catalog
+}
+
+// Load reads flavor definitions from a YAML file, replacing the built-in
+// catalog entirely
+func Load(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- operator-configured path
+	if err != nil {
+		return nil, fmt.Errorf("failed to read flavors file: %w", err)
+	}
+
+	var defined []Flavor
+	if err := yaml.Unmarshal(data, &defined); err != nil {
+		return nil, fmt.Errorf("failed to parse flavors file %s: %w", path, err)
+	}
+	if len(defined) == 0 {
+		return nil, fmt.Errorf("flavors file %s defines no flavors", path)
+	}
+
+	catalog, err := newCatalog(defined)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flavors file %s: %w", path, err)
+	}
+	return catalog, nil
+}
+
+func newCatalog(defined []Flavor) (*Catalog, error) {
+	byName := make(map[string]Flavor, len(defined))
+	for _, flavor := range defined {
+		if flavor.Name == "" {
+			return nil, fmt.Errorf("flavor without a name")
+		}
+		if flavor.CPU <= 0 || flavor.RAM <= 0 {
+			return nil, fmt.Errorf("flavor %s: cpu and ram must be positive", flavor.Name)
+		}
+		if _, exists := byName[flavor.Name]; exists {
+			return nil, fmt.Errorf("duplicate flavor %s", flavor.Name)
+		}
+		byName[flavor.Name] = flavor
+	}
+
+	sorted := make([]Flavor, len(defined))
+	copy(sorted, defined)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	return &Catalog{flavors: sorted, byName: byName}, nil
+}
+
+// List returns the flavors sorted by name
+func (c *Catalog) List() []Flavor {
+	out := make([]Flavor, len(c.flavors))
+	copy(out, c.flavors)
+	return out
+}
+
+// Get returns the named flavor
+func (c *Catalog) Get(name string) (Flavor, bool) {
+	flavor, ok := c.byName[name]
+	return flavor, ok
+}
+
+// Names returns the flavor names sorted alphabetically, for error messages
+func (c *Catalog) Names() []string {
+	names := make([]string, 0, len(c.flavors))
+	for _, flavor := range c.flavors {
+		names = append(names, flavor.Name)
+	}
+	return names
+}
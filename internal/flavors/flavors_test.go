@@ -0,0 +1,60 @@
+package flavors
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltinCatalog(t *testing.T) {
+	catalog := Builtin()
+
+	assert.Equal(t, []string{"large", "medium", "small"}, catalog.Names())
+
+	medium, ok := catalog.Get("medium")
+	require.True(t, ok)
+	assert.Equal(t, 2, medium.CPU)
+	assert.Equal(t, 4, medium.RAM)
+	assert.Equal(t, "20Gi", medium.Disk)
+
+	_, ok = catalog.Get("xlarge")
+	assert.False(t, ok)
+}
+
+func TestLoadReplacesCatalog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flavors.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+- name: tiny
+  cpu: 1
+  ram: 1
+- name: gpu-large
+  cpu: 16
+  ram: 32
+  disk: 100Gi
+  description: GPU node shape
+`), 0o600))
+
+	catalog, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gpu-large", "tiny"}, catalog.Names())
+
+	// Duplicate names are rejected
+	require.NoError(t, os.WriteFile(path, []byte(`
+- name: tiny
+  cpu: 1
+  ram: 1
+- name: tiny
+  cpu: 2
+  ram: 2
+`), 0o600))
+	_, err = Load(path)
+	assert.ErrorContains(t, err, "duplicate flavor")
+
+	// A flavor must have a positive shape
+	require.NoError(t, os.WriteFile(path, []byte("- name: empty\n"), 0o600))
+	_, err = Load(path)
+	assert.ErrorContains(t, err, "cpu and ram must be positive")
+}
@@ -0,0 +1,316 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dcm-project/k8s-service-provider/internal/auth"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/services"
+	providerv1 "github.com/dcm-project/k8s-service-provider/internal/grpc/providerv1"
+	namespaceServices "github.com/dcm-project/k8s-service-provider/internal/namespace/services"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DeploymentServer implements the gRPC DeploymentService on top of the shared services layer
+type DeploymentServer struct {
+	providerv1.UnimplementedDeploymentServiceServer
+	deployService services.DeploymentServiceInterface
+	logger        *zap.Logger
+}
+
+// NamespaceServer implements the gRPC NamespaceService on top of the shared services layer
+type NamespaceServer struct {
+	providerv1.UnimplementedNamespaceServiceServer
+	namespaceService *namespaceServices.NamespaceService
+	logger           *zap.Logger
+}
+
+// NewServer creates a gRPC server with both services registered. The
+// authenticator guards every RPC with the same schemes as the HTTP listeners
+// (nil leaves them open for proxy-authenticated setups), and a non-nil TLS
+// configuration serves the listener with the same certificates and client
+// verification as the HTTP servers.
+func NewServer(deployService services.DeploymentServiceInterface, namespaceService *namespaceServices.NamespaceService, authenticator *auth.Authenticator, tlsConfig *tls.Config, logger *zap.Logger) *grpclib.Server {
+	opts := []grpclib.ServerOption{
+		grpclib.ChainUnaryInterceptor(authUnaryInterceptor(authenticator, logger)),
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpclib.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	server := grpclib.NewServer(opts...)
+	providerv1.RegisterDeploymentServiceServer(server, &DeploymentServer{
+		deployService: deployService,
+		logger:        logger,
+	})
+	providerv1.RegisterNamespaceServiceServer(server, &NamespaceServer{
+		namespaceService: namespaceService,
+		logger:           logger,
+	})
+	return server
+}
+
+// CreateDeployment handles the CreateDeployment RPC
+func (s *DeploymentServer) CreateDeployment(ctx context.Context, req *providerv1.CreateDeploymentRequest) (*providerv1.CreateDeploymentResponse, error) {
+	logger := s.logger.Named("grpc_server").With(zap.String("rpc", "create_deployment"))
+
+	deployReq, err := buildDeploymentRequest(req.GetKind(), req.GetMetadata(), req.GetSpec())
+	if err != nil {
+		logger.Error("Invalid deployment request", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	deploymentID := uuid.New().String()
+	if err := s.deployService.CreateDeployment(ctx, deployReq, deploymentID); err != nil {
+		logger.Error("Failed to create deployment", zap.Error(err))
+		return nil, statusFromError(err)
+	}
+
+	deployment, err := s.deployService.GetDeploymentByID(ctx, deploymentID)
+	if err != nil {
+		logger.Error("Failed to fetch created deployment", zap.Error(err))
+		return nil, statusFromError(err)
+	}
+	deployment.Spec = deployReq.Spec
+
+	logger.Info("Successfully created deployment", zap.String("deployment_id", deploymentID))
+	return &providerv1.CreateDeploymentResponse{Deployment: toProtoDeployment(deployment)}, nil
+}
+
+// GetDeployment handles the GetDeployment RPC
+func (s *DeploymentServer) GetDeployment(ctx context.Context, req *providerv1.GetDeploymentRequest) (*providerv1.GetDeploymentResponse, error) {
+	logger := s.logger.Named("grpc_server").With(zap.String("rpc", "get_deployment"))
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "deployment ID is required")
+	}
+
+	deployment, err := s.deployService.GetDeploymentByID(ctx, req.GetId())
+	if err != nil {
+		logger.Error("Failed to get deployment", zap.Error(err))
+		return nil, statusFromError(err)
+	}
+
+	return &providerv1.GetDeploymentResponse{Deployment: toProtoDeployment(deployment)}, nil
+}
+
+// UpdateDeployment handles the UpdateDeployment RPC
+func (s *DeploymentServer) UpdateDeployment(ctx context.Context, req *providerv1.UpdateDeploymentRequest) (*providerv1.UpdateDeploymentResponse, error) {
+	logger := s.logger.Named("grpc_server").With(zap.String("rpc", "update_deployment"))
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "deployment ID is required")
+	}
+
+	deployReq, err := buildDeploymentRequest(req.GetKind(), req.GetMetadata(), req.GetSpec())
+	if err != nil {
+		logger.Error("Invalid deployment request", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.deployService.UpdateDeployment(ctx, deployReq, req.GetId()); err != nil {
+		logger.Error("Failed to update deployment", zap.Error(err))
+		return nil, statusFromError(err)
+	}
+
+	deployment, err := s.deployService.GetDeploymentByID(ctx, req.GetId())
+	if err != nil {
+		logger.Error("Failed to fetch updated deployment", zap.Error(err))
+		return nil, statusFromError(err)
+	}
+	deployment.Spec = deployReq.Spec
+
+	logger.Info("Successfully updated deployment", zap.String("deployment_id", req.GetId()))
+	return &providerv1.UpdateDeploymentResponse{Deployment: toProtoDeployment(deployment)}, nil
+}
+
+// DeleteDeployment handles the DeleteDeployment RPC
+func (s *DeploymentServer) DeleteDeployment(ctx context.Context, req *providerv1.DeleteDeploymentRequest) (*providerv1.DeleteDeploymentResponse, error) {
+	logger := s.logger.Named("grpc_server").With(zap.String("rpc", "delete_deployment"))
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "deployment ID is required")
+	}
+
+	if err := s.deployService.DeleteDeployment(ctx, req.GetId()); err != nil {
+		logger.Error("Failed to delete deployment", zap.Error(err))
+		return nil, statusFromError(err)
+	}
+
+	logger.Info("Successfully deleted deployment", zap.String("deployment_id", req.GetId()))
+	return &providerv1.DeleteDeploymentResponse{}, nil
+}
+
+// ListDeployments handles the ListDeployments RPC
+func (s *DeploymentServer) ListDeployments(ctx context.Context, req *providerv1.ListDeploymentsRequest) (*providerv1.ListDeploymentsResponse, error) {
+	logger := s.logger.Named("grpc_server").With(zap.String("rpc", "list_deployments"))
+
+	listReq := &models.ListDeploymentsRequest{
+		Namespace: req.GetNamespace(),
+		Kind:      models.DeploymentKind(req.GetKind()),
+		Limit:     int(req.GetLimit()),
+		Offset:    int(req.GetOffset()),
+	}
+	if listReq.Limit == 0 {
+		listReq.Limit = 20
+	}
+
+	response, err := s.deployService.ListDeployments(ctx, listReq)
+	if err != nil {
+		logger.Error("Failed to list deployments", zap.Error(err))
+		return nil, statusFromError(err)
+	}
+
+	deployments := make([]*providerv1.Deployment, 0, len(response.Deployments))
+	for i := range response.Deployments {
+		deployments = append(deployments, toProtoDeployment(&response.Deployments[i]))
+	}
+
+	return &providerv1.ListDeploymentsResponse{
+		Deployments: deployments,
+		Pagination: &providerv1.Pagination{
+			Limit:   int32(response.Pagination.Limit),  // #nosec G115
+			Offset:  int32(response.Pagination.Offset), // #nosec G115
+			Total:   int32(response.Pagination.Total),  // #nosec G115
+			HasMore: response.Pagination.HasMore,
+		},
+	}, nil
+}
+
+// GetNamespacesByLabels handles the GetNamespacesByLabels RPC
+func (s *NamespaceServer) GetNamespacesByLabels(ctx context.Context, req *providerv1.GetNamespacesByLabelsRequest) (*providerv1.GetNamespacesByLabelsResponse, error) {
+	logger := s.logger.Named("grpc_server").With(zap.String("rpc", "get_namespaces_by_labels"))
+
+	if len(req.GetLabels()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "labels cannot be empty")
+	}
+
+	response, err := s.namespaceService.GetNamespacesByLabels(ctx, req.GetLabels())
+	if err != nil {
+		logger.Error("Failed to get namespaces", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	namespaces := make([]*providerv1.Namespace, 0, len(response.Namespaces))
+	for _, ns := range response.Namespaces {
+		namespaces = append(namespaces, &providerv1.Namespace{
+			Name:   ns.Name,
+			Labels: ns.Labels,
+		})
+	}
+
+	return &providerv1.GetNamespacesByLabelsResponse{
+		Namespaces: namespaces,
+		Count:      int32(response.Count), // #nosec G115
+	}, nil
+}
+
+// buildDeploymentRequest converts proto request fields into a typed deployment request
+func buildDeploymentRequest(kind string, metadata *providerv1.Metadata, spec *structpb.Struct) (*models.DeploymentRequest, error) {
+	if metadata == nil || metadata.GetName() == "" {
+		return nil, fmt.Errorf("metadata.name is required")
+	}
+	if spec == nil {
+		return nil, fmt.Errorf("spec is required")
+	}
+
+	req := &models.DeploymentRequest{
+		Kind: models.DeploymentKind(kind),
+		Metadata: models.Metadata{
+			Name:      metadata.GetName(),
+			Namespace: metadata.GetNamespace(),
+			Labels:    metadata.GetLabels(),
+		},
+		Spec: spec.AsMap(),
+	}
+	if req.Metadata.Namespace == "" {
+		req.Metadata.Namespace = "default"
+	}
+
+	// Convert the free-form spec to the proper typed spec based on kind
+	specBytes, err := json.Marshal(req.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Kind {
+	case models.DeploymentKindContainer:
+		var containerSpec models.ContainerSpec
+		if err := json.Unmarshal(specBytes, &containerSpec); err != nil {
+			return nil, err
+		}
+		req.Spec = containerSpec
+	case models.DeploymentKindVM:
+		var vmSpec models.VMSpec
+		if err := json.Unmarshal(specBytes, &vmSpec); err != nil {
+			return nil, err
+		}
+		req.Spec = vmSpec
+	case models.DeploymentKindJob:
+		var jobSpec models.JobSpec
+		if err := json.Unmarshal(specBytes, &jobSpec); err != nil {
+			return nil, err
+		}
+		req.Spec = jobSpec
+	default:
+		return nil, fmt.Errorf("unsupported deployment kind: %s", kind)
+	}
+
+	return req, nil
+}
+
+// toProtoDeployment converts a deployment response model to its proto representation
+func toProtoDeployment(deployment *models.DeploymentResponse) *providerv1.Deployment {
+	result := &providerv1.Deployment{
+		Id:   deployment.ID,
+		Kind: string(deployment.Kind),
+		Metadata: &providerv1.Metadata{
+			Name:      deployment.Metadata.Name,
+			Namespace: deployment.Metadata.Namespace,
+			Labels:    deployment.Metadata.Labels,
+		},
+		Status: &providerv1.DeploymentStatus{
+			Phase:         string(deployment.Status.Phase),
+			Message:       deployment.Status.Message,
+			ReadyReplicas: int32(deployment.Status.ReadyReplicas), // #nosec G115
+		},
+		CreatedAt: timestamppb.New(deployment.CreatedAt),
+		UpdatedAt: timestamppb.New(deployment.UpdatedAt),
+	}
+
+	// The spec is free-form; round-trip through JSON to build a proto struct
+	if deployment.Spec != nil {
+		if specBytes, err := json.Marshal(deployment.Spec); err == nil {
+			var specMap map[string]interface{}
+			if err := json.Unmarshal(specBytes, &specMap); err == nil {
+				if spec, err := structpb.NewStruct(specMap); err == nil {
+					result.Spec = spec
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// statusFromError maps service layer errors to gRPC status errors
+func statusFromError(err error) error {
+	switch {
+	case models.IsNotFoundError(err):
+		return status.Error(codes.NotFound, err.Error())
+	case models.IsConflictError(err):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,48 @@
+// Package identity carries the caller's identity through the request context.
+// Until the provider terminates authentication itself, the identity is taken
+// from forwarded headers set by an authenticating proxy in front of the
+// service; requests without those headers are simply anonymous.
+package identity
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// Headers populated by an authenticating proxy in front of the provider
+const (
+	HeaderForwardedUser  = "X-Forwarded-User"
+	HeaderForwardedEmail = "X-Forwarded-Email"
+)
+
+// contextKey is an unexported type to avoid collisions with other context values
+type contextKey struct{}
+
+// WithCreator returns a context carrying the caller identity
+func WithCreator(ctx context.Context, creator *models.Creator) context.Context {
+	if creator == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, creator)
+}
+
+// FromContext returns the caller identity carried by the context, if any
+func FromContext(ctx context.Context) (*models.Creator, bool) {
+	creator, ok := ctx.Value(contextKey{}).(*models.Creator)
+	return creator, ok
+}
+
+// FromRequest extracts the caller identity from forwarded headers.
+// It returns nil for anonymous requests.
+func FromRequest(r *http.Request) *models.Creator {
+	subject := r.Header.Get(HeaderForwardedUser)
+	if subject == "" {
+		return nil
+	}
+	return &models.Creator{
+		Subject: subject,
+		Email:   r.Header.Get(HeaderForwardedEmail),
+	}
+}
@@ -0,0 +1,47 @@
+package identity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+	req.Header.Set(HeaderForwardedUser, "alice")
+	req.Header.Set(HeaderForwardedEmail, "alice@example.com")
+
+	creator := FromRequest(req)
+	require.NotNil(t, creator)
+	assert.Equal(t, "alice", creator.Subject)
+	assert.Equal(t, "alice@example.com", creator.Email)
+}
+
+func TestFromRequestAnonymous(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+	// An email without a subject does not constitute an identity
+	req.Header.Set(HeaderForwardedEmail, "alice@example.com")
+
+	assert.Nil(t, FromRequest(req))
+}
+
+func TestContextRoundTrip(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+	req.Header.Set(HeaderForwardedUser, "bob")
+
+	ctx := WithCreator(context.Background(), FromRequest(req))
+	creator, ok := FromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, "bob", creator.Subject)
+}
+
+func TestFromContextWithoutIdentity(t *testing.T) {
+	ctx := WithCreator(context.Background(), nil)
+	creator, ok := FromContext(ctx)
+	assert.False(t, ok)
+	assert.Nil(t, creator)
+}
@@ -7,50 +7,113 @@ import (
 	"path/filepath"
 
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/dcm-project/k8s-service-provider/internal/config"
 )
 
-// Client wraps the Kubernetes client and provides shared functionality
+// Client wraps the Kubernetes clients and provides shared functionality.
+// Reads and writes go through separate clientsets with distinct client-side
+// rate limits so read-heavy load cannot starve provisioning writes.
 type Client struct {
-	clientset kubernetes.Interface
-	logger    *zap.Logger
+	clientset     kubernetes.Interface
+	readClientset kubernetes.Interface
+	dynamicClient dynamic.Interface
+	mapper        meta.RESTMapper
+	logger        *zap.Logger
 }
 
 // NewClient creates a new shared Kubernetes client
 func NewClient(cfg config.KubernetesConfig, logger *zap.Logger) (ClientInterface, error) {
-	k8sConfig, err := getKubeConfig(cfg, logger)
+	writeConfig, err := getKubeConfig(cfg, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get kubernetes config: %w", err)
 	}
+	writeConfig.QPS = float32(cfg.WriteQPS)
+	writeConfig.Burst = cfg.WriteBurst
 
-	clientset, err := kubernetes.NewForConfig(k8sConfig)
+	clientset, err := kubernetes.NewForConfig(writeConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
 	}
 
+	// The read client may use distinct credentials via ReadConfigPath;
+	// otherwise it shares the write credentials with its own rate limits
+	readCfg := cfg
+	if cfg.ReadConfigPath != "" {
+		readCfg.ConfigPath = cfg.ReadConfigPath
+		readCfg.InCluster = false
+		logger.Info("Using separate credentials for read client", zap.String("path", cfg.ReadConfigPath))
+	}
+	readConfig, err := getKubeConfig(readCfg, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubernetes read config: %w", err)
+	}
+	readConfig.QPS = float32(cfg.ReadQPS)
+	readConfig.Burst = cfg.ReadBurst
+
+	readClientset, err := kubernetes.NewForConfig(readConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes read client: %w", err)
+	}
+
+	// The dynamic client serves raw manifest deployments, with a cached
+	// discovery mapper resolving arbitrary kinds to their resources
+	dynamicClient, err := dynamic.NewForConfig(writeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(readClientset.Discovery()))
+
+	logger.Info("Initialized Kubernetes clients",
+		zap.Float64("read_qps", cfg.ReadQPS),
+		zap.Int("read_burst", cfg.ReadBurst),
+		zap.Float64("write_qps", cfg.WriteQPS),
+		zap.Int("write_burst", cfg.WriteBurst))
+
 	return &Client{
-		clientset: clientset,
-		logger:    logger,
+		clientset:     clientset,
+		readClientset: readClientset,
+		dynamicClient: dynamicClient,
+		mapper:        mapper,
+		logger:        logger,
 	}, nil
 }
 
-// GetClientset returns the underlying Kubernetes clientset
+// GetClientset returns the Kubernetes clientset used for writes
 func (c *Client) GetClientset() kubernetes.Interface {
 	return c.clientset
 }
 
+// GetReadClientset returns the Kubernetes clientset used for read-heavy operations
+func (c *Client) GetReadClientset() kubernetes.Interface {
+	return c.readClientset
+}
+
+// GetDynamicClient returns the dynamic client used for raw manifest objects
+func (c *Client) GetDynamicClient() dynamic.Interface {
+	return c.dynamicClient
+}
+
+// GetRESTMapper returns the discovery-backed mapper resolving kinds to resources
+func (c *Client) GetRESTMapper() meta.RESTMapper {
+	return c.mapper
+}
+
 // HealthCheck verifies that the Kubernetes client can connect to the cluster
 func (c *Client) HealthCheck(ctx context.Context) error {
 	c.logger.Debug("Performing Kubernetes health check")
 
 	// Try to get server version as a simple health check
-	_, err := c.clientset.Discovery().ServerVersion()
+	_, err := c.readClientset.Discovery().ServerVersion()
 	if err != nil {
 		c.logger.Error("Kubernetes health check failed", zap.Error(err))
 		return fmt.Errorf("kubernetes health check failed: %w", err)
@@ -68,7 +131,7 @@ func (c *Client) GetNamespacesByLabels(ctx context.Context, labelSelectors map[s
 	selector := labels.Set(labelSelectors).AsSelector()
 
 	// List namespaces with label selector
-	namespaceList, err := c.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+	namespaceList, err := c.readClientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
 		LabelSelector: selector.String(),
 	})
 	if err != nil {
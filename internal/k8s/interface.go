@@ -3,14 +3,25 @@ package k8s
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
 // ClientInterface defines the interface for Kubernetes client operations
 type ClientInterface interface {
-	// GetClientset returns the underlying Kubernetes clientset
+	// GetClientset returns the Kubernetes clientset used for writes
 	GetClientset() kubernetes.Interface
 
+	// GetReadClientset returns the Kubernetes clientset used for read-heavy operations
+	GetReadClientset() kubernetes.Interface
+
+	// GetDynamicClient returns the dynamic client used for raw manifest objects
+	GetDynamicClient() dynamic.Interface
+
+	// GetRESTMapper returns the discovery-backed mapper resolving kinds to resources
+	GetRESTMapper() meta.RESTMapper
+
 	// HealthCheck verifies that the Kubernetes client can connect to the cluster
 	HealthCheck(ctx context.Context) error
 
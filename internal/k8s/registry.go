@@ -0,0 +1,76 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+)
+
+// Cluster is a registered target cluster: a name, the kubeconfig it was
+// built from, and a ready-to-use client
+type Cluster struct {
+	Name       string
+	Kubeconfig string
+	Client     ClientInterface
+}
+
+// Registry holds clients for the additional target clusters configured via
+// "name=kubeconfig-path" entries. The provider's own cluster is not part of
+// the registry; a nil registry means single-cluster operation.
+type Registry struct {
+	clusters map[string]*Cluster
+}
+
+// NewRegistry builds a client per configured cluster entry. Cluster names
+// must be unique; each client inherits the base rate limits but always
+// authenticates with the entry's kubeconfig, never in-cluster credentials.
+// Returns nil when no clusters are configured.
+func NewRegistry(cfg config.KubernetesConfig, logger *zap.Logger) (*Registry, error) {
+	if len(cfg.Clusters) == 0 {
+		return nil, nil
+	}
+
+	clusters := make(map[string]*Cluster, len(cfg.Clusters))
+	for _, entry := range cfg.Clusters {
+		name, kubeconfig, ok := strings.Cut(entry, "=")
+		if !ok || name == "" || kubeconfig == "" {
+			return nil, fmt.Errorf("invalid cluster entry %q: expected name=kubeconfig-path", entry)
+		}
+		if _, exists := clusters[name]; exists {
+			return nil, fmt.Errorf("duplicate cluster name %q", name)
+		}
+
+		clusterCfg := cfg
+		clusterCfg.ConfigPath = kubeconfig
+		clusterCfg.ReadConfigPath = ""
+		clusterCfg.InCluster = false
+		client, err := NewClient(clusterCfg, logger.With(zap.String("cluster", name)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for cluster %s: %w", name, err)
+		}
+
+		clusters[name] = &Cluster{Name: name, Kubeconfig: kubeconfig, Client: client}
+		logger.Info("Registered target cluster",
+			zap.String("cluster", name),
+			zap.String("kubeconfig", kubeconfig))
+	}
+
+	return &Registry{clusters: clusters}, nil
+}
+
+// Clusters returns the registered clusters in stable name order
+func (r *Registry) Clusters() []*Cluster {
+	if r == nil {
+		return nil
+	}
+	clusters := make([]*Cluster, 0, len(r.clusters))
+	for _, cluster := range r.clusters {
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].Name < clusters[j].Name })
+	return clusters
+}
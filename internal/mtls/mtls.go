@@ -0,0 +1,83 @@
+// Package mtls builds the TLS configuration for the HTTP API listeners,
+// including optional mutual TLS client authentication with a CN/SAN
+// allowlist for zero-trust deployments.
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+)
+
+// NewTLSConfig builds the listener TLS configuration. It returns nil when
+// TLS is disabled so the servers fall back to plain HTTP. When a client CA
+// bundle is configured the listeners require client certificates signed by
+// it; the allowlist, when set, additionally restricts which certificate
+// identities are accepted.
+func NewTLSConfig(cfg config.TLSConfig) (*tls.Config, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading server certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if cfg.ClientCAFile == "" {
+		return tlsConfig, nil
+	}
+
+	caPEM, err := os.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading client CA bundle: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("client CA bundle %s contains no certificates", cfg.ClientCAFile)
+	}
+
+	tlsConfig.ClientCAs = pool
+	tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+
+	if len(cfg.AllowedClients) > 0 {
+		allowed := cfg.AllowedClients
+		// VerifyConnection runs after the handshake has already verified the
+		// client chain against ClientCAs, so only the identity check remains
+		tlsConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+			return verifyClientIdentity(cs.PeerCertificates, allowed)
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// verifyClientIdentity checks the leaf client certificate against the
+// allowlist; an entry matches the certificate Common Name or any DNS SAN
+func verifyClientIdentity(peerCerts []*x509.Certificate, allowed []string) error {
+	if len(peerCerts) == 0 {
+		return fmt.Errorf("no client certificate presented")
+	}
+
+	leaf := peerCerts[0]
+	for _, name := range allowed {
+		if leaf.Subject.CommonName == name {
+			return nil
+		}
+		for _, san := range leaf.DNSNames {
+			if san == name {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("client certificate %q is not in the allowed client list", leaf.Subject.CommonName)
+}
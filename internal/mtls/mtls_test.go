@@ -0,0 +1,173 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+)
+
+// writeSelfSignedPair writes a self-signed certificate and key to dir and
+// returns their paths
+func writeSelfSignedPair(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test-server"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "tls.crt")
+	keyFile = filepath.Join(dir, "tls.key")
+	require.NoError(t, os.WriteFile(certFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certFile, keyFile
+}
+
+func TestNewTLSConfigDisabled(t *testing.T) {
+	tlsConfig, err := NewTLSConfig(config.TLSConfig{Enabled: false})
+	assert.NoError(t, err)
+	assert.Nil(t, tlsConfig)
+}
+
+func TestNewTLSConfigServerOnly(t *testing.T) {
+	certFile, keyFile := writeSelfSignedPair(t, t.TempDir())
+
+	tlsConfig, err := NewTLSConfig(config.TLSConfig{
+		Enabled:  true,
+		CertFile: certFile,
+		KeyFile:  keyFile,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig)
+	assert.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+	assert.Len(t, tlsConfig.Certificates, 1)
+}
+
+func TestNewTLSConfigRequiresClientCertificates(t *testing.T) {
+	certFile, keyFile := writeSelfSignedPair(t, t.TempDir())
+
+	tlsConfig, err := NewTLSConfig(config.TLSConfig{
+		Enabled:      true,
+		CertFile:     certFile,
+		KeyFile:      keyFile,
+		ClientCAFile: certFile, // any PEM certificate works as a CA bundle here
+	})
+	require.NoError(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+	assert.NotNil(t, tlsConfig.ClientCAs)
+	assert.Nil(t, tlsConfig.VerifyConnection)
+}
+
+func TestNewTLSConfigAllowlistSetsVerifyConnection(t *testing.T) {
+	certFile, keyFile := writeSelfSignedPair(t, t.TempDir())
+
+	tlsConfig, err := NewTLSConfig(config.TLSConfig{
+		Enabled:        true,
+		CertFile:       certFile,
+		KeyFile:        keyFile,
+		ClientCAFile:   certFile,
+		AllowedClients: []string{"ops-gateway"},
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, tlsConfig.VerifyConnection)
+}
+
+func TestNewTLSConfigMissingFiles(t *testing.T) {
+	_, err := NewTLSConfig(config.TLSConfig{
+		Enabled:  true,
+		CertFile: "/nonexistent/tls.crt",
+		KeyFile:  "/nonexistent/tls.key",
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "loading server certificate")
+}
+
+func TestNewTLSConfigEmptyCABundle(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeSelfSignedPair(t, dir)
+	emptyCA := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(emptyCA, []byte("not a certificate"), 0600))
+
+	_, err := NewTLSConfig(config.TLSConfig{
+		Enabled:      true,
+		CertFile:     certFile,
+		KeyFile:      keyFile,
+		ClientCAFile: emptyCA,
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "contains no certificates")
+}
+
+func TestVerifyClientIdentity(t *testing.T) {
+	cert := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "ops-gateway"},
+		DNSNames: []string{"gateway.internal.example.com"},
+	}
+
+	tests := []struct {
+		name    string
+		certs   []*x509.Certificate
+		allowed []string
+		wantErr bool
+	}{
+		{
+			name:    "common name match",
+			certs:   []*x509.Certificate{cert},
+			allowed: []string{"ops-gateway"},
+		},
+		{
+			name:    "dns san match",
+			certs:   []*x509.Certificate{cert},
+			allowed: []string{"gateway.internal.example.com"},
+		},
+		{
+			name:    "no match",
+			certs:   []*x509.Certificate{cert},
+			allowed: []string{"other-client"},
+			wantErr: true,
+		},
+		{
+			name:    "no certificate presented",
+			certs:   nil,
+			allowed: []string{"ops-gateway"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyClientIdentity(tt.certs, tt.allowed)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
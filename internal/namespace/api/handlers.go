@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	deploymodels "github.com/dcm-project/k8s-service-provider/internal/deployment/models"
 	"github.com/dcm-project/k8s-service-provider/internal/namespace/models"
 	"github.com/dcm-project/k8s-service-provider/internal/namespace/services"
 	"go.uber.org/zap"
@@ -35,14 +36,14 @@ func (h *Handler) GetNamespacesByLabels(w http.ResponseWriter, r *http.Request)
 	var req models.LabelSelectors
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.Error("Failed to decode request body", zap.Error(err))
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON", "Failed to parse request body")
+		h.writeErrorResponse(w, http.StatusBadRequest, deploymodels.CodeInvalidRequest, "Invalid request format", "Failed to parse request body")
 		return
 	}
 
 	// Validate request
 	if len(req.Labels) == 0 {
 		h.logger.Error("Empty labels provided")
-		h.writeErrorResponse(w, http.StatusBadRequest, "Validation Error", "Labels cannot be empty")
+		h.writeErrorResponse(w, http.StatusBadRequest, deploymodels.CodeInvalidRequest, "Invalid request format", "Labels cannot be empty")
 		return
 	}
 
@@ -53,7 +54,7 @@ func (h *Handler) GetNamespacesByLabels(w http.ResponseWriter, r *http.Request)
 	response, err := h.namespaceService.GetNamespacesByLabels(r.Context(), req.Labels)
 	if err != nil {
 		h.logger.Error("Failed to get namespaces from service", zap.Error(err))
-		h.writeErrorResponse(w, http.StatusInternalServerError, "Kubernetes API Error", "Failed to fetch namespaces")
+		h.writeErrorResponse(w, http.StatusInternalServerError, deploymodels.CodeNamespaceListFailed, "Failed to fetch namespaces", err.Error())
 		return
 	}
 
@@ -96,24 +97,17 @@ func (h *Handler) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// writeErrorResponse writes a standardized error response
-func (h *Handler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorType, message string) {
-	response := models.ErrorResponse{
-		Error:   errorType,
-		Message: message,
-	}
-
-	w.WriteHeader(statusCode)
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		h.logger.Error("Failed to encode error response", zap.Error(err))
-	}
+// writeErrorResponse writes the standard RFC 7807 problem document, shared
+// with the deployment API so both services emit the same error shape
+func (h *Handler) writeErrorResponse(w http.ResponseWriter, statusCode int, code, title, detail string) {
+	deploymodels.WriteProblem(w, deploymodels.NewProblem(statusCode, code, title, detail))
 }
 
 // NotFoundHandler handles 404 errors
 func (h *Handler) NotFoundHandler(w http.ResponseWriter, r *http.Request) {
 	h.logger.Warn("Endpoint not found", zap.String("path", r.URL.Path))
 	w.Header().Set("Content-Type", "application/json")
-	h.writeErrorResponse(w, http.StatusNotFound, "Not Found", "The requested endpoint does not exist")
+	h.writeErrorResponse(w, http.StatusNotFound, deploymodels.CodeNotFound, "Not found", "The requested endpoint does not exist")
 }
 
 // MethodNotAllowedHandler handles 405 errors
@@ -123,5 +117,5 @@ func (h *Handler) MethodNotAllowedHandler(w http.ResponseWriter, r *http.Request
 		zap.String("path", r.URL.Path),
 	)
 	w.Header().Set("Content-Type", "application/json")
-	h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method Not Allowed", "The HTTP method is not allowed for this endpoint")
+	h.writeErrorResponse(w, http.StatusMethodNotAllowed, deploymodels.CodeMethodNotAllowed, "Method not allowed", "The HTTP method is not allowed for this endpoint")
 }
@@ -1,19 +1,22 @@
 package api
 
 import (
+	"net"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/dcm-project/k8s-service-provider/internal/config"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 )
 
 // SetupRouter sets up the HTTP router for the namespace service
-func SetupRouter(handler *Handler, logger *zap.Logger) *mux.Router {
+func SetupRouter(handler *Handler, serverCfg config.ServerConfig, logger *zap.Logger) *mux.Router {
 	router := mux.NewRouter()
 
 	// Add middleware
-	router.Use(loggingMiddleware(logger))
+	router.Use(loggingMiddleware(serverCfg, logger))
 	router.Use(corsMiddleware)
 
 	// API v1 routes
@@ -29,7 +32,7 @@ func SetupRouter(handler *Handler, logger *zap.Logger) *mux.Router {
 }
 
 // loggingMiddleware logs HTTP requests using zap
-func loggingMiddleware(logger *zap.Logger) func(next http.Handler) http.Handler {
+func loggingMiddleware(serverCfg config.ServerConfig, logger *zap.Logger) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
@@ -48,6 +51,7 @@ func loggingMiddleware(logger *zap.Logger) func(next http.Handler) http.Handler
 				zap.Int("status_code", wrapped.statusCode),
 				zap.Duration("duration", duration),
 				zap.String("remote_addr", r.RemoteAddr),
+				zap.String("client_ip", clientIP(serverCfg, r)),
 				zap.String("user_agent", r.UserAgent()),
 				zap.String("query_params", r.URL.RawQuery),
 			)
@@ -55,6 +59,52 @@ func loggingMiddleware(logger *zap.Logger) func(next http.Handler) http.Handler
 	}
 }
 
+// clientIP resolves the caller address, honouring X-Forwarded-For only when
+// the connection came through one of the configured trusted proxies
+func clientIP(serverCfg config.ServerConfig, r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if !serverCfg.ForwardedByClientIP || !isTrustedProxy(serverCfg.TrustedProxies, host) {
+		return host
+	}
+
+	forwarded := r.Header.Get("X-Forwarded-For")
+	if forwarded == "" {
+		return host
+	}
+
+	// The original client is the first entry; later entries are proxies
+	if idx := strings.Index(forwarded, ","); idx >= 0 {
+		forwarded = forwarded[:idx]
+	}
+	return strings.TrimSpace(forwarded)
+}
+
+// isTrustedProxy reports whether the remote host matches a trusted proxy IP
+// or CIDR from the server configuration
+func isTrustedProxy(proxies []string, host string) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	for _, proxy := range proxies {
+		if _, cidr, err := net.ParseCIDR(proxy); err == nil {
+			if cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if proxyIP := net.ParseIP(proxy); proxyIP != nil && proxyIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // responseWriter wraps http.ResponseWriter to capture status code
 type responseWriter struct {
 	http.ResponseWriter
@@ -19,12 +19,6 @@ type NamespaceResponse struct {
 	Count      int         `json:"count"`
 }
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message"`
-}
-
 // HealthResponse represents the health check response
 type HealthResponse struct {
 	Status    string    `json:"status"`
@@ -0,0 +1,190 @@
+// Package netid reserves stable network identities for VM deployments.
+// DHCP reservations and MAC-bound licensing break when a VM comes back with
+// a fresh MAC after an update or migration, so the registry assigns each
+// deployment ID a MAC address and hostname once and reapplies them for the
+// lifetime of the deployment.
+package netid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// registryConfigMapName is the ConfigMap holding all reservations, keyed by
+// deployment ID
+const registryConfigMapName = "vm-network-identities"
+
+// macPrefix is the locally administered QEMU/KVM OUI used for generated MACs
+const macPrefix = "52:54:00"
+
+// reserveRetries bounds optimistic-concurrency retries against the ConfigMap
+const reserveRetries = 3
+
+// Reservation is the stable network identity assigned to one deployment
+type Reservation struct {
+	MAC      string `json:"mac"`
+	Hostname string `json:"hostname"`
+}
+
+// Registry assigns and persists network identities per deployment ID
+type Registry interface {
+	// Reserve returns the existing reservation for the deployment, assigning
+	// and persisting a new one on first use
+	Reserve(ctx context.Context, id, name string) (*Reservation, error)
+	// Release removes the reservation for a deleted deployment
+	Release(ctx context.Context, id string) error
+}
+
+// ConfigMapRegistry persists reservations in a single ConfigMap so they
+// survive VM recreates without requiring an external store
+type ConfigMapRegistry struct {
+	client    kubernetes.Interface
+	namespace string
+	logger    *zap.Logger
+}
+
+// NewConfigMapRegistry creates a registry backed by a ConfigMap in the given namespace
+func NewConfigMapRegistry(client kubernetes.Interface, namespace string, logger *zap.Logger) *ConfigMapRegistry {
+	return &ConfigMapRegistry{
+		client:    client,
+		namespace: namespace,
+		logger:    logger.Named("netid_registry"),
+	}
+}
+
+// Reserve returns the deployment's reservation, creating one on first use.
+// Concurrent writers are handled with optimistic retries on the ConfigMap.
+func (r *ConfigMapRegistry) Reserve(ctx context.Context, id, name string) (*Reservation, error) {
+	for attempt := 0; attempt < reserveRetries; attempt++ {
+		configMap, err := r.getOrCreateConfigMap(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if entry, ok := configMap.Data[id]; ok {
+			var reservation Reservation
+			if err := json.Unmarshal([]byte(entry), &reservation); err != nil {
+				return nil, fmt.Errorf("corrupt network identity entry for deployment %s: %w", id, err)
+			}
+			return &reservation, nil
+		}
+
+		mac, err := generateMAC(configMap.Data)
+		if err != nil {
+			return nil, err
+		}
+		reservation := &Reservation{
+			MAC:      mac,
+			Hostname: fmt.Sprintf("%s-%s", name, id[:8]),
+		}
+
+		entry, err := json.Marshal(reservation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode network identity: %w", err)
+		}
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+		configMap.Data[id] = string(entry)
+
+		_, err = r.client.CoreV1().ConfigMaps(r.namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+		if err == nil {
+			r.logger.Info("Reserved network identity",
+				zap.String("deployment_id", id),
+				zap.String("mac", reservation.MAC),
+				zap.String("hostname", reservation.Hostname))
+			return reservation, nil
+		}
+		if !k8serrors.IsConflict(err) {
+			return nil, fmt.Errorf("failed to persist network identity: %w", err)
+		}
+	}
+
+	return nil, fmt.Errorf("failed to reserve network identity for deployment %s after %d attempts", id, reserveRetries)
+}
+
+// Release removes the deployment's reservation; a missing entry is not an error
+func (r *ConfigMapRegistry) Release(ctx context.Context, id string) error {
+	for attempt := 0; attempt < reserveRetries; attempt++ {
+		configMap, err := r.client.CoreV1().ConfigMaps(r.namespace).Get(ctx, registryConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get network identity registry: %w", err)
+		}
+
+		if _, ok := configMap.Data[id]; !ok {
+			return nil
+		}
+		delete(configMap.Data, id)
+
+		_, err = r.client.CoreV1().ConfigMaps(r.namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+		if err == nil {
+			r.logger.Info("Released network identity", zap.String("deployment_id", id))
+			return nil
+		}
+		if !k8serrors.IsConflict(err) {
+			return fmt.Errorf("failed to release network identity: %w", err)
+		}
+	}
+
+	return fmt.Errorf("failed to release network identity for deployment %s after %d attempts", id, reserveRetries)
+}
+
+// getOrCreateConfigMap fetches the registry ConfigMap, creating it on first use
+func (r *ConfigMapRegistry) getOrCreateConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+	configMap, err := r.client.CoreV1().ConfigMaps(r.namespace).Get(ctx, registryConfigMapName, metav1.GetOptions{})
+	if err == nil {
+		return configMap, nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get network identity registry: %w", err)
+	}
+
+	created, err := r.client.CoreV1().ConfigMaps(r.namespace).Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      registryConfigMapName,
+			Namespace: r.namespace,
+		},
+	}, metav1.CreateOptions{})
+	if err == nil {
+		return created, nil
+	}
+	if k8serrors.IsAlreadyExists(err) {
+		return r.client.CoreV1().ConfigMaps(r.namespace).Get(ctx, registryConfigMapName, metav1.GetOptions{})
+	}
+	return nil, fmt.Errorf("failed to create network identity registry: %w", err)
+}
+
+// generateMAC returns a random locally administered MAC not already reserved
+func generateMAC(existing map[string]string) (string, error) {
+	reserved := make(map[string]bool, len(existing))
+	for _, entry := range existing {
+		var reservation Reservation
+		if err := json.Unmarshal([]byte(entry), &reservation); err == nil {
+			reserved[reservation.MAC] = true
+		}
+	}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		suffix := make([]byte, 3)
+		if _, err := rand.Read(suffix); err != nil {
+			return "", fmt.Errorf("failed to generate MAC address: %w", err)
+		}
+		mac := fmt.Sprintf("%s:%02x:%02x:%02x", macPrefix, suffix[0], suffix[1], suffix[2])
+		if !reserved[mac] {
+			return mac, nil
+		}
+	}
+
+	return "", fmt.Errorf("failed to generate an unreserved MAC address")
+}
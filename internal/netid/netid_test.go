@@ -0,0 +1,61 @@
+package netid
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var macPattern = regexp.MustCompile(`^52:54:00(:[0-9a-f]{2}){3}$`)
+
+func TestReserveAssignsStableIdentity(t *testing.T) {
+	registry := NewConfigMapRegistry(fake.NewSimpleClientset(), "default", zap.NewNop())
+	ctx := context.Background()
+
+	first, err := registry.Reserve(ctx, "11111111-2222-3333-4444-555566667777", "devbox")
+	require.NoError(t, err)
+	assert.Regexp(t, macPattern, first.MAC)
+	assert.Equal(t, "devbox-11111111", first.Hostname)
+
+	// A second reserve for the same deployment returns the same identity
+	second, err := registry.Reserve(ctx, "11111111-2222-3333-4444-555566667777", "devbox")
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestReserveAssignsDistinctMACs(t *testing.T) {
+	registry := NewConfigMapRegistry(fake.NewSimpleClientset(), "default", zap.NewNop())
+	ctx := context.Background()
+
+	first, err := registry.Reserve(ctx, "11111111-0000-0000-0000-000000000000", "vm-a")
+	require.NoError(t, err)
+	second, err := registry.Reserve(ctx, "22222222-0000-0000-0000-000000000000", "vm-b")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.MAC, second.MAC)
+}
+
+func TestRelease(t *testing.T) {
+	registry := NewConfigMapRegistry(fake.NewSimpleClientset(), "default", zap.NewNop())
+	ctx := context.Background()
+
+	reserved, err := registry.Reserve(ctx, "11111111-0000-0000-0000-000000000000", "devbox")
+	require.NoError(t, err)
+
+	require.NoError(t, registry.Release(ctx, "11111111-0000-0000-0000-000000000000"))
+
+	// A fresh reservation is assigned after release
+	next, err := registry.Reserve(ctx, "11111111-0000-0000-0000-000000000000", "devbox")
+	require.NoError(t, err)
+	assert.NotEqual(t, reserved.MAC, next.MAC)
+}
+
+func TestReleaseMissingEntry(t *testing.T) {
+	registry := NewConfigMapRegistry(fake.NewSimpleClientset(), "default", zap.NewNop())
+	assert.NoError(t, registry.Release(context.Background(), "11111111-0000-0000-0000-000000000000"))
+}
@@ -0,0 +1,92 @@
+// Package placement decides where new deployments land. A Strategy is
+// consulted at create time with the requested kind, name, namespace and
+// labels and returns the namespace (and, once multi-cluster support lands,
+// the cluster) to create in. Strategies with cluster access can weigh quotas
+// or spread policies; the default passthrough simply honors the request.
+package placement
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+// DefaultNamespace is used when the caller does not request a namespace,
+// matching the fallback applied by the per-kind services.
+const DefaultNamespace = "default"
+
+// Request carries the deployment attributes a strategy may consider
+type Request struct {
+	Kind      string
+	Name      string
+	Namespace string // namespace requested by the caller, may be empty
+	Labels    map[string]string
+}
+
+// Decision is the placement outcome for one deployment
+type Decision struct {
+	Namespace string
+	// Cluster selects the target cluster once multi-cluster support lands;
+	// it is empty for the local cluster today.
+	Cluster string
+}
+
+// Strategy chooses where a deployment is created
+type Strategy interface {
+	Name() string
+	Place(ctx context.Context, req *Request) (*Decision, error)
+}
+
+// Passthrough honors the requested namespace, falling back to the default
+// namespace when none is given. It is the strategy used unless an operator
+// swaps in another one.
+type Passthrough struct{}
+
+// NewPassthrough creates the default passthrough strategy
+func NewPassthrough() *Passthrough {
+	return &Passthrough{}
+}
+
+// Name returns the strategy identifier used in logs
+func (p *Passthrough) Name() string {
+	return "passthrough"
+}
+
+// Place returns the requested namespace, defaulting when empty
+func (p *Passthrough) Place(_ context.Context, req *Request) (*Decision, error) {
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	return &Decision{Namespace: namespace}, nil
+}
+
+// RoundRobin spreads deployments without an explicit namespace across a
+// fixed namespace pool. Requests that name a namespace are honored as-is,
+// so tenants keep control when they need it.
+type RoundRobin struct {
+	pool []string
+	next atomic.Uint64
+}
+
+// NewRoundRobin creates a round-robin strategy over the given namespace pool
+func NewRoundRobin(pool []string) (*RoundRobin, error) {
+	if len(pool) == 0 {
+		return nil, fmt.Errorf("round-robin placement requires at least one namespace")
+	}
+	return &RoundRobin{pool: pool}, nil
+}
+
+// Name returns the strategy identifier used in logs
+func (r *RoundRobin) Name() string {
+	return "round-robin"
+}
+
+// Place cycles through the pool for requests without an explicit namespace
+func (r *RoundRobin) Place(_ context.Context, req *Request) (*Decision, error) {
+	if req.Namespace != "" {
+		return &Decision{Namespace: req.Namespace}, nil
+	}
+	index := (r.next.Add(1) - 1) % uint64(len(r.pool))
+	return &Decision{Namespace: r.pool[index]}, nil
+}
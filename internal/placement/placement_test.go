@@ -0,0 +1,48 @@
+package placement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassthroughPlace(t *testing.T) {
+	strategy := NewPassthrough()
+
+	decision, err := strategy.Place(context.Background(), &Request{Namespace: "team-a"})
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", decision.Namespace)
+
+	decision, err = strategy.Place(context.Background(), &Request{})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultNamespace, decision.Namespace)
+}
+
+func TestRoundRobinPlace(t *testing.T) {
+	strategy, err := NewRoundRobin([]string{"pool-a", "pool-b"})
+	require.NoError(t, err)
+
+	var placed []string
+	for i := 0; i < 4; i++ {
+		decision, err := strategy.Place(context.Background(), &Request{})
+		require.NoError(t, err)
+		placed = append(placed, decision.Namespace)
+	}
+	assert.Equal(t, []string{"pool-a", "pool-b", "pool-a", "pool-b"}, placed)
+}
+
+func TestRoundRobinHonorsExplicitNamespace(t *testing.T) {
+	strategy, err := NewRoundRobin([]string{"pool-a"})
+	require.NoError(t, err)
+
+	decision, err := strategy.Place(context.Background(), &Request{Namespace: "team-a"})
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", decision.Namespace)
+}
+
+func TestRoundRobinRequiresPool(t *testing.T) {
+	_, err := NewRoundRobin(nil)
+	assert.Error(t, err)
+}
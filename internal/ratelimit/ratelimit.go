@@ -0,0 +1,142 @@
+// Package ratelimit applies token-bucket rate limits to the HTTP servers so
+// runaway clients cannot translate into runaway load on the Kubernetes API
+// server. A global bucket caps the total request rate and per-client buckets
+// cap each caller, keyed by the authenticated principal when one is attached
+// and by client IP otherwise.
+package ratelimit
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+)
+
+// pruneAfter is how long an idle client bucket is kept before it is dropped;
+// an idle bucket is full, so dropping it loses nothing
+const pruneAfter = 10 * time.Minute
+
+// Limiter enforces the configured global and per-client rate limits
+type Limiter struct {
+	global      *rate.Limiter
+	clientRate  rate.Limit
+	clientBurst int
+
+	mu        sync.Mutex
+	clients   map[string]*clientBucket
+	lastPrune time.Time
+}
+
+// clientBucket pairs a client's bucket with its last use, for pruning
+type clientBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewLimiter creates a limiter from configuration, or nil when rate limiting
+// is disabled so the middleware can be applied unconditionally
+func NewLimiter(cfg config.RateLimitConfig) *Limiter {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &Limiter{
+		global:      rate.NewLimiter(rate.Limit(cfg.RPS), cfg.Burst),
+		clientRate:  rate.Limit(cfg.ClientRPS),
+		clientBurst: cfg.ClientBurst,
+		clients:     make(map[string]*clientBucket),
+		lastPrune:   time.Now(),
+	}
+}
+
+// Middleware rejects requests above the configured rates with 429 and a
+// Retry-After header. Health checks are never limited, so probes keep working
+// while the service sheds load. A nil limiter leaves the handler untouched.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	if l == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/health") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if delay, ok := l.reserve(l.clientLimiter(clientKey(r))); !ok {
+			writeTooManyRequests(w, delay)
+			return
+		}
+		if delay, ok := l.reserve(l.global); !ok {
+			writeTooManyRequests(w, delay)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// reserve takes a token from the bucket, returning the wait time until one is
+// available when the bucket is empty
+func (l *Limiter) reserve(limiter *rate.Limiter) (time.Duration, bool) {
+	reservation := limiter.Reserve()
+	if delay := reservation.Delay(); delay > 0 {
+		reservation.Cancel()
+		return delay, false
+	}
+	return 0, true
+}
+
+// clientLimiter returns the bucket for the client key, creating it on first
+// use and pruning idle buckets on the way
+func (l *Limiter) clientLimiter(key string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastPrune) > pruneAfter {
+		for key, bucket := range l.clients {
+			if now.Sub(bucket.lastSeen) > pruneAfter {
+				delete(l.clients, key)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	bucket, ok := l.clients[key]
+	if !ok {
+		bucket = &clientBucket{limiter: rate.NewLimiter(l.clientRate, l.clientBurst)}
+		l.clients[key] = bucket
+	}
+	bucket.lastSeen = now
+	return bucket.limiter
+}
+
+// clientKey identifies the caller for per-client limiting: the authenticated
+// principal when the authentication middleware attached one, otherwise the
+// client IP
+func clientKey(r *http.Request) string {
+	if creator, ok := identity.FromContext(r.Context()); ok {
+		return creator.Subject
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// writeTooManyRequests rejects the request with 429 and the wait until a
+// token is available, rounded up to whole seconds per the Retry-After header
+func writeTooManyRequests(w http.ResponseWriter, delay time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+	models.WriteProblem(w, models.NewProblem(http.StatusTooManyRequests, models.CodeRateLimited,
+		"Too many requests, retry after the indicated delay", ""))
+}
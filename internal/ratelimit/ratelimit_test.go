@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+)
+
+// limiterConfig returns an enabled configuration with the given bucket sizes
+// and rates low enough that buckets do not refill during a test
+func limiterConfig(globalBurst, clientBurst int) config.RateLimitConfig {
+	return config.RateLimitConfig{
+		Enabled:     true,
+		RPS:         0.001,
+		Burst:       globalBurst,
+		ClientRPS:   0.001,
+		ClientBurst: clientBurst,
+	}
+}
+
+func serve(handler http.Handler, remoteAddr string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+	request.RemoteAddr = remoteAddr
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+	return response
+}
+
+func TestMiddlewareEnforcesPerClientLimit(t *testing.T) {
+	handler := NewLimiter(limiterConfig(100, 2)).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	// The client bucket holds two tokens; the third request is rejected
+	assert.Equal(t, http.StatusOK, serve(handler, "10.0.0.1:1234").Code)
+	assert.Equal(t, http.StatusOK, serve(handler, "10.0.0.1:1234").Code)
+
+	response := serve(handler, "10.0.0.1:1234")
+	assert.Equal(t, http.StatusTooManyRequests, response.Code)
+	assert.NotEmpty(t, response.Header().Get("Retry-After"))
+	assert.Contains(t, response.Body.String(), "RATE_LIMITED")
+
+	// A different client has its own bucket
+	assert.Equal(t, http.StatusOK, serve(handler, "10.0.0.2:1234").Code)
+}
+
+func TestMiddlewareEnforcesGlobalLimit(t *testing.T) {
+	handler := NewLimiter(limiterConfig(2, 100)).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	assert.Equal(t, http.StatusOK, serve(handler, "10.0.0.1:1234").Code)
+	assert.Equal(t, http.StatusOK, serve(handler, "10.0.0.2:1234").Code)
+
+	// The global bucket is exhausted even though each client has budget left
+	assert.Equal(t, http.StatusTooManyRequests, serve(handler, "10.0.0.3:1234").Code)
+}
+
+func TestMiddlewareKeysByPrincipal(t *testing.T) {
+	handler := NewLimiter(limiterConfig(100, 1)).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	authenticated := func(subject, remoteAddr string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+		request.RemoteAddr = remoteAddr
+		request = request.WithContext(identity.WithCreator(request.Context(), &models.Creator{Subject: subject}))
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+		return response
+	}
+
+	// The same principal shares a bucket across source addresses
+	assert.Equal(t, http.StatusOK, authenticated("api-key:ci", "10.0.0.1:1234").Code)
+	assert.Equal(t, http.StatusTooManyRequests, authenticated("api-key:ci", "10.0.0.2:1234").Code)
+
+	// A different principal from the same address is unaffected
+	assert.Equal(t, http.StatusOK, authenticated("api-key:other", "10.0.0.1:1234").Code)
+}
+
+func TestMiddlewareNeverLimitsHealthChecks(t *testing.T) {
+	handler := NewLimiter(limiterConfig(1, 1)).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for i := 0; i < 5; i++ {
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)
+		request.RemoteAddr = "10.0.0.1:1234"
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+		assert.Equal(t, http.StatusOK, response.Code)
+	}
+}
+
+func TestNewLimiterDisabled(t *testing.T) {
+	assert.Nil(t, NewLimiter(config.RateLimitConfig{}))
+}
+
+func TestNilLimiterMiddlewareIsPassthrough(t *testing.T) {
+	var limiter *Limiter
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	assert.Equal(t, http.StatusNoContent, serve(handler, "10.0.0.1:1234").Code)
+}
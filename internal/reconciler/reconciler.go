@@ -0,0 +1,120 @@
+package reconciler
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+	"github.com/dcm-project/k8s-service-provider/internal/records"
+)
+
+// Deployer is the slice of the deployment service the reconciler drives:
+// a live lookup to detect missing cluster objects, and the create path to
+// rebuild them under the recorded ID
+type Deployer interface {
+	GetDeployment(ctx context.Context, id, namespace string, kind models.DeploymentKind) (*models.DeploymentResponse, error)
+	CreateDeployment(ctx context.Context, req *models.DeploymentRequest, id string) error
+}
+
+// Reconciler periodically walks the ManagedDeployment records and recreates
+// deployments whose cluster objects were removed out-of-band. The interval
+// bounds how quickly drift is corrected.
+type Reconciler struct {
+	store    records.StoreInterface
+	deployer Deployer
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+// New creates a reconciler over the given record store and deployment service
+func New(store records.StoreInterface, deployer Deployer, interval time.Duration, logger *zap.Logger) *Reconciler {
+	return &Reconciler{
+		store:    store,
+		deployer: deployer,
+		interval: interval,
+		logger:   logger.Named("reconciler"),
+	}
+}
+
+// Start runs the reconcile loop in the background until the context is
+// cancelled. A full pass runs immediately, then once per interval.
+func (r *Reconciler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		r.ReconcileAll(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.ReconcileAll(ctx)
+			}
+		}
+	}()
+}
+
+// ReconcileAll runs one pass over every record. Failures on individual
+// records are logged and do not stop the pass; the next interval retries.
+func (r *Reconciler) ReconcileAll(ctx context.Context) {
+	recordList, err := r.store.List(ctx, nil)
+	if err != nil {
+		r.logger.Error("Failed to list deployment records", zap.Error(err))
+		return
+	}
+
+	for i := range recordList {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := r.reconcile(ctx, &recordList[i]); err != nil {
+			r.logger.Error("Failed to reconcile deployment record",
+				zap.String("deployment_id", recordList[i].ID),
+				zap.Error(err))
+		}
+	}
+}
+
+// reconcile converges one record: when the recorded deployment's cluster
+// objects are gone, they are recreated from the spec snapshot under the
+// recorded ID
+func (r *Reconciler) reconcile(ctx context.Context, record *records.Record) error {
+	kind := models.DeploymentKind(record.Kind)
+	_, err := r.deployer.GetDeployment(ctx, record.ID, record.Namespace, kind)
+	if err == nil {
+		return nil
+	}
+	if !models.IsNotFoundError(err) {
+		return err
+	}
+
+	spec, err := models.ParseSpec(kind, record.Spec)
+	if err != nil {
+		return err
+	}
+	req := &models.DeploymentRequest{
+		Kind: kind,
+		Metadata: models.Metadata{
+			Name:      record.Name,
+			Namespace: record.Namespace,
+		},
+		Spec: spec,
+	}
+
+	// Recreate as the recorded owner so ownership annotations survive the
+	// rebuild
+	createCtx := ctx
+	if record.Owner != "" {
+		createCtx = identity.WithCreator(ctx, &models.Creator{Subject: record.Owner})
+	}
+
+	r.logger.Info("Recreating deployment from its record",
+		zap.String("deployment_id", record.ID),
+		zap.String("kind", record.Kind),
+		zap.String("namespace", record.Namespace))
+	return r.deployer.CreateDeployment(createCtx, req, record.ID)
+}
@@ -0,0 +1,81 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+	"github.com/dcm-project/k8s-service-provider/internal/records"
+)
+
+// stubDeployer reports the IDs in existing as live and captures creates
+type stubDeployer struct {
+	existing map[string]bool
+	created  []*models.DeploymentRequest
+	ids      []string
+	owners   []string
+}
+
+func (s *stubDeployer) GetDeployment(_ context.Context, id, _ string, _ models.DeploymentKind) (*models.DeploymentResponse, error) {
+	if s.existing[id] {
+		return &models.DeploymentResponse{ID: id}, nil
+	}
+	return nil, models.NewErrDeploymentNotFound(id)
+}
+
+func (s *stubDeployer) CreateDeployment(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	s.created = append(s.created, req)
+	s.ids = append(s.ids, id)
+	owner := ""
+	if creator, ok := identity.FromContext(ctx); ok {
+		owner = creator.Subject
+	}
+	s.owners = append(s.owners, owner)
+	return nil
+}
+
+func TestReconcileRecreatesMissingDeployments(t *testing.T) {
+	store := records.NewMemoryStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-healthy", "container", "team-a", "web", "alice")))
+	require.NoError(t, store.Save(ctx, testRecord("id-gone", "container", "team-a", "db", "bob")))
+
+	deployer := &stubDeployer{existing: map[string]bool{"id-healthy": true}}
+	reconciler := New(store, deployer, time.Minute, zap.NewNop())
+	reconciler.ReconcileAll(ctx)
+
+	// Only the deployment whose cluster objects are gone is recreated, under
+	// its recorded ID and owner, with the spec snapshot re-typed for the kind
+	require.Len(t, deployer.created, 1)
+	assert.Equal(t, []string{"id-gone"}, deployer.ids)
+	assert.Equal(t, []string{"bob"}, deployer.owners)
+
+	req := deployer.created[0]
+	assert.Equal(t, models.DeploymentKindContainer, req.Kind)
+	assert.Equal(t, "db", req.Metadata.Name)
+	assert.Equal(t, "team-a", req.Metadata.Namespace)
+	spec, ok := req.Spec.(models.ContainerSpec)
+	require.True(t, ok)
+	assert.Equal(t, "nginx:latest", spec.Container.Image)
+}
+
+func TestReconcileAllContinuesPastBadRecords(t *testing.T) {
+	store := records.NewMemoryStore()
+	ctx := context.Background()
+
+	// An unparseable record must not stop the pass
+	require.NoError(t, store.Save(ctx, testRecord("id-bad", "unknown", "team-a", "broken", "")))
+	require.NoError(t, store.Save(ctx, testRecord("id-gone", "container", "team-b", "api", "")))
+
+	deployer := &stubDeployer{existing: map[string]bool{}}
+	New(store, deployer, time.Minute, zap.NewNop()).ReconcileAll(ctx)
+
+	assert.Equal(t, []string{"id-gone"}, deployer.ids)
+}
@@ -0,0 +1,213 @@
+// Package reconciler stores deployment records as ManagedDeployment custom
+// resources and runs a controller loop that converges the cluster back
+// toward the recorded specs, so the imperative create paths become
+// desired-state management with drift correction.
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/records"
+)
+
+// ManagedDeploymentGVR identifies the ManagedDeployment custom resource. The
+// CRD manifest lives in deploy/crds and must be installed before the
+// reconciler is enabled.
+var ManagedDeploymentGVR = schema.GroupVersionResource{
+	Group:    "k8s-service-provider.io",
+	Version:  "v1alpha1",
+	Resource: "manageddeployments",
+}
+
+// ManagedDeploymentKind is the kind written into created custom resources
+const ManagedDeploymentKind = "ManagedDeployment"
+
+// Store persists deployment records as ManagedDeployment custom resources.
+// It implements records.StoreInterface, so the deployment service writes a
+// resource on every create and update and removes it on delete; the
+// reconciler then treats those resources as the desired state.
+type Store struct {
+	client dynamic.Interface
+	logger *zap.Logger
+}
+
+// NewStore creates a record store backed by ManagedDeployment custom resources
+func NewStore(client dynamic.Interface, logger *zap.Logger) *Store {
+	return &Store{
+		client: client,
+		logger: logger.Named("record_store"),
+	}
+}
+
+// Save upserts the ManagedDeployment for a record, named by the deployment
+// ID in the deployment's namespace. The original creation time is carried by
+// the resource, and an empty owner on an update keeps the stored owner.
+func (s *Store) Save(ctx context.Context, record *records.Record) error {
+	desired := resourceForRecord(record)
+
+	_, err := s.client.Resource(ManagedDeploymentGVR).Namespace(record.Namespace).
+		Create(ctx, desired, metav1.CreateOptions{})
+	if err == nil {
+		return nil
+	}
+	if !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating ManagedDeployment %s: %w", record.ID, err)
+	}
+
+	existing, err := s.client.Resource(ManagedDeploymentGVR).Namespace(record.Namespace).
+		Get(ctx, record.ID, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("reading ManagedDeployment %s: %w", record.ID, err)
+	}
+	if record.Owner == "" {
+		if owner, _, _ := unstructured.NestedString(existing.Object, "spec", "owner"); owner != "" {
+			_ = unstructured.SetNestedField(desired.Object, owner, "spec", "owner")
+		}
+	}
+	existing.Object["spec"] = desired.Object["spec"]
+	existing.SetLabels(desired.GetLabels())
+	if _, err := s.client.Resource(ManagedDeploymentGVR).Namespace(record.Namespace).
+		Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating ManagedDeployment %s: %w", record.ID, err)
+	}
+	return nil
+}
+
+// Get returns the record for a deployment ID. The namespace is not known to
+// callers, so the resource is found by its app-id label across namespaces.
+func (s *Store) Get(ctx context.Context, id string) (*records.Record, error) {
+	list, err := s.client.Resource(ManagedDeploymentGVR).
+		List(ctx, metav1.ListOptions{LabelSelector: models.BuildDeploymentSelector(id)})
+	if err != nil {
+		return nil, fmt.Errorf("reading ManagedDeployment %s: %w", id, err)
+	}
+	if len(list.Items) == 0 {
+		return nil, records.ErrNotFound
+	}
+	return recordForResource(&list.Items[0]), nil
+}
+
+// List returns the records matching the filter, sorted by namespace and name
+func (s *Store) List(ctx context.Context, filter *records.Filter) ([]records.Record, error) {
+	resource := s.client.Resource(ManagedDeploymentGVR)
+	lister := resource.Namespace(metav1.NamespaceAll)
+	if filter != nil && filter.Namespace != "" {
+		lister = resource.Namespace(filter.Namespace)
+	}
+	list, err := lister.List(ctx, metav1.ListOptions{LabelSelector: models.BuildManagedResourceSelector()})
+	if err != nil {
+		return nil, fmt.Errorf("listing ManagedDeployments: %w", err)
+	}
+
+	var matched []records.Record
+	for i := range list.Items {
+		record := recordForResource(&list.Items[i])
+		if filter != nil && !filter.Matches(record) {
+			continue
+		}
+		matched = append(matched, *record)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].Namespace != matched[j].Namespace {
+			return matched[i].Namespace < matched[j].Namespace
+		}
+		return matched[i].Name < matched[j].Name
+	})
+	return matched, nil
+}
+
+// Delete removes the ManagedDeployment for a deployment ID
+func (s *Store) Delete(ctx context.Context, id string) error {
+	record, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	err = s.client.Resource(ManagedDeploymentGVR).Namespace(record.Namespace).
+		Delete(ctx, id, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return records.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("deleting ManagedDeployment %s: %w", id, err)
+	}
+	return nil
+}
+
+// Close implements records.StoreInterface; there is nothing to release
+func (s *Store) Close() error {
+	return nil
+}
+
+// resourceForRecord builds the ManagedDeployment custom resource for a record
+func resourceForRecord(record *records.Record) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"kind":      record.Kind,
+		"name":      record.Name,
+		"owner":     record.Owner,
+		"phase":     record.Phase,
+		"updatedAt": time.Now().UTC().Format(time.RFC3339),
+	}
+	if len(record.Spec) > 0 {
+		var deployment map[string]interface{}
+		if err := json.Unmarshal(record.Spec, &deployment); err == nil {
+			spec["deployment"] = deployment
+		}
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": ManagedDeploymentGVR.Group + "/" + ManagedDeploymentGVR.Version,
+		"kind":       ManagedDeploymentKind,
+		"metadata": map[string]interface{}{
+			"name":      record.ID,
+			"namespace": record.Namespace,
+			"labels":    labelsValue(models.BuildDeploymentLabels(record.ID, record.Name)),
+		},
+		"spec": spec,
+	}}
+}
+
+// recordForResource rebuilds a record from a ManagedDeployment resource
+func recordForResource(obj *unstructured.Unstructured) *records.Record {
+	record := &records.Record{
+		ID:        obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		CreatedAt: obj.GetCreationTimestamp().Time,
+	}
+	record.Kind, _, _ = unstructured.NestedString(obj.Object, "spec", "kind")
+	record.Name, _, _ = unstructured.NestedString(obj.Object, "spec", "name")
+	record.Owner, _, _ = unstructured.NestedString(obj.Object, "spec", "owner")
+	record.Phase, _, _ = unstructured.NestedString(obj.Object, "spec", "phase")
+	if deployment, found, _ := unstructured.NestedMap(obj.Object, "spec", "deployment"); found {
+		if data, err := json.Marshal(deployment); err == nil {
+			record.Spec = data
+		}
+	}
+	record.UpdatedAt = record.CreatedAt
+	if updated, _, _ := unstructured.NestedString(obj.Object, "spec", "updatedAt"); updated != "" {
+		if parsed, err := time.Parse(time.RFC3339, updated); err == nil {
+			record.UpdatedAt = parsed
+		}
+	}
+	return record
+}
+
+// labelsValue converts a label map into the interface{} values the
+// unstructured object requires
+func labelsValue(labels map[string]string) map[string]interface{} {
+	value := make(map[string]interface{}, len(labels))
+	for k, v := range labels {
+		value[k] = v
+	}
+	return value
+}
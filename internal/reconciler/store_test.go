@@ -0,0 +1,106 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/dcm-project/k8s-service-provider/internal/records"
+)
+
+func newTestStore() *Store {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(kscheme.Scheme,
+		map[schema.GroupVersionResource]string{ManagedDeploymentGVR: ManagedDeploymentKind + "List"})
+	return NewStore(client, zap.NewNop())
+}
+
+func testRecord(id, kind, namespace, name, owner string) *records.Record {
+	return &records.Record{
+		ID:        id,
+		Kind:      kind,
+		Name:      name,
+		Namespace: namespace,
+		Owner:     owner,
+		Spec:      json.RawMessage(`{"container":{"image":"nginx:latest"}}`),
+		Phase:     "pending",
+	}
+}
+
+func TestStoreSaveAndGet(t *testing.T) {
+	store := newTestStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+
+	record, err := store.Get(ctx, "id-1")
+	require.NoError(t, err)
+	assert.Equal(t, "container", record.Kind)
+	assert.Equal(t, "web", record.Name)
+	assert.Equal(t, "team-a", record.Namespace)
+	assert.Equal(t, "alice", record.Owner)
+	assert.JSONEq(t, `{"container":{"image":"nginx:latest"}}`, string(record.Spec))
+
+	_, err = store.Get(ctx, "missing")
+	assert.True(t, errors.Is(err, records.ErrNotFound))
+}
+
+func TestStoreSavePreservesOwner(t *testing.T) {
+	store := newTestStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+
+	// An update without a caller identity keeps the original owner
+	update := testRecord("id-1", "container", "team-a", "web", "")
+	update.Phase = "running"
+	require.NoError(t, store.Save(ctx, update))
+
+	record, err := store.Get(ctx, "id-1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", record.Owner)
+	assert.Equal(t, "running", record.Phase)
+}
+
+func TestStoreListFilters(t *testing.T) {
+	store := newTestStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+	require.NoError(t, store.Save(ctx, testRecord("id-2", "vm", "team-a", "db", "bob")))
+	require.NoError(t, store.Save(ctx, testRecord("id-3", "container", "team-b", "api", "alice")))
+
+	all, err := store.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	// Sorted by namespace, then name
+	assert.Equal(t, []string{"id-2", "id-1", "id-3"}, []string{all[0].ID, all[1].ID, all[2].ID})
+
+	containers, err := store.List(ctx, &records.Filter{Kind: "container"})
+	require.NoError(t, err)
+	assert.Len(t, containers, 2)
+
+	teamA, err := store.List(ctx, &records.Filter{Namespace: "team-a", Owner: "alice"})
+	require.NoError(t, err)
+	require.Len(t, teamA, 1)
+	assert.Equal(t, "id-1", teamA[0].ID)
+}
+
+func TestStoreDelete(t *testing.T) {
+	store := newTestStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+	require.NoError(t, store.Delete(ctx, "id-1"))
+
+	_, err := store.Get(ctx, "id-1")
+	assert.True(t, errors.Is(err, records.ErrNotFound))
+	assert.True(t, errors.Is(store.Delete(ctx, "id-1"), records.ErrNotFound))
+}
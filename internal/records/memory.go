@@ -0,0 +1,92 @@
+package records
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps deployment records in process memory. It backs the
+// "memory" store driver, intended for development and tests; records do not
+// survive a restart.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	records map[string]Record
+}
+
+// NewMemoryStore creates an empty in-memory record store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records: make(map[string]Record),
+	}
+}
+
+// Save upserts a record by ID. The original creation time is preserved, and
+// an empty owner on an update keeps the previously stored owner.
+func (s *MemoryStore) Save(_ context.Context, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *record
+	stored.UpdatedAt = time.Now().UTC()
+	if existing, ok := s.records[record.ID]; ok {
+		stored.CreatedAt = existing.CreatedAt
+		if stored.Owner == "" {
+			stored.Owner = existing.Owner
+		}
+	} else {
+		stored.CreatedAt = stored.UpdatedAt
+	}
+	s.records[record.ID] = stored
+	return nil
+}
+
+// Get returns the record for a deployment ID
+func (s *MemoryStore) Get(_ context.Context, id string) (*Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	record, ok := s.records[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &record, nil
+}
+
+// List returns the records matching the filter, sorted by namespace and name
+func (s *MemoryStore) List(_ context.Context, filter *Filter) ([]Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matched := make([]Record, 0, len(s.records))
+	for _, record := range s.records {
+		if filter == nil || filter.Matches(&record) {
+			matched = append(matched, record)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].Namespace != matched[j].Namespace {
+			return matched[i].Namespace < matched[j].Namespace
+		}
+		return matched[i].Name < matched[j].Name
+	})
+	return matched, nil
+}
+
+// Delete removes the record for a deployment ID
+func (s *MemoryStore) Delete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.records[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.records, id)
+	return nil
+}
+
+// Close is a no-op for the in-memory store
+func (s *MemoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,77 @@
+// Package records persists deployment records outside the cluster so
+// deployment IDs stay resolvable even when the underlying objects are
+// deleted out-of-band, and so metadata queries need not hit the API server.
+package records
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+)
+
+// ErrNotFound indicates no record exists for the requested deployment ID
+var ErrNotFound = errors.New("deployment record not found")
+
+// Record is the stored snapshot of a deployment: its identity, ownership,
+// the requested spec and a cached status phase
+type Record struct {
+	ID        string          `json:"id"`
+	Kind      string          `json:"kind"`
+	Name      string          `json:"name"`
+	Namespace string          `json:"namespace"`
+	Owner     string          `json:"owner,omitempty"`
+	Spec      json.RawMessage `json:"spec,omitempty"`
+	Phase     string          `json:"phase,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+// Filter narrows down the records returned by List
+type Filter struct {
+	Namespace string
+	Kind      string
+	Owner     string
+}
+
+// Matches reports whether the record satisfies the filter
+func (f *Filter) Matches(record *Record) bool {
+	if f.Namespace != "" && record.Namespace != f.Namespace {
+		return false
+	}
+	if f.Kind != "" && record.Kind != f.Kind {
+		return false
+	}
+	if f.Owner != "" && record.Owner != f.Owner {
+		return false
+	}
+	return true
+}
+
+// StoreInterface is the contract for deployment record persistence. Save
+// upserts by ID, preserving the original creation time and owner; Get and
+// Delete report ErrNotFound for unknown IDs.
+type StoreInterface interface {
+	Save(ctx context.Context, record *Record) error
+	Get(ctx context.Context, id string) (*Record, error)
+	List(ctx context.Context, filter *Filter) ([]Record, error)
+	Delete(ctx context.Context, id string) error
+	Close() error
+}
+
+// NewStore builds the record store selected by the configuration
+func NewStore(cfg config.StoreConfig, logger *zap.Logger) (StoreInterface, error) {
+	switch cfg.Driver {
+	case config.StoreDriverMemory:
+		return NewMemoryStore(), nil
+	case config.StoreDriverPostgres, config.StoreDriverSQLite:
+		return NewSQLStore(cfg.Driver, cfg.DSN, logger)
+	default:
+		return nil, fmt.Errorf("unknown store driver: %s", cfg.Driver)
+	}
+}
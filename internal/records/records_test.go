@@ -0,0 +1,194 @@
+package records
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+)
+
+func testRecord(id, kind, namespace, name, owner string) *Record {
+	return &Record{
+		ID:        id,
+		Kind:      kind,
+		Name:      name,
+		Namespace: namespace,
+		Owner:     owner,
+		Spec:      json.RawMessage(`{"container":{"image":"nginx:latest"}}`),
+		Phase:     "pending",
+	}
+}
+
+func TestMemoryStoreSaveAndGet(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+
+	record, err := store.Get(ctx, "id-1")
+	require.NoError(t, err)
+	assert.Equal(t, "container", record.Kind)
+	assert.Equal(t, "alice", record.Owner)
+	assert.False(t, record.CreatedAt.IsZero())
+	assert.Equal(t, record.CreatedAt, record.UpdatedAt)
+
+	_, err = store.Get(ctx, "missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestMemoryStoreSavePreservesCreationAndOwner(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+	created, err := store.Get(ctx, "id-1")
+	require.NoError(t, err)
+
+	// An update without a caller identity keeps the original owner, and the
+	// creation timestamp survives the upsert
+	update := testRecord("id-1", "container", "team-a", "web", "")
+	update.Phase = "running"
+	require.NoError(t, store.Save(ctx, update))
+
+	record, err := store.Get(ctx, "id-1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", record.Owner)
+	assert.Equal(t, created.CreatedAt, record.CreatedAt)
+	assert.Equal(t, "running", record.Phase)
+}
+
+func TestMemoryStoreListFilters(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+	require.NoError(t, store.Save(ctx, testRecord("id-2", "vm", "team-a", "db", "bob")))
+	require.NoError(t, store.Save(ctx, testRecord("id-3", "container", "team-b", "api", "alice")))
+
+	all, err := store.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	// Sorted by namespace, then name
+	assert.Equal(t, []string{"id-2", "id-1", "id-3"}, []string{all[0].ID, all[1].ID, all[2].ID})
+
+	containers, err := store.List(ctx, &Filter{Kind: "container"})
+	require.NoError(t, err)
+	assert.Len(t, containers, 2)
+
+	teamA, err := store.List(ctx, &Filter{Namespace: "team-a", Owner: "alice"})
+	require.NoError(t, err)
+	require.Len(t, teamA, 1)
+	assert.Equal(t, "id-1", teamA[0].ID)
+}
+
+func TestMemoryStoreDelete(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+	require.NoError(t, store.Delete(ctx, "id-1"))
+
+	_, err := store.Get(ctx, "id-1")
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.True(t, errors.Is(store.Delete(ctx, "id-1"), ErrNotFound))
+}
+
+func TestSQLStoreRebind(t *testing.T) {
+	numbered := &SQLStore{numbered: true}
+	assert.Equal(t, "SELECT x FROM t WHERE a = $1 AND b = $2",
+		numbered.rebind("SELECT x FROM t WHERE a = ? AND b = ?"))
+
+	positional := &SQLStore{}
+	assert.Equal(t, "SELECT x FROM t WHERE a = ?",
+		positional.rebind("SELECT x FROM t WHERE a = ?"))
+}
+
+// newTestSQLStore opens a SQLStore backed by a throwaway sqlite database
+func newTestSQLStore(t *testing.T) *SQLStore {
+	t.Helper()
+	store, err := NewSQLStore(config.StoreDriverSQLite, filepath.Join(t.TempDir(), "records.db"), zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestSQLStoreSaveAndGet(t *testing.T) {
+	store := newTestSQLStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+
+	record, err := store.Get(ctx, "id-1")
+	require.NoError(t, err)
+	assert.Equal(t, "container", record.Kind)
+	assert.Equal(t, "alice", record.Owner)
+	assert.JSONEq(t, `{"container":{"image":"nginx:latest"}}`, string(record.Spec))
+	assert.False(t, record.CreatedAt.IsZero())
+
+	_, err = store.Get(ctx, "missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestSQLStoreSavePreservesCreationAndOwner(t *testing.T) {
+	store := newTestSQLStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+	created, err := store.Get(ctx, "id-1")
+	require.NoError(t, err)
+
+	// An update without a caller identity keeps the original owner, and the
+	// creation timestamp survives the upsert
+	update := testRecord("id-1", "container", "team-a", "web", "")
+	update.Phase = "running"
+	require.NoError(t, store.Save(ctx, update))
+
+	record, err := store.Get(ctx, "id-1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", record.Owner)
+	assert.Equal(t, created.CreatedAt, record.CreatedAt)
+	assert.Equal(t, "running", record.Phase)
+}
+
+func TestSQLStoreListFilters(t *testing.T) {
+	store := newTestSQLStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+	require.NoError(t, store.Save(ctx, testRecord("id-2", "vm", "team-a", "db", "bob")))
+	require.NoError(t, store.Save(ctx, testRecord("id-3", "container", "team-b", "api", "alice")))
+
+	all, err := store.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	// Sorted by namespace, then name
+	assert.Equal(t, []string{"id-2", "id-1", "id-3"}, []string{all[0].ID, all[1].ID, all[2].ID})
+
+	containers, err := store.List(ctx, &Filter{Kind: "container"})
+	require.NoError(t, err)
+	assert.Len(t, containers, 2)
+
+	teamA, err := store.List(ctx, &Filter{Namespace: "team-a", Owner: "alice"})
+	require.NoError(t, err)
+	require.Len(t, teamA, 1)
+	assert.Equal(t, "id-1", teamA[0].ID)
+}
+
+func TestSQLStoreDelete(t *testing.T) {
+	store := newTestSQLStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, testRecord("id-1", "container", "team-a", "web", "alice")))
+	require.NoError(t, store.Delete(ctx, "id-1"))
+
+	_, err := store.Get(ctx, "id-1")
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.True(t, errors.Is(store.Delete(ctx, "id-1"), ErrNotFound))
+}
@@ -0,0 +1,210 @@
+package records
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	// Register the pure-Go database/sql drivers for the supported backends
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+)
+
+// createRecordsTable is written in the SQL subset Postgres and SQLite share
+const createRecordsTable = `CREATE TABLE IF NOT EXISTS deployment_records (
+	id TEXT PRIMARY KEY,
+	kind TEXT NOT NULL,
+	name TEXT NOT NULL,
+	namespace TEXT NOT NULL,
+	owner TEXT NOT NULL DEFAULT '',
+	spec TEXT NOT NULL DEFAULT '',
+	phase TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+)`
+
+const upsertRecord = `INSERT INTO deployment_records
+	(id, kind, name, namespace, owner, spec, phase, created_at, updated_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT (id) DO UPDATE SET
+		kind = excluded.kind,
+		name = excluded.name,
+		namespace = excluded.namespace,
+		owner = COALESCE(NULLIF(excluded.owner, ''), deployment_records.owner),
+		spec = excluded.spec,
+		phase = excluded.phase,
+		updated_at = excluded.updated_at`
+
+const selectRecord = `SELECT id, kind, name, namespace, owner, spec, phase, created_at, updated_at
+	FROM deployment_records WHERE id = ?`
+
+// SQLStore persists deployment records in a relational database through
+// database/sql, using the pure-Go pgx and modernc sqlite drivers linked
+// above. Queries are written with ? placeholders and rebound for drivers
+// that use numbered parameters.
+type SQLStore struct {
+	db       *sql.DB
+	numbered bool
+	logger   *zap.Logger
+}
+
+// NewSQLStore opens the database for the configured driver and ensures the
+// records schema exists
+func NewSQLStore(driver, dsn string, logger *zap.Logger) (*SQLStore, error) {
+	db, err := sql.Open(sqlDriverName(driver), dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s store: %w", driver, err)
+	}
+
+	store := &SQLStore{
+		db:       db,
+		numbered: driver == config.StoreDriverPostgres,
+		logger:   logger.Named("record_store"),
+	}
+	if _, err := db.Exec(createRecordsTable); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("creating records schema: %w", err)
+	}
+
+	logger.Info("Opened deployment record store", zap.String("driver", driver))
+	return store, nil
+}
+
+// sqlDriverName maps the configured driver to its database/sql registration
+func sqlDriverName(driver string) string {
+	if driver == config.StoreDriverPostgres {
+		return "pgx"
+	}
+	return driver
+}
+
+// rebind rewrites ? placeholders to the $n style for drivers that need it
+func (s *SQLStore) rebind(query string) string {
+	if !s.numbered {
+		return query
+	}
+	var builder strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&builder, "$%d", n)
+			continue
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+// Save upserts a record by ID. The original creation time is preserved, and
+// an empty owner on an update keeps the previously stored owner.
+func (s *SQLStore) Save(ctx context.Context, record *Record) error {
+	now := time.Now().UTC()
+	_, err := s.db.ExecContext(ctx, s.rebind(upsertRecord),
+		record.ID, record.Kind, record.Name, record.Namespace, record.Owner,
+		string(record.Spec), record.Phase, now, now)
+	if err != nil {
+		return fmt.Errorf("saving record %s: %w", record.ID, err)
+	}
+	return nil
+}
+
+// Get returns the record for a deployment ID
+func (s *SQLStore) Get(ctx context.Context, id string) (*Record, error) {
+	row := s.db.QueryRowContext(ctx, s.rebind(selectRecord), id)
+	record, err := scanRecord(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading record %s: %w", id, err)
+	}
+	return record, nil
+}
+
+// List returns the records matching the filter, sorted by namespace and name
+func (s *SQLStore) List(ctx context.Context, filter *Filter) ([]Record, error) {
+	query := `SELECT id, kind, name, namespace, owner, spec, phase, created_at, updated_at
+	FROM deployment_records`
+	var clauses []string
+	var args []interface{}
+	if filter != nil {
+		if filter.Namespace != "" {
+			clauses = append(clauses, "namespace = ?")
+			args = append(args, filter.Namespace)
+		}
+		if filter.Kind != "" {
+			clauses = append(clauses, "kind = ?")
+			args = append(args, filter.Kind)
+		}
+		if filter.Owner != "" {
+			clauses = append(clauses, "owner = ?")
+			args = append(args, filter.Owner)
+		}
+	}
+	if len(clauses) > 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+	query += " ORDER BY namespace, name"
+
+	rows, err := s.db.QueryContext(ctx, s.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing records: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			s.logger.Warn("Failed to close record rows", zap.Error(err))
+		}
+	}()
+
+	var matched []Record
+	for rows.Next() {
+		record, err := scanRecord(rows.Scan)
+		if err != nil {
+			return nil, fmt.Errorf("scanning record: %w", err)
+		}
+		matched = append(matched, *record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing records: %w", err)
+	}
+	return matched, nil
+}
+
+// Delete removes the record for a deployment ID
+func (s *SQLStore) Delete(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, s.rebind("DELETE FROM deployment_records WHERE id = ?"), id)
+	if err != nil {
+		return fmt.Errorf("deleting record %s: %w", id, err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Close releases the database connections
+func (s *SQLStore) Close() error {
+	return s.db.Close()
+}
+
+// scanRecord reads one records row through the given Scan function
+func scanRecord(scan func(dest ...interface{}) error) (*Record, error) {
+	var record Record
+	var spec string
+	if err := scan(&record.ID, &record.Kind, &record.Name, &record.Namespace,
+		&record.Owner, &spec, &record.Phase, &record.CreatedAt, &record.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if spec != "" {
+		record.Spec = []byte(spec)
+	}
+	return &record, nil
+}
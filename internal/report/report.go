@@ -0,0 +1,201 @@
+// Package report builds periodic per-namespace digests summarizing the
+// deployments managed by this service and delivers them to configured
+// webhook and email sinks. Digests cover active deployments, aggregate
+// resource requests and ResourceQuota usage; TTL expirations will join the
+// digest once deployment TTLs are tracked.
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// digestListLimit bounds how many deployments a digest covers per build.
+// It is intentionally far above the API pagination ceiling so digests see
+// the full inventory.
+const digestListLimit = 1000
+
+// Digest is a point-in-time summary of all managed namespaces
+type Digest struct {
+	GeneratedAt time.Time         `json:"generatedAt"`
+	Interval    string            `json:"interval"`
+	Namespaces  []NamespaceReport `json:"namespaces"`
+}
+
+// NamespaceReport summarizes the managed workloads within one namespace
+type NamespaceReport struct {
+	Namespace      string         `json:"namespace"`
+	Deployments    int            `json:"deployments"`
+	ByKind         map[string]int `json:"byKind"`
+	ReadyReplicas  int            `json:"readyReplicas"`
+	CPURequests    string         `json:"cpuRequests,omitempty"`
+	MemoryRequests string         `json:"memoryRequests,omitempty"`
+	Quotas         []QuotaUsage   `json:"quotas,omitempty"`
+}
+
+// QuotaUsage reports one resource tracked by a namespace ResourceQuota
+type QuotaUsage struct {
+	Name     string `json:"name"`
+	Resource string `json:"resource"`
+	Used     string `json:"used"`
+	Hard     string `json:"hard"`
+}
+
+// deploymentLister is the subset of the deployment service the builder needs
+type deploymentLister interface {
+	ListDeployments(ctx context.Context, req *models.ListDeploymentsRequest) (*models.ListDeploymentsResponse, error)
+}
+
+// Builder assembles digests from the deployment inventory and cluster state
+type Builder struct {
+	deployments deploymentLister
+	readClient  kubernetes.Interface
+	logger      *zap.Logger
+}
+
+// NewBuilder creates a new digest builder
+func NewBuilder(deployments deploymentLister, readClient kubernetes.Interface, logger *zap.Logger) *Builder {
+	return &Builder{
+		deployments: deployments,
+		readClient:  readClient,
+		logger:      logger,
+	}
+}
+
+// BuildDigest builds a digest covering every namespace with managed deployments
+func (b *Builder) BuildDigest(ctx context.Context, interval string) (*Digest, error) {
+	logger := b.logger.Named("report_builder")
+
+	list, err := b.deployments.ListDeployments(ctx, &models.ListDeploymentsRequest{Limit: digestListLimit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments for digest: %w", err)
+	}
+
+	reports := make(map[string]*NamespaceReport)
+	for _, deployment := range list.Deployments {
+		namespace := deployment.Metadata.Namespace
+		report, ok := reports[namespace]
+		if !ok {
+			report = &NamespaceReport{
+				Namespace: namespace,
+				ByKind:    make(map[string]int),
+			}
+			reports[namespace] = report
+		}
+		report.Deployments++
+		report.ByKind[string(deployment.Kind)]++
+		report.ReadyReplicas += deployment.Status.ReadyReplicas
+	}
+
+	b.addResourceTotals(ctx, reports)
+
+	digest := &Digest{
+		GeneratedAt: time.Now(),
+		Interval:    interval,
+		Namespaces:  make([]NamespaceReport, 0, len(reports)),
+	}
+	for _, namespace := range sortedNamespaces(reports) {
+		report := reports[namespace]
+		report.Quotas = b.quotaUsage(ctx, namespace)
+		digest.Namespaces = append(digest.Namespaces, *report)
+	}
+
+	logger.Info("Built namespace digest",
+		zap.String("interval", interval),
+		zap.Int("namespaces", len(digest.Namespaces)))
+	return digest, nil
+}
+
+// addResourceTotals sums the container resource requests of managed pods into
+// the per-namespace reports. Failures degrade the digest rather than fail it.
+func (b *Builder) addResourceTotals(ctx context.Context, reports map[string]*NamespaceReport) {
+	pods, err := b.readClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		LabelSelector: models.BuildManagedResourceSelector(),
+	})
+	if err != nil {
+		b.logger.Named("report_builder").Warn("Failed to list managed pods for resource totals", zap.Error(err))
+		return
+	}
+
+	cpu := make(map[string]*resource.Quantity)
+	memory := make(map[string]*resource.Quantity)
+	for _, pod := range pods.Items {
+		if _, ok := reports[pod.Namespace]; !ok {
+			continue
+		}
+		if cpu[pod.Namespace] == nil {
+			cpu[pod.Namespace] = resource.NewQuantity(0, resource.DecimalSI)
+			memory[pod.Namespace] = resource.NewQuantity(0, resource.BinarySI)
+		}
+		for _, container := range pod.Spec.Containers {
+			if request, ok := container.Resources.Requests[corev1.ResourceCPU]; ok {
+				cpu[pod.Namespace].Add(request)
+			}
+			if request, ok := container.Resources.Requests[corev1.ResourceMemory]; ok {
+				memory[pod.Namespace].Add(request)
+			}
+		}
+	}
+
+	for namespace, report := range reports {
+		if quantity := cpu[namespace]; quantity != nil && !quantity.IsZero() {
+			report.CPURequests = quantity.String()
+		}
+		if quantity := memory[namespace]; quantity != nil && !quantity.IsZero() {
+			report.MemoryRequests = quantity.String()
+		}
+	}
+}
+
+// quotaUsage collects ResourceQuota usage for a namespace. Quota data is
+// supplementary, so errors are logged and an empty slice returned.
+func (b *Builder) quotaUsage(ctx context.Context, namespace string) []QuotaUsage {
+	quotas, err := b.readClient.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		b.logger.Named("report_builder").Warn("Failed to list resource quotas",
+			zap.String("namespace", namespace),
+			zap.Error(err))
+		return nil
+	}
+
+	var usage []QuotaUsage
+	for _, quota := range quotas.Items {
+		resources := make([]string, 0, len(quota.Status.Hard))
+		for name := range quota.Status.Hard {
+			resources = append(resources, string(name))
+		}
+		sort.Strings(resources)
+
+		for _, name := range resources {
+			hard := quota.Status.Hard[corev1.ResourceName(name)]
+			used := quota.Status.Used[corev1.ResourceName(name)]
+			usage = append(usage, QuotaUsage{
+				Name:     quota.Name,
+				Resource: name,
+				Used:     used.String(),
+				Hard:     hard.String(),
+			})
+		}
+	}
+	return usage
+}
+
+// sortedNamespaces returns the report namespaces in a stable order
+func sortedNamespaces(reports map[string]*NamespaceReport) []string {
+	namespaces := make([]string, 0, len(reports))
+	for namespace := range reports {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
@@ -0,0 +1,166 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// stubLister returns a fixed deployment inventory
+type stubLister struct {
+	deployments []models.DeploymentResponse
+}
+
+func (s *stubLister) ListDeployments(_ context.Context, _ *models.ListDeploymentsRequest) (*models.ListDeploymentsResponse, error) {
+	return &models.ListDeploymentsResponse{Deployments: s.deployments}, nil
+}
+
+func TestBuildDigest(t *testing.T) {
+	lister := &stubLister{
+		deployments: []models.DeploymentResponse{
+			{
+				ID:       "id-1",
+				Kind:     models.DeploymentKindContainer,
+				Metadata: models.Metadata{Name: "web", Namespace: "team-a"},
+				Status:   models.DeploymentStatus{ReadyReplicas: 3},
+			},
+			{
+				ID:       "id-2",
+				Kind:     models.DeploymentKindVM,
+				Metadata: models.Metadata{Name: "devbox", Namespace: "team-a"},
+			},
+			{
+				ID:       "id-3",
+				Kind:     models.DeploymentKindContainer,
+				Metadata: models.Metadata{Name: "api", Namespace: "team-b"},
+				Status:   models.DeploymentStatus{ReadyReplicas: 1},
+			},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web-0",
+				Namespace: "team-a",
+				Labels:    models.BuildDeploymentLabels("id-1", "web"),
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "web",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("100m"),
+								corev1.ResourceMemory: resource.MustParse("128Mi"),
+							},
+						},
+					},
+				},
+			},
+		},
+		&corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "team-a"},
+			Status: corev1.ResourceQuotaStatus{
+				Hard: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+				Used: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			},
+		},
+	)
+
+	builder := NewBuilder(lister, fakeClient, zap.NewNop())
+	digest, err := builder.BuildDigest(context.Background(), "daily")
+	require.NoError(t, err)
+
+	require.Len(t, digest.Namespaces, 2)
+	assert.Equal(t, "daily", digest.Interval)
+
+	teamA := digest.Namespaces[0]
+	assert.Equal(t, "team-a", teamA.Namespace)
+	assert.Equal(t, 2, teamA.Deployments)
+	assert.Equal(t, 1, teamA.ByKind["container"])
+	assert.Equal(t, 1, teamA.ByKind["vm"])
+	assert.Equal(t, 3, teamA.ReadyReplicas)
+	assert.Equal(t, "100m", teamA.CPURequests)
+	assert.Equal(t, "128Mi", teamA.MemoryRequests)
+	require.Len(t, teamA.Quotas, 1)
+	assert.Equal(t, "compute", teamA.Quotas[0].Name)
+	assert.Equal(t, "cpu", teamA.Quotas[0].Resource)
+	assert.Equal(t, "1", teamA.Quotas[0].Used)
+	assert.Equal(t, "4", teamA.Quotas[0].Hard)
+
+	teamB := digest.Namespaces[1]
+	assert.Equal(t, "team-b", teamB.Namespace)
+	assert.Equal(t, 1, teamB.Deployments)
+	assert.Empty(t, teamB.Quotas)
+}
+
+func TestWebhookSinkDeliver(t *testing.T) {
+	var received Digest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, zap.NewNop())
+	digest := &Digest{
+		GeneratedAt: time.Now(),
+		Interval:    "weekly",
+		Namespaces:  []NamespaceReport{{Namespace: "team-a", Deployments: 1}},
+	}
+
+	require.NoError(t, sink.Deliver(context.Background(), digest))
+	assert.Equal(t, "weekly", received.Interval)
+	require.Len(t, received.Namespaces, 1)
+	assert.Equal(t, "team-a", received.Namespaces[0].Namespace)
+}
+
+func TestWebhookSinkDeliverFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, zap.NewNop())
+	err := sink.Deliver(context.Background(), &Digest{GeneratedAt: time.Now()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "502")
+}
+
+func TestRenderText(t *testing.T) {
+	digest := &Digest{
+		GeneratedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Interval:    "daily",
+		Namespaces: []NamespaceReport{
+			{
+				Namespace:     "team-a",
+				Deployments:   2,
+				ByKind:        map[string]int{"container": 1, "vm": 1},
+				ReadyReplicas: 3,
+				CPURequests:   "100m",
+				Quotas:        []QuotaUsage{{Name: "compute", Resource: "cpu", Used: "1", Hard: "4"}},
+			},
+		},
+	}
+
+	text := renderText(digest)
+	assert.Contains(t, text, "Namespace team-a: 2 deployment(s), 3 ready replica(s)")
+	assert.Contains(t, text, "container: 1")
+	assert.Contains(t, text, "quota compute cpu: 1 of 4 used")
+}
@@ -0,0 +1,103 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/schedule"
+)
+
+// Scheduler runs digest builds on the configured cadence and fans the result
+// out to every sink. The first run happens at the next occurrence of the
+// configured time of day; subsequent runs repeat every day or every seven
+// days depending on the interval.
+type Scheduler struct {
+	builder *Builder
+	sinks   []Sink
+	cfg     config.ReportConfig
+	logger  *zap.Logger
+}
+
+// NewScheduler creates a digest scheduler
+func NewScheduler(builder *Builder, sinks []Sink, cfg config.ReportConfig, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		builder: builder,
+		sinks:   sinks,
+		cfg:     cfg,
+		logger:  logger.Named("report_scheduler"),
+	}
+}
+
+// period returns the spacing between digest runs
+func (s *Scheduler) period() time.Duration {
+	if s.cfg.Interval == config.ReportIntervalWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// Start launches the scheduler loop; it stops when the context is cancelled
+func (s *Scheduler) Start(ctx context.Context) {
+	firstRun, err := s.firstRun(time.Now())
+	if err != nil {
+		s.logger.Error("Invalid report schedule, digests disabled", zap.Error(err))
+		return
+	}
+
+	s.logger.Info("Starting digest scheduler",
+		zap.String("interval", s.cfg.Interval),
+		zap.Time("next_run", firstRun),
+		zap.Int("sinks", len(s.sinks)))
+
+	go s.loop(ctx, firstRun)
+}
+
+// firstRun computes the first digest time from the configured time of day
+func (s *Scheduler) firstRun(now time.Time) (time.Time, error) {
+	daily := &schedule.Schedule{StartAt: s.cfg.At, Timezone: s.cfg.Timezone}
+	status, err := daily.NextStatus(now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return *status.NextStart, nil
+}
+
+// loop waits for each scheduled run, builds the digest and delivers it
+func (s *Scheduler) loop(ctx context.Context, nextRun time.Time) {
+	for {
+		timer := time.NewTimer(time.Until(nextRun))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.logger.Info("Stopping digest scheduler")
+			return
+		case <-timer.C:
+		}
+
+		s.runOnce(ctx)
+		nextRun = nextRun.Add(s.period())
+	}
+}
+
+// runOnce builds one digest and delivers it to every sink, logging per-sink
+// failures without aborting the remaining deliveries
+func (s *Scheduler) runOnce(ctx context.Context) {
+	digest, err := s.builder.BuildDigest(ctx, s.cfg.Interval)
+	if err != nil {
+		s.logger.Error("Failed to build digest", zap.Error(err))
+		return
+	}
+
+	for _, sink := range s.sinks {
+		if err := sink.Deliver(ctx, digest); err != nil {
+			s.logger.Error("Failed to deliver digest",
+				zap.String("sink", sink.Name()),
+				zap.Error(err))
+			continue
+		}
+		s.logger.Info("Delivered digest", zap.String("sink", sink.Name()))
+	}
+}
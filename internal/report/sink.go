@@ -0,0 +1,165 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+)
+
+// Sink delivers a digest to one destination. Delivery failures are isolated
+// per sink so one unreachable destination does not block the others.
+type Sink interface {
+	Name() string
+	Deliver(ctx context.Context, digest *Digest) error
+}
+
+// webhookTimeout bounds a single webhook delivery attempt
+const webhookTimeout = 10 * time.Second
+
+// WebhookSink POSTs the digest as JSON to a configured URL
+type WebhookSink struct {
+	url    string
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewWebhookSink creates a webhook sink targeting the given URL
+func NewWebhookSink(url string, logger *zap.Logger) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+		logger: logger,
+	}
+}
+
+// Name returns the sink identifier used in logs
+func (w *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Deliver POSTs the digest and treats any non-2xx response as a failure
+func (w *WebhookSink) Deliver(ctx context.Context, digest *Digest) error {
+	body, err := json.Marshal(digest)
+	if err != nil {
+		return fmt.Errorf("failed to encode digest: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := w.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to deliver digest webhook: %w", err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("digest webhook returned status %d", response.StatusCode)
+	}
+	return nil
+}
+
+// EmailSink sends the digest as a plain-text email over SMTP
+type EmailSink struct {
+	addr   string
+	from   string
+	to     []string
+	logger *zap.Logger
+}
+
+// NewEmailSink creates an email sink using the given SMTP server address
+func NewEmailSink(addr, from string, to []string, logger *zap.Logger) *EmailSink {
+	return &EmailSink{
+		addr:   addr,
+		from:   from,
+		to:     to,
+		logger: logger,
+	}
+}
+
+// Name returns the sink identifier used in logs
+func (e *EmailSink) Name() string {
+	return "email"
+}
+
+// Deliver renders the digest as text and sends it to all recipients
+func (e *EmailSink) Deliver(ctx context.Context, digest *Digest) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var message strings.Builder
+	fmt.Fprintf(&message, "From: %s\r\n", e.from)
+	fmt.Fprintf(&message, "To: %s\r\n", strings.Join(e.to, ", "))
+	fmt.Fprintf(&message, "Subject: Namespace deployment digest (%s)\r\n", digest.Interval)
+	message.WriteString("\r\n")
+	message.WriteString(renderText(digest))
+
+	if err := smtp.SendMail(e.addr, nil, e.from, e.to, []byte(message.String())); err != nil {
+		return fmt.Errorf("failed to send digest email: %w", err)
+	}
+	return nil
+}
+
+// renderText formats a digest for human consumption in email bodies
+func renderText(digest *Digest) string {
+	var body strings.Builder
+	fmt.Fprintf(&body, "Namespace deployment digest, generated %s\n\n", digest.GeneratedAt.Format(time.RFC3339))
+
+	if len(digest.Namespaces) == 0 {
+		body.WriteString("No managed deployments found.\n")
+		return body.String()
+	}
+
+	for _, namespace := range digest.Namespaces {
+		fmt.Fprintf(&body, "Namespace %s: %d deployment(s), %d ready replica(s)\n",
+			namespace.Namespace, namespace.Deployments, namespace.ReadyReplicas)
+
+		kinds := make([]string, 0, len(namespace.ByKind))
+		for kind := range namespace.ByKind {
+			kinds = append(kinds, kind)
+		}
+		sort.Strings(kinds)
+		for _, kind := range kinds {
+			fmt.Fprintf(&body, "  %s: %d\n", kind, namespace.ByKind[kind])
+		}
+
+		if namespace.CPURequests != "" || namespace.MemoryRequests != "" {
+			fmt.Fprintf(&body, "  requests: cpu=%s memory=%s\n", namespace.CPURequests, namespace.MemoryRequests)
+		}
+		for _, quota := range namespace.Quotas {
+			fmt.Fprintf(&body, "  quota %s %s: %s of %s used\n", quota.Name, quota.Resource, quota.Used, quota.Hard)
+		}
+		body.WriteString("\n")
+	}
+	return body.String()
+}
+
+// SinksFromConfig builds the sink list from the reporting configuration
+func SinksFromConfig(cfg config.ReportConfig, logger *zap.Logger) []Sink {
+	var sinks []Sink
+	if cfg.WebhookURL != "" {
+		sinks = append(sinks, NewWebhookSink(cfg.WebhookURL, logger))
+	}
+	if cfg.SMTPAddr != "" && cfg.EmailFrom != "" && cfg.EmailTo != "" {
+		recipients := strings.Split(cfg.EmailTo, ",")
+		for i := range recipients {
+			recipients[i] = strings.TrimSpace(recipients[i])
+		}
+		sinks = append(sinks, NewEmailSink(cfg.SMTPAddr, cfg.EmailFrom, recipients, logger))
+	}
+	return sinks
+}
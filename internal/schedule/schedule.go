@@ -0,0 +1,107 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+)
+
+// timeOfDayLayout is the expected layout for daily schedule times (24h clock)
+const timeOfDayLayout = "15:04"
+
+// Schedule represents a daily schedule expressed in a tenant's local timezone.
+// Times use the 24h "HH:MM" format; Timezone is an IANA timezone name such as
+// "Europe/Berlin" and defaults to UTC when empty.
+type Schedule struct {
+	StartAt  string `json:"startAt,omitempty"`
+	StopAt   string `json:"stopAt,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Status surfaces the computed next-run times for a schedule
+type Status struct {
+	NextStart *time.Time `json:"nextStart,omitempty"`
+	NextStop  *time.Time `json:"nextStop,omitempty"`
+	Timezone  string     `json:"timezone"`
+}
+
+// Location resolves the schedule's IANA timezone, defaulting to UTC
+func (s *Schedule) Location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", s.Timezone, err)
+	}
+	return location, nil
+}
+
+// Validate checks the schedule times and timezone
+func (s *Schedule) Validate() error {
+	if s.StartAt == "" && s.StopAt == "" {
+		return fmt.Errorf("schedule requires at least one of startAt or stopAt")
+	}
+
+	if _, err := s.Location(); err != nil {
+		return err
+	}
+
+	if s.StartAt != "" {
+		if _, err := time.Parse(timeOfDayLayout, s.StartAt); err != nil {
+			return fmt.Errorf("invalid startAt %q: expected HH:MM", s.StartAt)
+		}
+	}
+	if s.StopAt != "" {
+		if _, err := time.Parse(timeOfDayLayout, s.StopAt); err != nil {
+			return fmt.Errorf("invalid stopAt %q: expected HH:MM", s.StopAt)
+		}
+	}
+
+	return nil
+}
+
+// NextStatus computes the next start and stop occurrences after the given time
+func (s *Schedule) NextStatus(from time.Time) (*Status, error) {
+	location, err := s.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{Timezone: location.String()}
+
+	if s.StartAt != "" {
+		next, err := nextOccurrence(from, s.StartAt, location)
+		if err != nil {
+			return nil, err
+		}
+		status.NextStart = &next
+	}
+	if s.StopAt != "" {
+		next, err := nextOccurrence(from, s.StopAt, location)
+		if err != nil {
+			return nil, err
+		}
+		status.NextStop = &next
+	}
+
+	return status, nil
+}
+
+// nextOccurrence returns the next wall-clock occurrence of timeOfDay in the
+// given location, strictly after the reference time. Using the location's
+// wall clock means "stop at 19:00" follows the tenant's local time across
+// daylight saving transitions.
+func nextOccurrence(from time.Time, timeOfDay string, location *time.Location) (time.Time, error) {
+	parsed, err := time.Parse(timeOfDayLayout, timeOfDay)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid schedule time %q: expected HH:MM", timeOfDay)
+	}
+
+	local := from.In(location)
+	candidate := time.Date(local.Year(), local.Month(), local.Day(), parsed.Hour(), parsed.Minute(), 0, 0, location)
+	if !candidate.After(from) {
+		candidate = candidate.AddDate(0, 0, 1)
+	}
+	return candidate, nil
+}
@@ -0,0 +1,72 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+		wantErr  bool
+	}{
+		{"stop only with timezone", Schedule{StopAt: "19:00", Timezone: "Europe/Berlin"}, false},
+		{"start and stop, default UTC", Schedule{StartAt: "08:00", StopAt: "19:00"}, false},
+		{"empty schedule", Schedule{}, true},
+		{"invalid timezone", Schedule{StopAt: "19:00", Timezone: "Mars/Olympus"}, true},
+		{"invalid time format", Schedule{StopAt: "7pm"}, true},
+		{"out of range time", Schedule{StopAt: "25:00"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleNextStatus(t *testing.T) {
+	// 2024-06-15 10:00 UTC is 12:00 in Berlin (CEST, UTC+2)
+	from := time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+
+	schedule := Schedule{StartAt: "08:00", StopAt: "19:00", Timezone: "Europe/Berlin"}
+	status, err := schedule.NextStatus(from)
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", status.Timezone)
+
+	// 19:00 Berlin is 17:00 UTC the same day
+	assert.NotNil(t, status.NextStop)
+	assert.Equal(t, time.Date(2024, 6, 15, 17, 0, 0, 0, time.UTC), status.NextStop.UTC())
+
+	// 08:00 Berlin has already passed, so the next start is tomorrow (06:00 UTC)
+	assert.NotNil(t, status.NextStart)
+	assert.Equal(t, time.Date(2024, 6, 16, 6, 0, 0, 0, time.UTC), status.NextStart.UTC())
+}
+
+func TestScheduleNextStatusDefaultsToUTC(t *testing.T) {
+	from := time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+
+	schedule := Schedule{StopAt: "19:00"}
+	status, err := schedule.NextStatus(from)
+	assert.NoError(t, err)
+	assert.Equal(t, "UTC", status.Timezone)
+	assert.Nil(t, status.NextStart)
+	assert.Equal(t, time.Date(2024, 6, 15, 19, 0, 0, 0, time.UTC), status.NextStop.UTC())
+}
+
+func TestNextOccurrenceStrictlyAfter(t *testing.T) {
+	// Exactly at the scheduled time: next occurrence is the following day
+	from := time.Date(2024, 6, 15, 19, 0, 0, 0, time.UTC)
+
+	next, err := nextOccurrence(from, "19:00", time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 6, 16, 19, 0, 0, 0, time.UTC), next)
+}
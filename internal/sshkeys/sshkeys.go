@@ -0,0 +1,274 @@
+// Package sshkeys manages a registry of reusable SSH public keys. Keys are
+// registered once through the API and stored as labeled Secrets in a single
+// namespace; VM specs then reference them by name regardless of the namespace
+// they deploy into. Secrets copied into VM namespaces carry the key-name
+// label, so the registry can tell which deployments use a key and refuse to
+// delete one that is still referenced.
+package sshkeys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+const (
+	// LabelKeyName carries the registered key name on registry Secrets and
+	// on the per-VM Secrets materialized from them
+	LabelKeyName = "ssh-key-name"
+
+	// labelRegistry marks the registry Secrets themselves, distinguishing
+	// them from the per-VM copies that share the key-name label
+	labelRegistry = "ssh-key-registry"
+
+	// secretPrefix namespaces the registry Secret names
+	secretPrefix = "ssh-key-"
+)
+
+// ErrNotFound indicates no key is registered under the requested name
+var ErrNotFound = errors.New("ssh key not found")
+
+// ErrAlreadyExists indicates a key is already registered under the name
+var ErrAlreadyExists = errors.New("ssh key already exists")
+
+// ErrInUse indicates the key is referenced by live VM deployments and
+// cannot be deleted
+var ErrInUse = errors.New("ssh key is in use")
+
+// Key is a registered SSH public key and the deployments referencing it
+type Key struct {
+	Name      string    `json:"name"`
+	PublicKey string    `json:"publicKey"`
+	CreatedAt time.Time `json:"createdAt"`
+	UsedBy    []string  `json:"usedBy"` // deployment IDs referencing the key
+}
+
+// RegisterRequest is the payload for registering a public key
+type RegisterRequest struct {
+	Name      string `json:"name" binding:"required,max=253"`
+	PublicKey string `json:"publicKey" binding:"required"`
+}
+
+// ListResponse wraps the registered keys for the list endpoint
+type ListResponse struct {
+	Keys []Key `json:"keys"`
+}
+
+// Registry stores registered keys as Secrets in a single namespace
+type Registry struct {
+	client     kubernetes.Interface
+	readClient kubernetes.Interface
+	namespace  string
+	logger     *zap.Logger
+}
+
+// NewRegistry creates a registry backed by Secrets in the given namespace
+func NewRegistry(client, readClient kubernetes.Interface, namespace string, logger *zap.Logger) *Registry {
+	return &Registry{
+		client:     client,
+		readClient: readClient,
+		namespace:  namespace,
+		logger:     logger.Named("sshkey_registry"),
+	}
+}
+
+// Register validates and stores a public key under the given name
+func (r *Registry) Register(ctx context.Context, name, publicKey string) (*Key, error) {
+	if err := validateKeyName(name); err != nil {
+		return nil, err
+	}
+	if err := ValidatePublicKey(publicKey); err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretPrefix + name,
+			Namespace: r.namespace,
+			Labels: map[string]string{
+				labelRegistry: "true",
+				LabelKeyName:  name,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		StringData: map[string]string{
+			"key": publicKey,
+		},
+	}
+
+	created, err := r.client.CoreV1().Secrets(r.namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("%w: %s", ErrAlreadyExists, name)
+		}
+		return nil, fmt.Errorf("failed to store ssh key %s: %w", name, err)
+	}
+
+	r.logger.Info("Registered SSH key", zap.String("name", name))
+	return &Key{
+		Name:      name,
+		PublicKey: publicKey,
+		CreatedAt: created.CreationTimestamp.Time,
+		UsedBy:    []string{},
+	}, nil
+}
+
+// Get returns the registered key and the deployments currently using it
+func (r *Registry) Get(ctx context.Context, name string) (*Key, error) {
+	secret, err := r.readClient.CoreV1().Secrets(r.namespace).Get(ctx, secretPrefix+name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+		return nil, fmt.Errorf("failed to get ssh key %s: %w", name, err)
+	}
+
+	usedBy, err := r.usage(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return keyForSecret(secret, usedBy), nil
+}
+
+// List returns all registered keys sorted by name
+func (r *Registry) List(ctx context.Context) ([]Key, error) {
+	secrets, err := r.readClient.CoreV1().Secrets(r.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelRegistry + "=true",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ssh keys: %w", err)
+	}
+
+	keys := make([]Key, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		usedBy, err := r.usage(ctx, secret.Labels[LabelKeyName])
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *keyForSecret(secret, usedBy))
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	return keys, nil
+}
+
+// Delete removes a registered key, refusing while deployments reference it
+func (r *Registry) Delete(ctx context.Context, name string) error {
+	usedBy, err := r.usage(ctx, name)
+	if err != nil {
+		return err
+	}
+	if len(usedBy) > 0 {
+		return fmt.Errorf("%w: %s is referenced by deployments %s", ErrInUse, name, strings.Join(usedBy, ", "))
+	}
+
+	if err := r.client.CoreV1().Secrets(r.namespace).Delete(ctx, secretPrefix+name, metav1.DeleteOptions{}); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+		return fmt.Errorf("failed to delete ssh key %s: %w", name, err)
+	}
+
+	r.logger.Info("Deleted SSH key", zap.String("name", name))
+	return nil
+}
+
+// Resolve returns the public key registered under the given name; VM
+// creation uses it to materialize the key into the deployment's namespace
+func (r *Registry) Resolve(ctx context.Context, name string) (string, error) {
+	secret, err := r.readClient.CoreV1().Secrets(r.namespace).Get(ctx, secretPrefix+name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+		return "", fmt.Errorf("failed to get ssh key %s: %w", name, err)
+	}
+	return string(secret.Data["key"]), nil
+}
+
+// usage lists the deployment IDs of per-VM Secrets copied from the key,
+// excluding the registry Secret itself
+func (r *Registry) usage(ctx context.Context, name string) ([]string, error) {
+	secrets, err := r.readClient.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,!%s", LabelKeyName, name, labelRegistry),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check ssh key usage: %w", err)
+	}
+
+	usedBy := make([]string, 0, len(secrets.Items))
+	seen := make(map[string]bool)
+	for i := range secrets.Items {
+		if id := secrets.Items[i].Labels[models.LabelAppID]; id != "" && !seen[id] {
+			seen[id] = true
+			usedBy = append(usedBy, id)
+		}
+	}
+	sort.Strings(usedBy)
+	return usedBy, nil
+}
+
+func keyForSecret(secret *corev1.Secret, usedBy []string) *Key {
+	publicKey := string(secret.Data["key"])
+	if publicKey == "" {
+		publicKey = secret.StringData["key"]
+	}
+	return &Key{
+		Name:      secret.Labels[LabelKeyName],
+		PublicKey: publicKey,
+		CreatedAt: secret.CreationTimestamp.Time,
+		UsedBy:    usedBy,
+	}
+}
+
+// ValidatePublicKey checks that the string looks like an OpenSSH public key
+func ValidatePublicKey(publicKey string) error {
+	publicKey = strings.TrimSpace(publicKey)
+
+	if publicKey == "" {
+		return fmt.Errorf("SSH public key cannot be empty")
+	}
+
+	// Valid SSH key types
+	validPrefixes := []string{
+		"ssh-rsa ",
+		"ssh-ed25519 ",
+		"ecdsa-sha2-nistp256 ",
+		"ecdsa-sha2-nistp384 ",
+		"ecdsa-sha2-nistp521 ",
+		"ssh-dss ", // Legacy, but still valid
+	}
+
+	for _, prefix := range validPrefixes {
+		if strings.HasPrefix(publicKey, prefix) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid SSH public key format: must start with ssh-rsa, ssh-ed25519, ecdsa-sha2-*, or ssh-dss")
+}
+
+// validateKeyName enforces DNS-1123 label rules so the name can embed in a
+// Secret name
+func validateKeyName(name string) error {
+	matched, err := regexp.MatchString(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`, name)
+	if err != nil {
+		return fmt.Errorf("failed to validate key name: %w", err)
+	}
+	if !matched {
+		return fmt.Errorf("invalid key name: must be lowercase alphanumeric with hyphens, start and end with alphanumeric")
+	}
+	return nil
+}
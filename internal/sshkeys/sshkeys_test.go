@@ -0,0 +1,75 @@
+package sshkeys
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+const testPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDummyKeyMaterial user@host"
+
+func TestRegisterGetDelete(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	registry := NewRegistry(client, client, "provider-system", zap.NewNop())
+
+	key, err := registry.Register(context.Background(), "team-key", testPublicKey)
+	require.NoError(t, err)
+	assert.Equal(t, "team-key", key.Name)
+	assert.Empty(t, key.UsedBy)
+
+	// Duplicate names are rejected
+	_, err = registry.Register(context.Background(), "team-key", testPublicKey)
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+
+	// Invalid key material is rejected
+	_, err = registry.Register(context.Background(), "bad-key", "not a key")
+	assert.Error(t, err)
+
+	got, err := registry.Get(context.Background(), "team-key")
+	require.NoError(t, err)
+	assert.Equal(t, testPublicKey, got.PublicKey)
+
+	keys, err := registry.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	require.NoError(t, registry.Delete(context.Background(), "team-key"))
+	_, err = registry.Get(context.Background(), "team-key")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestDeleteRefusedWhileInUse(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	registry := NewRegistry(client, client, "provider-system", zap.NewNop())
+
+	_, err := registry.Register(context.Background(), "team-key", testPublicKey)
+	require.NoError(t, err)
+
+	// A per-VM copy of the key in another namespace marks it as in use
+	deploymentID := "11111111-2222-3333-4444-555555555555"
+	labels := models.BuildDeploymentLabels(deploymentID, "team-key")
+	labels[LabelKeyName] = "team-key"
+	_, err = client.CoreV1().Secrets("workloads").Create(context.Background(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-key", Namespace: "workloads", Labels: labels},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	err = registry.Delete(context.Background(), "team-key")
+	assert.ErrorIs(t, err, ErrInUse)
+
+	got, err := registry.Get(context.Background(), "team-key")
+	require.NoError(t, err)
+	assert.Equal(t, []string{deploymentID}, got.UsedBy)
+
+	// Once the VM copy is gone the key can be deleted
+	require.NoError(t, client.CoreV1().Secrets("workloads").Delete(context.Background(), "team-key", metav1.DeleteOptions{}))
+	assert.NoError(t, registry.Delete(context.Background(), "team-key"))
+}
@@ -0,0 +1,111 @@
+// Package tenancy maps authenticated principals to the namespaces they own
+// and carries that mapping through the request context. The deployment
+// service consults it so tenants only see and touch their own namespaces.
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+)
+
+// Wildcard grants a principal access to every namespace
+const Wildcard = "*"
+
+// Resolver maps principal subjects to their allowed namespaces
+type Resolver struct {
+	tenants map[string][]string
+}
+
+// NewResolver parses the configured tenant entries, each of the form
+// subject:ns1;ns2. A namespace of "*" grants access to all namespaces.
+func NewResolver(entries []string) (*Resolver, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	tenants := make(map[string][]string, len(entries))
+	for _, entry := range entries {
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid tenant entry %q: expected subject:ns1;ns2", entry)
+		}
+		if _, exists := tenants[parts[0]]; exists {
+			return nil, fmt.Errorf("duplicate tenant entry for subject %q", parts[0])
+		}
+
+		var namespaces []string
+		for _, namespace := range strings.Split(parts[1], ";") {
+			if namespace = strings.TrimSpace(namespace); namespace != "" {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+		tenants[parts[0]] = namespaces
+	}
+
+	return &Resolver{tenants: tenants}, nil
+}
+
+// NamespacesFor returns the namespaces the subject owns. Subjects without a
+// tenant entry own none.
+func (r *Resolver) NamespacesFor(subject string) []string {
+	return r.tenants[subject]
+}
+
+// contextKey is an unexported type to avoid collisions with other context values
+type contextKey struct{}
+
+// WithNamespaces returns a context carrying the caller's allowed namespaces
+func WithNamespaces(ctx context.Context, namespaces []string) context.Context {
+	return context.WithValue(ctx, contextKey{}, namespaces)
+}
+
+// FromContext returns the caller's allowed namespaces. The second return is
+// false when no tenancy restriction is attached, meaning all namespaces are
+// allowed.
+func FromContext(ctx context.Context) ([]string, bool) {
+	namespaces, ok := ctx.Value(contextKey{}).([]string)
+	return namespaces, ok
+}
+
+// Allowed reports whether the caller may operate on the namespace. Requests
+// without a tenancy restriction on the context are unrestricted.
+func Allowed(ctx context.Context, namespace string) bool {
+	namespaces, ok := FromContext(ctx)
+	if !ok {
+		return true
+	}
+	for _, allowed := range namespaces {
+		if allowed == Wildcard || allowed == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// Middleware attaches the caller's allowed namespaces to the request context.
+// The principal comes from the identity attached by the authentication
+// middleware, falling back to the forwarded headers for proxy-authenticated
+// setups; principals without a tenant entry own no namespaces. A nil resolver
+// leaves the handler untouched, so the middleware can be applied
+// unconditionally.
+func (r *Resolver) Middleware(next http.Handler) http.Handler {
+	if r == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		subject := ""
+		if creator, ok := identity.FromContext(request.Context()); ok {
+			subject = creator.Subject
+		} else if creator := identity.FromRequest(request); creator != nil {
+			subject = creator.Subject
+		}
+
+		ctx := WithNamespaces(request.Context(), r.NamespacesFor(subject))
+		next.ServeHTTP(w, request.WithContext(ctx))
+	})
+}
@@ -0,0 +1,116 @@
+package tenancy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/identity"
+)
+
+func TestNewResolverParsesEntries(t *testing.T) {
+	resolver, err := NewResolver([]string{
+		"alice:team-a;team-a-staging",
+		"admin:*",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"team-a", "team-a-staging"}, resolver.NamespacesFor("alice"))
+	assert.Equal(t, []string{Wildcard}, resolver.NamespacesFor("admin"))
+	assert.Empty(t, resolver.NamespacesFor("stranger"))
+}
+
+func TestNewResolverEmpty(t *testing.T) {
+	resolver, err := NewResolver(nil)
+	require.NoError(t, err)
+	assert.Nil(t, resolver)
+}
+
+func TestNewResolverRejectsMalformedEntries(t *testing.T) {
+	_, err := NewResolver([]string{"alice"})
+	assert.ErrorContains(t, err, "invalid tenant entry")
+
+	_, err = NewResolver([]string{"alice:team-a", "alice:team-b"})
+	assert.ErrorContains(t, err, "duplicate tenant entry")
+}
+
+func TestAllowed(t *testing.T) {
+	// No tenancy on the context: everything is allowed
+	assert.True(t, Allowed(context.Background(), "team-a"))
+
+	restricted := WithNamespaces(context.Background(), []string{"team-a"})
+	assert.True(t, Allowed(restricted, "team-a"))
+	assert.False(t, Allowed(restricted, "team-b"))
+
+	admin := WithNamespaces(context.Background(), []string{Wildcard})
+	assert.True(t, Allowed(admin, "team-b"))
+
+	// A restriction with no namespaces denies everything
+	denied := WithNamespaces(context.Background(), nil)
+	assert.False(t, Allowed(denied, "team-a"))
+}
+
+func TestMiddlewareAttachesNamespaces(t *testing.T) {
+	resolver, err := NewResolver([]string{"alice:team-a"})
+	require.NoError(t, err)
+
+	var namespaces []string
+	var restricted bool
+	handler := resolver.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		namespaces, restricted = FromContext(r.Context())
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+	request = request.WithContext(identity.WithCreator(request.Context(), &models.Creator{Subject: "alice"}))
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	assert.True(t, restricted)
+	assert.Equal(t, []string{"team-a"}, namespaces)
+}
+
+func TestMiddlewareFallsBackToForwardedHeaders(t *testing.T) {
+	resolver, err := NewResolver([]string{"bob:team-b"})
+	require.NoError(t, err)
+
+	var namespaces []string
+	handler := resolver.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		namespaces, _ = FromContext(r.Context())
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil)
+	request.Header.Set(identity.HeaderForwardedUser, "bob")
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	assert.Equal(t, []string{"team-b"}, namespaces)
+}
+
+func TestMiddlewareUnmappedPrincipalOwnsNothing(t *testing.T) {
+	resolver, err := NewResolver([]string{"alice:team-a"})
+	require.NoError(t, err)
+
+	var allowed bool
+	handler := resolver.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		allowed = Allowed(r.Context(), "team-a")
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil))
+	assert.False(t, allowed)
+}
+
+func TestNilResolverMiddlewareIsPassthrough(t *testing.T) {
+	var resolver *Resolver
+	handler := resolver.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, restricted := FromContext(r.Context())
+		assert.False(t, restricted)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/api/v1/deployments", nil))
+	assert.Equal(t, http.StatusNoContent, response.Code)
+}
@@ -0,0 +1,118 @@
+// Package client provides a small Go SDK for the deployment service REST
+// API, including watch-based wait helpers so consumers do not have to write
+// their own sleep-loops.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gorilla/websocket"
+)
+
+// defaultHTTPTimeout bounds individual REST calls; watches and waits are
+// bounded by the caller's context instead
+const defaultHTTPTimeout = 30 * time.Second
+
+// Client talks to a deployment service instance
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	dialer     *websocket.Dialer
+}
+
+// NewClient creates a client for the deployment service at baseURL
+// (e.g. "http://localhost:8080")
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+		dialer:     websocket.DefaultDialer,
+	}
+}
+
+// GetDeployment retrieves a deployment by its globally unique ID
+func (c *Client) GetDeployment(ctx context.Context, id string) (*models.DeploymentResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/api/v1/deployments/%s", c.baseURL, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment: %w", err)
+	}
+	defer resp.Body.Close() // #nosec G307
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr models.ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Code != "" {
+			return nil, fmt.Errorf("get deployment failed: %s: %s", apiErr.Code, apiErr.Title)
+		}
+		return nil, fmt.Errorf("get deployment returned status %d", resp.StatusCode)
+	}
+
+	var deployment models.DeploymentResponse
+	if err := json.NewDecoder(resp.Body).Decode(&deployment); err != nil {
+		return nil, fmt.Errorf("failed to decode deployment: %w", err)
+	}
+	return &deployment, nil
+}
+
+// watchDeployment opens the WebSocket watch for a deployment and forwards
+// status updates until the server closes the stream or the context is
+// cancelled
+func (c *Client) watchDeployment(ctx context.Context, id string) (<-chan models.DeploymentResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/deployments/%s/watch", c.websocketBaseURL(), id)
+	conn, resp, err := c.dialer.DialContext(ctx, url, nil)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close() // #nosec G307
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open watch: %w", err)
+	}
+
+	updates := make(chan models.DeploymentResponse)
+	go func() {
+		defer close(updates)
+		defer conn.Close() //nolint:errcheck // best-effort close on a one-way stream
+
+		for {
+			var update models.DeploymentResponse
+			if err := conn.ReadJSON(&update); err != nil {
+				return
+			}
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// Close the connection when the context is cancelled so the reader
+	// goroutine unblocks
+	go func() {
+		<-ctx.Done()
+		conn.Close() //nolint:errcheck // best-effort close on cancellation
+	}()
+
+	return updates, nil
+}
+
+// websocketBaseURL rewrites the HTTP base URL to its WebSocket scheme
+func (c *Client) websocketBaseURL() string {
+	if rest, ok := strings.CutPrefix(c.baseURL, "https://"); ok {
+		return "wss://" + rest
+	}
+	return "ws://" + strings.TrimPrefix(c.baseURL, "http://")
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+)
+
+// Polling backoff bounds used when the watch stream is unavailable or closes
+// before the awaited condition is met
+const (
+	waitInitialBackoff = time.Second
+	waitMaxBackoff     = 10 * time.Second
+)
+
+// WaitForPhase blocks until the deployment reaches the given phase, the
+// context is cancelled, or its deadline expires. It consumes the WebSocket
+// watch stream where possible and falls back to polling with exponential
+// backoff when the stream cannot be opened or closes early.
+func (c *Client) WaitForPhase(ctx context.Context, id string, phase models.DeploymentPhase) (*models.DeploymentResponse, error) {
+	// The deployment may already be there
+	if deployment, err := c.GetDeployment(ctx, id); err == nil && deployment.Status.Phase == phase {
+		return deployment, nil
+	}
+
+	if updates, err := c.watchDeployment(ctx, id); err == nil {
+		for update := range updates {
+			if update.Status.Phase == phase {
+				return &update, nil
+			}
+		}
+		// The stream closed before the phase was reached; fall through to
+		// polling rather than reporting a spurious failure
+	}
+
+	return c.pollForPhase(ctx, id, phase)
+}
+
+// WaitForDeleted blocks until the deployment is no longer found, the context
+// is cancelled, or its deadline expires. Deletion has no terminal watch
+// event, so it polls with exponential backoff.
+func (c *Client) WaitForDeleted(ctx context.Context, id string) error {
+	backoff := waitInitialBackoff
+	for {
+		if _, err := c.GetDeployment(ctx, id); models.IsNotFoundError(err) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("deployment %s still exists: %w", id, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// pollForPhase implements the polling fallback for WaitForPhase
+func (c *Client) pollForPhase(ctx context.Context, id string, phase models.DeploymentPhase) (*models.DeploymentResponse, error) {
+	backoff := waitInitialBackoff
+	for {
+		deployment, err := c.GetDeployment(ctx, id)
+		if err == nil && deployment.Status.Phase == phase {
+			return deployment, nil
+		}
+		if err != nil && !models.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("deployment %s did not reach phase %s: %w", id, phase, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// nextBackoff doubles the delay up to the polling ceiling
+func nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > waitMaxBackoff {
+		return waitMaxBackoff
+	}
+	return backoff
+}
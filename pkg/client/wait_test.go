@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeploymentID = "0e7c6c7a-1111-2222-3333-444455556666"
+
+func deploymentJSON(phase models.DeploymentPhase) models.DeploymentResponse {
+	return models.DeploymentResponse{
+		ID:   testDeploymentID,
+		Kind: models.DeploymentKindContainer,
+		Status: models.DeploymentStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, body interface{}) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func TestWaitForPhaseUsesWatchStream(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/deployments/"+testDeploymentID, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, deploymentJSON(models.DeploymentPhasePending))
+	})
+	mux.HandleFunc("/api/v1/deployments/"+testDeploymentID+"/watch", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close() //nolint:errcheck
+
+		require.NoError(t, conn.WriteJSON(deploymentJSON(models.DeploymentPhasePending)))
+		require.NoError(t, conn.WriteJSON(deploymentJSON(models.DeploymentPhaseRunning)))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	deployment, err := NewClient(server.URL).WaitForPhase(ctx, testDeploymentID, models.DeploymentPhaseRunning)
+	require.NoError(t, err)
+	assert.Equal(t, models.DeploymentPhaseRunning, deployment.Status.Phase)
+}
+
+func TestWaitForPhaseFallsBackToPolling(t *testing.T) {
+	var gets atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/deployments/"+testDeploymentID, func(w http.ResponseWriter, r *http.Request) {
+		// Pending on the fast-path check, running once polling starts
+		if gets.Add(1) == 1 {
+			writeJSON(t, w, http.StatusOK, deploymentJSON(models.DeploymentPhasePending))
+			return
+		}
+		writeJSON(t, w, http.StatusOK, deploymentJSON(models.DeploymentPhaseRunning))
+	})
+	// No watch route: the WebSocket dial fails and the helper must poll
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	deployment, err := NewClient(server.URL).WaitForPhase(ctx, testDeploymentID, models.DeploymentPhaseRunning)
+	require.NoError(t, err)
+	assert.Equal(t, models.DeploymentPhaseRunning, deployment.Status.Phase)
+	assert.GreaterOrEqual(t, gets.Load(), int32(2))
+}
+
+func TestWaitForDeleted(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/deployments/"+testDeploymentID, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusNotFound, models.ErrorResponse{
+			Code:  "DEPLOYMENT_NOT_FOUND",
+			Title: "Deployment not found",
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	assert.NoError(t, NewClient(server.URL).WaitForDeleted(context.Background(), testDeploymentID))
+}
+
+func TestWaitForDeletedTimesOut(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/deployments/"+testDeploymentID, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, deploymentJSON(models.DeploymentPhaseRunning))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := NewClient(server.URL).WaitForDeleted(ctx, testDeploymentID)
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,273 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/k8s-service-provider/internal/approval"
+	"github.com/dcm-project/k8s-service-provider/internal/audit"
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/api"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/deployment/services"
+	"github.com/dcm-project/k8s-service-provider/internal/events"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// Benchmark harness for the API layer. The HTTP benchmarks drive a router
+// backed by the in-memory mock service; the service benchmarks exercise
+// ContainerService against a fake clientset. Both report latency percentiles
+// so performance-oriented changes (e.g. informer caching) can be validated
+// without a cluster:
+//
+//	go test -bench=. -benchmem ./test
+//
+// The workload mix of BenchmarkAPIMixedWorkload is configurable via
+// BENCH_CREATE_WEIGHT, BENCH_LIST_WEIGHT and BENCH_DELETE_WEIGHT.
+
+// benchWeight reads a workload weight from the environment with a fallback
+func benchWeight(key string, fallback int) int {
+	if value := os.Getenv(key); value != "" {
+		if intVal, err := strconv.Atoi(value); err == nil && intVal >= 0 {
+			return intVal
+		}
+	}
+	return fallback
+}
+
+// reportPercentiles attaches p50/p95/p99 latency metrics to the benchmark result
+func reportPercentiles(b *testing.B, durations []time.Duration) {
+	b.Helper()
+
+	if len(durations) == 0 {
+		return
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	percentile := func(p float64) float64 {
+		idx := int(p * float64(len(durations)-1))
+		return float64(durations[idx].Microseconds())
+	}
+	b.ReportMetric(percentile(0.50), "p50-µs")
+	b.ReportMetric(percentile(0.95), "p95-µs")
+	b.ReportMetric(percentile(0.99), "p99-µs")
+}
+
+// newBenchServer starts an HTTP server backed by the in-memory mock service
+func newBenchServer() *httptest.Server {
+	router := api.SetupRouter(config.ServerConfig{GinMode: config.GinModeTest}, &MockDeploymentService{}, audit.NewStore(), approval.NewStore(0), events.NewBus(), nil, zap.NewNop())
+	return httptest.NewServer(router)
+}
+
+// containerRequestBody builds a create/update payload for a container deployment
+func containerRequestBody(name string) []byte {
+	return []byte(fmt.Sprintf(`{
+		"kind": "container",
+		"metadata": {"name": %q, "namespace": "bench"},
+		"spec": {"container": {"image": "nginx:latest"}}
+	}`, name))
+}
+
+// doRequest issues one request and fails the benchmark on unexpected status codes
+func doRequest(b *testing.B, client *http.Client, method, url string, body []byte) {
+	b.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		b.Fatalf("failed to build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		b.Fatalf("request failed: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		b.Fatalf("unexpected status %d for %s %s", resp.StatusCode, method, url)
+	}
+}
+
+func BenchmarkAPICreateDeployment(b *testing.B) {
+	server := newBenchServer()
+	defer server.Close()
+
+	client := server.Client()
+	url := server.URL + "/api/v1/deployments"
+	durations := make([]time.Duration, 0, b.N)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		body := containerRequestBody(fmt.Sprintf("bench-%d", i))
+		start := time.Now()
+		doRequest(b, client, http.MethodPost, url, body)
+		durations = append(durations, time.Since(start))
+	}
+	b.StopTimer()
+
+	reportPercentiles(b, durations)
+}
+
+func BenchmarkAPIListDeployments(b *testing.B) {
+	server := newBenchServer()
+	defer server.Close()
+
+	client := server.Client()
+	url := server.URL + "/api/v1/deployments"
+
+	// Seed the service so list responses are non-trivial
+	for i := 0; i < 50; i++ {
+		doRequest(b, client, http.MethodPost, url, containerRequestBody(fmt.Sprintf("seed-%d", i)))
+	}
+
+	durations := make([]time.Duration, 0, b.N)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		start := time.Now()
+		doRequest(b, client, http.MethodGet, url+"?limit=50", nil)
+		durations = append(durations, time.Since(start))
+	}
+	b.StopTimer()
+
+	reportPercentiles(b, durations)
+}
+
+func BenchmarkAPIMixedWorkload(b *testing.B) {
+	createWeight := benchWeight("BENCH_CREATE_WEIGHT", 1)
+	listWeight := benchWeight("BENCH_LIST_WEIGHT", 8)
+	deleteWeight := benchWeight("BENCH_DELETE_WEIGHT", 1)
+	total := createWeight + listWeight + deleteWeight
+	if total == 0 {
+		b.Fatal("workload weights must not all be zero")
+	}
+
+	server := newBenchServer()
+	defer server.Close()
+
+	client := server.Client()
+	url := server.URL + "/api/v1/deployments"
+	durations := make([]time.Duration, 0, b.N)
+	created := make([]string, 0, b.N)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		slot := i % total
+		start := time.Now()
+		switch {
+		case slot < createWeight:
+			created = append(created, createDeployment(b, client, url, fmt.Sprintf("bench-%d", i)))
+		case slot < createWeight+listWeight:
+			doRequest(b, client, http.MethodGet, url+"?limit=20", nil)
+		default:
+			if len(created) > 0 {
+				doRequest(b, client, http.MethodDelete, url+"/"+created[len(created)-1], nil)
+				created = created[:len(created)-1]
+			} else {
+				// Nothing to delete yet; fall back to a list
+				doRequest(b, client, http.MethodGet, url+"?limit=20", nil)
+			}
+		}
+		durations = append(durations, time.Since(start))
+	}
+	b.StopTimer()
+
+	reportPercentiles(b, durations)
+}
+
+// createDeployment issues a create request and returns the assigned deployment ID
+func createDeployment(b *testing.B, client *http.Client, url, name string) string {
+	b.Helper()
+
+	resp, err := client.Post(url, "application/json", bytes.NewReader(containerRequestBody(name)))
+	if err != nil {
+		b.Fatalf("create request failed: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated {
+		b.Fatalf("unexpected status %d for create", resp.StatusCode)
+	}
+
+	var deployment models.DeploymentResponse
+	if err := json.NewDecoder(resp.Body).Decode(&deployment); err != nil {
+		b.Fatalf("failed to decode create response: %v", err)
+	}
+	return deployment.ID
+}
+
+func BenchmarkContainerServiceCreate(b *testing.B) {
+	fakeClient := fake.NewSimpleClientset()
+	service := services.NewContainerService(fakeClient, fakeClient, config.WorkloadConfig{}, zap.NewNop())
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		req := &models.DeploymentRequest{
+			Kind: models.DeploymentKindContainer,
+			Metadata: models.Metadata{
+				Name:      fmt.Sprintf("bench-%d", i),
+				Namespace: "bench",
+			},
+			Spec: models.ContainerSpec{
+				Container: models.ContainerConfig{Image: "nginx:latest"},
+			},
+		}
+		if err := service.CreateContainer(ctx, req, fmt.Sprintf("00000000-0000-0000-0000-%012d", i)); err != nil {
+			b.Fatalf("create failed: %v", err)
+		}
+	}
+}
+
+func BenchmarkContainerServiceList(b *testing.B) {
+	fakeClient := fake.NewSimpleClientset()
+	service := services.NewContainerService(fakeClient, fakeClient, config.WorkloadConfig{}, zap.NewNop())
+	ctx := context.Background()
+
+	// Seed deployments so list results are non-trivial
+	for i := 0; i < 100; i++ {
+		req := &models.DeploymentRequest{
+			Kind: models.DeploymentKindContainer,
+			Metadata: models.Metadata{
+				Name:      fmt.Sprintf("seed-%d", i),
+				Namespace: "bench",
+			},
+			Spec: models.ContainerSpec{
+				Container: models.ContainerConfig{Image: "nginx:latest"},
+			},
+		}
+		if err := service.CreateContainer(ctx, req, fmt.Sprintf("00000000-0000-0000-0000-%012d", i)); err != nil {
+			b.Fatalf("seed create failed: %v", err)
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := service.ListContainers(ctx, "bench", 100, 0); err != nil {
+			b.Fatalf("list failed: %v", err)
+		}
+	}
+}
@@ -4,16 +4,28 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/dcm-project/k8s-service-provider/internal/approval"
+	"github.com/dcm-project/k8s-service-provider/internal/audit"
+	"github.com/dcm-project/k8s-service-provider/internal/config"
+	"github.com/dcm-project/k8s-service-provider/internal/defaults"
+	"github.com/dcm-project/k8s-service-provider/internal/flavors"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/api"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/services"
 	"github.com/dcm-project/k8s-service-provider/internal/deployment/models"
+	"github.com/dcm-project/k8s-service-provider/internal/events"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 	"k8s.io/utils/ptr"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 )
 
 // IntegrationTestSuite defines the test suite for integration tests
@@ -30,10 +42,10 @@ func (suite *IntegrationTestSuite) SetupSuite() {
 
 	// Create mock deployment service
 	// In a real integration test, you might use test containers or in-memory implementations
-	mockDeployService := &MockDeploymentService{}
+	mockDeployService := &MockDeploymentService{deployments: make(map[string]*models.DeploymentResponse)}
 
 	// Setup router
-	ginRouter := api.SetupRouter(mockDeployService, suite.logger)
+	ginRouter := api.SetupRouter(config.ServerConfig{GinMode: config.GinModeTest}, mockDeployService, audit.NewStore(), approval.NewStore(0), events.NewBus(), nil, suite.logger)
 	suite.router = httptest.NewServer(ginRouter)
 }
 
@@ -42,8 +54,11 @@ func (suite *IntegrationTestSuite) TearDownSuite() {
 	suite.router.Close()
 }
 
-// MockDeploymentService is a simple mock for integration testing
+// MockDeploymentService is a simple mock for integration testing. The mutex
+// guards the deployments map: batch endpoints fan requests out across
+// goroutines, so the mock must be safe for concurrent use.
 type MockDeploymentService struct {
+	mu          sync.Mutex
 	deployments map[string]*models.DeploymentResponse
 }
 
@@ -51,6 +66,9 @@ type MockDeploymentService struct {
 var _ services.DeploymentServiceInterface = (*MockDeploymentService)(nil)
 
 func (m *MockDeploymentService) CreateDeployment(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.deployments == nil {
 		m.deployments = make(map[string]*models.DeploymentResponse)
 	}
@@ -68,6 +86,9 @@ func (m *MockDeploymentService) CreateDeployment(ctx context.Context, req *model
 }
 
 func (m *MockDeploymentService) GetDeploymentByID(ctx context.Context, id string) (*models.DeploymentResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.deployments == nil {
 		return nil, models.NewErrDeploymentNotFound(id)
 	}
@@ -80,6 +101,9 @@ func (m *MockDeploymentService) GetDeploymentByID(ctx context.Context, id string
 }
 
 func (m *MockDeploymentService) UpdateDeployment(ctx context.Context, req *models.DeploymentRequest, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.deployments == nil {
 		return models.NewErrDeploymentNotFound(id)
 	}
@@ -93,7 +117,32 @@ func (m *MockDeploymentService) UpdateDeployment(ctx context.Context, req *model
 	return nil
 }
 
+func (m *MockDeploymentService) UpdateDeploymentMetadata(ctx context.Context, id string, req *models.UpdateDeploymentMetadataRequest) (*models.DeploymentResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.deployments == nil {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+
+	deployment, exists := m.deployments[id]
+	if !exists {
+		return nil, models.NewErrDeploymentNotFound(id)
+	}
+
+	if req.Notes != nil {
+		deployment.Metadata.Notes = *req.Notes
+	}
+	if req.Tags != nil {
+		deployment.Metadata.Tags = *req.Tags
+	}
+	return deployment, nil
+}
+
 func (m *MockDeploymentService) DeleteDeployment(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.deployments == nil {
 		return models.NewErrDeploymentNotFound(id)
 	}
@@ -106,7 +155,218 @@ func (m *MockDeploymentService) DeleteDeployment(ctx context.Context, id string)
 	return nil
 }
 
+func (m *MockDeploymentService) WatchDeployment(ctx context.Context, id string) (<-chan models.DeploymentResponse, error) {
+	deployment, err := m.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan models.DeploymentResponse, 1)
+	updates <- *deployment
+	close(updates)
+	return updates, nil
+}
+
+func (m *MockDeploymentService) GetDeploymentLogs(ctx context.Context, id string, req *models.DeploymentLogsRequest) (io.ReadCloser, error) {
+	deployment, err := m.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.Kind != models.DeploymentKindContainer && deployment.Kind != models.DeploymentKindJob {
+		return nil, models.NewErrLogsNotSupported(id, deployment.Kind)
+	}
+	return io.NopCloser(strings.NewReader("mock log line\n")), nil
+}
+
+func (m *MockDeploymentService) GetDeploymentConsoleLog(ctx context.Context, id string, req *models.DeploymentLogsRequest) (io.ReadCloser, error) {
+	deployment, err := m.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.Kind != models.DeploymentKindVM {
+		return nil, models.NewErrConsoleLogNotSupported(id, deployment.Kind)
+	}
+	return io.NopCloser(strings.NewReader("mock console line\n")), nil
+}
+
+func (m *MockDeploymentService) StreamDeploymentConsole(ctx context.Context, id, consoleType string) (kvcorev1.StreamInterface, error) {
+	deployment, err := m.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.Kind != models.DeploymentKindVM {
+		return nil, models.NewErrConsoleLogNotSupported(id, deployment.Kind)
+	}
+	return nil, fmt.Errorf("console streaming is not supported by the mock")
+}
+
+func (m *MockDeploymentService) DryRunDeployment(ctx context.Context, req *models.DeploymentRequest, id string) (*models.DryRunResponse, error) {
+	return &models.DryRunResponse{
+		ID:        id,
+		Kind:      req.Kind,
+		Manifests: []interface{}{req.Spec},
+	}, nil
+}
+
+func (m *MockDeploymentService) GetDeploymentEvents(ctx context.Context, id string) (*models.DeploymentEventsResponse, error) {
+	deployment, err := m.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	events := []models.DeploymentEvent{
+		{
+			Type:      "Normal",
+			Reason:    "Scheduled",
+			Message:   "Successfully assigned pod to node",
+			Object:    "Pod/" + deployment.Metadata.Name,
+			Count:     1,
+			FirstSeen: deployment.CreatedAt,
+			LastSeen:  deployment.CreatedAt,
+		},
+	}
+	return &models.DeploymentEventsResponse{Events: events, Count: len(events)}, nil
+}
+
+func (m *MockDeploymentService) GetDeploymentMetrics(ctx context.Context, id string) (*models.DeploymentMetricsResponse, error) {
+	deployment, err := m.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	pod := models.PodUsage{
+		Name:  deployment.Metadata.Name + "-0",
+		Usage: models.ResourceUsage{CPUMillicores: 100, MemoryBytes: 128 * 1024 * 1024},
+	}
+	return &models.DeploymentMetricsResponse{
+		ID:        id,
+		Kind:      deployment.Kind,
+		Namespace: deployment.Metadata.Namespace,
+		Pods:      []models.PodUsage{pod},
+		Totals:    pod.Usage,
+	}, nil
+}
+
+func (m *MockDeploymentService) NamespaceWarningEvents(ctx context.Context, namespace string) ([]models.DeploymentEvent, error) {
+	return nil, nil
+}
+
+func (m *MockDeploymentService) PerformDeploymentAction(ctx context.Context, id, action string) (*models.DeploymentActionResponse, error) {
+	deployment, err := m.GetDeploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.Kind != models.DeploymentKindVM {
+		return nil, models.NewErrActionNotSupported(id, deployment.Kind, action)
+	}
+	phase := models.DeploymentPhaseRunning
+	switch action {
+	case models.ActionStop:
+		phase = models.DeploymentPhasePending
+	case models.ActionPause:
+		phase = models.DeploymentPhasePaused
+	}
+	return &models.DeploymentActionResponse{
+		ID:        id,
+		Action:    action,
+		Phase:     phase,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+func (m *MockDeploymentService) AttachDeploymentDisk(ctx context.Context, id string, disk *models.VMDiskConfig) (*models.DeploymentActionResponse, error) {
+	return &models.DeploymentActionResponse{
+		ID:        id,
+		Action:    models.ActionAttachDisk,
+		Phase:     models.DeploymentPhaseRunning,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+func (m *MockDeploymentService) DetachDeploymentDisk(ctx context.Context, id, diskName string) (*models.DeploymentActionResponse, error) {
+	return &models.DeploymentActionResponse{
+		ID:        id,
+		Action:    models.ActionDetachDisk,
+		Phase:     models.DeploymentPhaseRunning,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+func (m *MockDeploymentService) AdoptDeployment(ctx context.Context, req *models.AdoptDeploymentRequest, id string) (*models.DeploymentResponse, error) {
+	return &models.DeploymentResponse{ID: id, Kind: req.Kind}, nil
+}
+
+func (m *MockDeploymentService) DeploymentDrift(ctx context.Context, id string) (*models.DriftResponse, error) {
+	return &models.DriftResponse{ID: id, Fields: []models.DriftedField{}}, nil
+}
+
+func (m *MockDeploymentService) SweepOrphans(ctx context.Context, remove bool) (*models.OrphanSweepResponse, error) {
+	return &models.OrphanSweepResponse{Orphans: []models.OrphanedResource{}}, nil
+}
+
+func (m *MockDeploymentService) StartNodeMaintenance(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error) {
+	return &models.NodeMaintenanceResponse{Node: node, Unschedulable: true}, nil
+}
+
+func (m *MockDeploymentService) NodeMaintenanceStatus(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error) {
+	return &models.NodeMaintenanceResponse{Node: node}, nil
+}
+
+func (m *MockDeploymentService) EndNodeMaintenance(ctx context.Context, node string) (*models.NodeMaintenanceResponse, error) {
+	return &models.NodeMaintenanceResponse{Node: node}, nil
+}
+
+func (m *MockDeploymentService) RefreshCapabilities(ctx context.Context) models.Capabilities {
+	return models.Capabilities{KubeVirt: true}
+}
+
+func (m *MockDeploymentService) DefaultsProfiles(ctx context.Context) []defaults.Profile {
+	return nil
+}
+
+func (m *MockDeploymentService) VMFlavors(ctx context.Context) []flavors.Flavor {
+	return flavors.Builtin().List()
+}
+
+func (m *MockDeploymentService) BuildSyncPlan(ctx context.Context, req *models.SyncPlanRequest) (*models.SyncPlanResponse, error) {
+	response := &models.SyncPlanResponse{Summary: map[string]int{}}
+	for _, record := range req.Records {
+		entry := models.SyncPlanEntry{
+			ID:        record.ID,
+			Kind:      record.Deployment.Kind,
+			Name:      record.Deployment.Metadata.Name,
+			Namespace: record.Deployment.Metadata.Namespace,
+			Action:    models.SyncActionCreate,
+		}
+		if existing, err := m.GetDeploymentByID(ctx, record.ID); err == nil {
+			entry.Action = models.SyncActionAdopt
+			if existing.Metadata.Name != record.Deployment.Metadata.Name {
+				entry.Action = models.SyncActionConflict
+			}
+		}
+		response.Entries = append(response.Entries, entry)
+		response.Summary[entry.Action]++
+	}
+	return response, nil
+}
+
+func (m *MockDeploymentService) ApplySyncPlan(ctx context.Context, req *models.SyncApplyRequest) (*models.SyncApplyResponse, error) {
+	response := &models.SyncApplyResponse{}
+	for i := range req.Records {
+		record := &req.Records[i]
+		result := models.SyncApplyResult{ID: record.ID, Action: models.SyncActionCreate, Status: models.SyncStatusSkipped}
+		if _, err := m.GetDeploymentByID(ctx, record.ID); err == nil {
+			result.Action = models.SyncActionAdopt
+		} else if err := m.CreateDeployment(ctx, &record.Deployment, record.ID); err == nil {
+			result.Status = models.SyncStatusApplied
+		}
+		response.Results = append(response.Results, result)
+	}
+	return response, nil
+}
+
 func (m *MockDeploymentService) ListDeployments(ctx context.Context, req *models.ListDeploymentsRequest) (*models.ListDeploymentsResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	if m.deployments == nil {
 		return &models.ListDeploymentsResponse{
 			Deployments: []models.DeploymentResponse{},
@@ -298,6 +558,54 @@ func (suite *IntegrationTestSuite) TestVMDeploymentLifecycle() {
 	suite.Equal(http.StatusNoContent, resp.StatusCode)
 }
 
+func (suite *IntegrationTestSuite) TestBatchCreateDeployments() {
+	batchReq := models.BatchDeploymentRequest{
+		Deployments: []models.DeploymentRequest{
+			{
+				Kind: models.DeploymentKindContainer,
+				Metadata: models.Metadata{
+					Name:      "batch-app-1",
+					Namespace: "default",
+				},
+				Spec: models.ContainerSpec{
+					Container: models.ContainerConfig{
+						Image: "nginx:latest",
+					},
+				},
+			},
+			{
+				Kind: models.DeploymentKindContainer,
+				Metadata: models.Metadata{
+					Name:      "batch-app-2",
+					Namespace: "default",
+				},
+				Spec: models.ContainerSpec{
+					Container: models.ContainerConfig{
+						Image: "redis:latest",
+					},
+				},
+			},
+		},
+	}
+
+	body, _ := json.Marshal(batchReq)
+	resp, err := http.Post(suite.router.URL+"/api/v1/deployments:batch", "application/json", bytes.NewBuffer(body))
+	suite.NoError(err)
+	suite.Equal(http.StatusCreated, resp.StatusCode)
+
+	var batchResp models.BatchDeploymentResponse
+	err = json.NewDecoder(resp.Body).Decode(&batchResp)
+	suite.NoError(err)
+	suite.Len(batchResp.Results, 2)
+	suite.Equal(2, batchResp.Succeeded)
+	suite.Equal(0, batchResp.Failed)
+	for _, result := range batchResp.Results {
+		suite.True(result.Success)
+		suite.NotNil(result.Deployment)
+		suite.NotEmpty(result.Deployment.ID)
+	}
+}
+
 func (suite *IntegrationTestSuite) TestErrorHandling() {
 	// Test invalid JSON
 	resp, err := http.Post(suite.router.URL+"/api/v1/deployments", "application/json", bytes.NewBuffer([]byte("invalid json")))